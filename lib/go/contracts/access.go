@@ -0,0 +1,50 @@
+package contracts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/parser2/lexer"
+)
+
+// legacyAccessModifiers are the access modifiers Cadence 1.0 removed
+// in favor of access(all) and access(self)
+var legacyAccessModifiers = map[string]bool{
+	"pub":  true,
+	"priv": true,
+}
+
+// ValidateAccessModifiers reports an error if the given code still uses
+// the pub or priv access modifiers Cadence 1.0 removed.
+//
+// The code is tokenized, so the keywords are only reported where they are used,
+// not where they appear in comments or strings.
+// The returned error lists the position of every remaining keyword.
+func ValidateAccessModifiers(code []byte) error {
+	var found []string
+
+	tokens := lexer.Lex(string(code), nil)
+	for {
+		token := tokens.Next()
+		if token.Is(lexer.TokenEOF) {
+			break
+		}
+
+		if !token.Is(lexer.TokenIdentifier) {
+			continue
+		}
+
+		identifier, ok := token.Value.(string)
+		if !ok || !legacyAccessModifiers[identifier] {
+			continue
+		}
+
+		found = append(found, fmt.Sprintf("%s at %d:%d", identifier, token.StartPos.Line, token.StartPos.Column))
+	}
+
+	if len(found) > 0 {
+		return fmt.Errorf("legacy access modifiers:\n\t%s", strings.Join(found, "\n\t"))
+	}
+
+	return nil
+}
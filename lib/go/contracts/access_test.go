@@ -0,0 +1,47 @@
+package contracts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+func TestValidateAccessModifiers(t *testing.T) {
+	t.Run("Should accept access(all) declarations", func(t *testing.T) {
+		code := []byte(`
+			// A pub contract before the migration
+			access(all) contract Test {
+				access(all) let name: String
+				access(self) var count: Int
+
+				init() {
+					self.name = "pub priv"
+					self.count = 0
+				}
+			}
+		`)
+
+		assert.NoError(t, contracts.ValidateAccessModifiers(code))
+	})
+
+	t.Run("Should report pub and priv declarations", func(t *testing.T) {
+		code := []byte(`access(all) contract Test {
+    pub let name: String
+    priv var count: Int
+    access(all) fun get(): Int { return self.count }
+}`)
+
+		err := contracts.ValidateAccessModifiers(code)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "pub at 2:4")
+		assert.Contains(t, err.Error(), "priv at 3:4")
+	})
+
+	t.Run("Should report the pre-1.0 embedded contracts", func(t *testing.T) {
+		// The embedded contracts still target Cadence before 1.0
+		assert.Error(t, contracts.ValidateAccessModifiers(contracts.ExampleTokenWithMetadataViews(addrA, addrB)))
+	})
+}
@@ -14,13 +14,25 @@ import (
 var (
 	placeholderFungibleToken = regexp.MustCompile(`"[^"\s].*/FungibleToken.cdc"`)
 	placeholderExampleToken  = regexp.MustCompile(`"[^"\s].*/ExampleToken.cdc"`)
+	placeholderMetadataViews = regexp.MustCompile(`"[^"\s].*/MetadataViews.cdc"`)
+)
+
+const (
+	placeholderFungibleTokenAddr    = "0xFUNGIBLETOKENADDRESS"
+	placeholderNonFungibleTokenAddr = "0xNONFUNGIBLETOKENADDRESS"
 )
 
 const (
 	filenameFungibleToken    = "FungibleToken.cdc"
 	filenameExampleToken     = "ExampleToken.cdc"
+	filenameLegacyExample    = "legacy/ExampleToken.cdc"
+	filenameMetadataViews    = "MetadataViews.cdc"
+	filenameWrapperToken     = "WrapperToken.cdc"
+	filenameMockToken        = "MockToken.cdc"
+	filenameNonFungibleToken = "utilityContracts/NonFungibleToken.cdc"
 	filenameTokenForwarding  = "utilityContracts/TokenForwarding.cdc"
 	filenamePrivateForwarder = "utilityContracts/PrivateReceiverForwarder.cdc"
+	filenameTokenMemo        = "utilityContracts/TokenMemo.cdc"
 )
 
 // FungibleToken returns the FungibleToken contract interface.
@@ -28,9 +40,29 @@ func FungibleToken() []byte {
 	return assets.MustAsset(filenameFungibleToken)
 }
 
+// NonFungibleToken returns the NonFungibleToken contract interface,
+// which the MetadataViews contract depends on.
+func NonFungibleToken() []byte {
+	return assets.MustAsset(filenameNonFungibleToken)
+}
+
+// MetadataViews returns the MetadataViews contract.
+//
+// The returned contract will import the FungibleToken and NonFungibleToken interfaces
+// from the specified addresses.
+func MetadataViews(fungibleTokenAddr, nonFungibleTokenAddr string) []byte {
+	code := assets.MustAssetString(filenameMetadataViews)
+
+	code = strings.ReplaceAll(code, placeholderFungibleTokenAddr, "0x"+fungibleTokenAddr)
+	code = strings.ReplaceAll(code, placeholderNonFungibleTokenAddr, "0x"+nonFungibleTokenAddr)
+
+	return []byte(code)
+}
+
 // ExampleToken returns the ExampleToken contract.
 //
 // The returned contract will import the FungibleToken interface from the specified address.
+// Use ExampleTokenWithMetadataViews to also import the MetadataViews contract from an address.
 func ExampleToken(fungibleTokenAddr string) []byte {
 	code := assets.MustAssetString(filenameExampleToken)
 
@@ -39,14 +71,81 @@ func ExampleToken(fungibleTokenAddr string) []byte {
 	return []byte(code)
 }
 
+// ExampleTokenWithMetadataViews returns the ExampleToken contract.
+//
+// The returned contract will import the FungibleToken interface and the MetadataViews contract
+// from the specified addresses.
+func ExampleTokenWithMetadataViews(fungibleTokenAddr, metadataViewsAddr string) []byte {
+	code := assets.MustAssetString(filenameExampleToken)
+
+	code = placeholderFungibleToken.ReplaceAllString(code, "0x"+fungibleTokenAddr)
+	code = placeholderMetadataViews.ReplaceAllString(code, "0x"+metadataViewsAddr)
+
+	return []byte(code)
+}
+
+// ExampleTokenLegacy returns a frozen snapshot of the ExampleToken contract
+// from before it implemented MetadataViews, as ExampleToken returned it at the time.
+//
+// The snapshot never changes, so it can serve as a baseline for testing
+// migrations of tokens deployed from it.
+//
+// The returned contract will import the FungibleToken interface from the specified address.
+func ExampleTokenLegacy(fungibleTokenAddr string) []byte {
+	code := assets.MustAssetString(filenameLegacyExample)
+
+	code = placeholderFungibleToken.ReplaceAllString(code, "0x"+fungibleTokenAddr)
+
+	return []byte(code)
+}
+
 // CustomToken returns the ExampleToken contract with a custom name.
 //
 // The returned contract will import the FungibleToken interface from the specified address.
+// Use CustomTokenWithMetadataViews to also import the MetadataViews contract from an address,
+// or to enable optional features of the contract.
 func CustomToken(fungibleTokenAddr, tokenName, storageName, initialBalance string) []byte {
 	code := assets.MustAssetString(filenameExampleToken)
 
 	code = placeholderFungibleToken.ReplaceAllString(code, "0x"+fungibleTokenAddr)
 
+	return []byte(renameToken(code, tokenName, storageName, initialBalance))
+}
+
+// CustomTokenWithMetadataViews returns the ExampleToken contract with a custom name.
+//
+// The returned contract will import the FungibleToken interface and the MetadataViews contract
+// from the specified addresses.
+// Optional features of the contract can be enabled with CustomTokenOptions,
+// and an error is returned if an option is invalid or can't be applied to the contract.
+func CustomTokenWithMetadataViews(fungibleTokenAddr, metadataViewsAddr, tokenName, storageName, initialBalance string, opts ...CustomTokenOption) ([]byte, error) {
+	code := assets.MustAssetString(filenameExampleToken)
+
+	config := newCustomTokenConfig(opts)
+
+	code, err := applyCustomTokenOptions(code, config)
+	if err != nil {
+		return nil, err
+	}
+
+	code = placeholderFungibleToken.ReplaceAllString(code, "0x"+fungibleTokenAddr)
+	code = placeholderMetadataViews.ReplaceAllString(code, "0x"+metadataViewsAddr)
+
+	code = renameToken(code, tokenName, storageName, initialBalance)
+
+	if config.importPathPrefix != "" {
+		code = string(ImportFromPaths([]byte(code), config.importPathPrefix))
+	}
+
+	if config.annotatedImports {
+		code = string(AnnotateImports([]byte(code), assets.MustAsset(filenameExampleToken)))
+	}
+
+	return []byte(addHeader(code, config.header)), nil
+}
+
+// renameToken replaces the name, storage name and initial balance of the ExampleToken contract
+func renameToken(code, tokenName, storageName, initialBalance string) string {
 	code = strings.ReplaceAll(
 		code,
 		"ExampleToken",
@@ -65,6 +164,38 @@ func CustomToken(fungibleTokenAddr, tokenName, storageName, initialBalance strin
 		initialBalance,
 	)
 
+	return code
+}
+
+// WrapperToken returns the WrapperToken contract, which wraps the specified underlying token.
+//
+// The returned contract will import the FungibleToken interface and the underlying token contract
+// from the specified addresses.
+func WrapperToken(fungibleTokenAddr, underlyingTokenAddr, underlyingTokenName string) []byte {
+	code := ReplaceImports(
+		assets.MustAsset(filenameWrapperToken),
+		map[string]string{
+			"FungibleToken": fungibleTokenAddr,
+			"ExampleToken":  underlyingTokenAddr,
+		},
+	)
+
+	return []byte(strings.ReplaceAll(
+		string(code),
+		"ExampleToken",
+		underlyingTokenName,
+	))
+}
+
+// MockToken returns the MockToken contract, a minimal fungible token for tests
+// that has no metadata views.
+//
+// The returned contract will import the FungibleToken interface from the specified address.
+func MockToken(fungibleTokenAddr string) []byte {
+	code := assets.MustAssetString(filenameMockToken)
+
+	code = placeholderFungibleToken.ReplaceAllString(code, "0x"+fungibleTokenAddr)
+
 	return []byte(code)
 }
 
@@ -109,3 +240,15 @@ func PrivateReceiverForwarder(fungibleTokenAddr string) []byte {
 
 	return []byte(code)
 }
+
+// TokenMemo returns the TokenMemo contract, which deposits tokens
+// and emits an event with a memo for the deposit.
+//
+// The returned contract will import the FungibleToken interface from the specified address.
+func TokenMemo(fungibleTokenAddr string) []byte {
+	code := assets.MustAssetString(filenameTokenMemo)
+
+	code = placeholderFungibleToken.ReplaceAllString(code, "0x"+fungibleTokenAddr)
+
+	return []byte(code)
+}
@@ -1,32 +1,92 @@
 package contracts_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/onflow/cadence/runtime/parser2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-ft/lib/go/contracts"
 )
 
-const addrA = "0A"
+const (
+	addrA = "0A"
+	addrB = "0B"
+)
 
 func TestFungibleTokenContract(t *testing.T) {
 	contract := contracts.FungibleToken()
 	assert.NotNil(t, contract)
 }
 
+func TestNonFungibleTokenContract(t *testing.T) {
+	contract := contracts.NonFungibleToken()
+	assert.NotNil(t, contract)
+}
+
+func TestMetadataViewsContract(t *testing.T) {
+	contract := contracts.MetadataViews(addrA, addrB)
+	assert.NotNil(t, contract)
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+	assert.Contains(t, string(contract), "import NonFungibleToken from 0x"+addrB)
+}
+
 func TestExampleTokenContract(t *testing.T) {
 	contract := contracts.ExampleToken(addrA)
 	assert.NotNil(t, contract)
 	assert.Contains(t, string(contract), addrA)
 }
 
+func TestExampleTokenWithMetadataViewsContract(t *testing.T) {
+	contract := contracts.ExampleTokenWithMetadataViews(addrA, addrB)
+	assert.NotNil(t, contract)
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+	assert.Contains(t, string(contract), "import MetadataViews from 0x"+addrB)
+}
+
 func TestCustomExampleTokenContract(t *testing.T) {
 	contract := contracts.CustomToken(addrA, "UtilityCoin", "utilityCoin", "100.0")
 	assert.NotNil(t, contract)
 	assert.Contains(t, string(contract), addrA)
 }
 
+func TestCustomExampleTokenWithMetadataViewsContract(t *testing.T) {
+	contract := customToken(t, "100.0")
+	assert.NotNil(t, contract)
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+	assert.Contains(t, string(contract), "import MetadataViews from 0x"+addrB)
+	assert.Contains(t, string(contract), "pub contract UtilityCoin: FungibleToken")
+}
+
+// customToken returns the UtilityCoin CustomToken with the given initial balance and options,
+// importing the FungibleToken interface from addrA and the MetadataViews contract from addrB
+func customToken(t *testing.T, initialBalance string, opts ...contracts.CustomTokenOption) []byte {
+	code, err := contracts.CustomTokenWithMetadataViews(addrA, addrB, "UtilityCoin", "utilityCoin", initialBalance, opts...)
+	require.NoError(t, err)
+
+	return code
+}
+
+func TestWrapperTokenContract(t *testing.T) {
+	contract := contracts.WrapperToken(addrA, addrB, "UtilityCoin")
+	assert.NotNil(t, contract)
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+	assert.Contains(t, string(contract), "import UtilityCoin from 0x"+addrB)
+	assert.NotContains(t, string(contract), "ExampleToken")
+
+	unresolved, _ := contracts.HasUnresolvedImports(contract)
+	assert.False(t, unresolved)
+}
+
+func TestMockTokenContract(t *testing.T) {
+	contract := contracts.MockToken(addrA)
+	assert.NotNil(t, contract)
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+	assert.NotContains(t, string(contract), "MetadataViews")
+}
+
 func TestTokenForwardingContract(t *testing.T) {
 	contract := contracts.TokenForwarding(addrA)
 	assert.NotNil(t, contract)
@@ -38,3 +98,170 @@ func TestCustomTokenForwardingContract(t *testing.T) {
 	assert.NotNil(t, contract)
 	assert.Contains(t, string(contract), addrA)
 }
+
+func TestTokenMemoContract(t *testing.T) {
+	contract := contracts.TokenMemo(addrA)
+	assert.NotNil(t, contract)
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+}
+
+func TestCustomTokenWithDepositEventField(t *testing.T) {
+	contract := customToken(t, "100.0")
+	assert.NotContains(t, string(contract), "TokensDepositedWithMemo")
+
+	contract = customToken(t, "100.0", contracts.WithDepositEventField("memo"))
+	assert.Contains(t, string(contract), "pub event TokensDepositedWithMemo(amount: UFix64, to: Address?, memo: String)")
+	assert.Contains(t, string(contract), "pub fun depositWithMemo(from: @FungibleToken.Vault, memo: String)")
+
+	_, err := parser2.ParseProgram(string(contract), nil)
+	assert.NoError(t, err)
+
+	t.Run("Should reject names that can't be declared as the field", func(t *testing.T) {
+		for _, name := range []string{"to", "from", "amount", "self", "let", "", "my memo", "1memo", `memo"`} {
+			code, err := contracts.CustomTokenWithMetadataViews(addrA, addrB, "UtilityCoin", "utilityCoin", "100.0", contracts.WithDepositEventField(name))
+			assert.Error(t, err, name)
+			assert.Nil(t, code, name)
+		}
+	})
+}
+
+func TestCustomTokenWithTransferEvent(t *testing.T) {
+	contract := string(customToken(t, "100.0"))
+	assert.NotContains(t, contract, "Transfer(")
+	assert.NotContains(t, contract, "var sources")
+
+	contract = string(customToken(t, "100.0", contracts.WithTransferEvent()))
+	assert.Contains(t, contract, "pub event Transfer(from: Address?, to: Address?, amount: UFix64)")
+	assert.Contains(t, contract, "access(contract) var sources: {Address: UFix64}")
+	assert.Contains(t, contract, "self.sources = {}")
+	assert.Contains(t, contract, "vault.sources[address] = amount")
+	assert.Contains(t, contract, "emit Transfer(from: address, to: to, amount: amount)")
+	assert.Contains(t, contract, "emit Transfer(from: nil, to: to, amount: vault.balance - attributed)")
+}
+
+func TestCustomTokenWithAnnotatedImports(t *testing.T) {
+	contract := string(customToken(t, "100.0"))
+	assert.Contains(t, contract, "import FungibleToken from 0x"+addrA+"\n")
+
+	contract = string(customToken(t, "100.0", contracts.WithAnnotatedImports()))
+	assert.Contains(t, contract, "import FungibleToken from 0x"+addrA+` // was "./FungibleToken.cdc"`)
+	assert.Contains(t, contract, "import MetadataViews from 0x"+addrB+` // was "./MetadataViews.cdc"`)
+
+	_, err := parser2.ParseProgram(contract, nil)
+	assert.NoError(t, err)
+}
+
+func TestCustomTokenWithImportsFromPaths(t *testing.T) {
+	code, err := contracts.CustomTokenWithMetadataViews("", "", "UtilityCoin", "utilityCoin", "100.0", contracts.WithImportsFromPaths("."))
+	require.NoError(t, err)
+
+	contract := string(code)
+	assert.Contains(t, contract, `import FungibleToken from "./FungibleToken.cdc"`)
+	assert.Contains(t, contract, `import MetadataViews from "./MetadataViews.cdc"`)
+	assert.NotContains(t, contract, "from 0x")
+
+	_, err = parser2.ParseProgram(contract, nil)
+	assert.NoError(t, err)
+}
+
+func TestCustomTokenWithMutableDisplay(t *testing.T) {
+	contract := string(customToken(t, "100.0"))
+	assert.NotContains(t, contract, "setDisplay")
+
+	contract = string(customToken(t, "100.0", contracts.WithMutableDisplay()))
+	assert.Contains(t, contract, "pub struct Display")
+	assert.Contains(t, contract, "pub var display: Display?")
+	assert.Contains(t, contract, "self.display = nil")
+	assert.Contains(t, contract, "pub fun setDisplay(_ display: Display)")
+	assert.Contains(t, contract, "Type<UtilityCoin.Display>() ,")
+	assert.Contains(t, contract, `name: UtilityCoin.display?.name ?? "UtilityCoin",`)
+	assert.Contains(t, contract, "self.totalSupply = 100.0")
+}
+
+func TestCustomTokenWithAdjustableMinterAllowance(t *testing.T) {
+	contract := string(customToken(t, "100.0"))
+	assert.NotContains(t, contract, "setMinterAllowance")
+	assert.NotContains(t, contract, "setAllowedAmount")
+
+	contract = string(customToken(t, "100.0", contracts.WithAdjustableMinterAllowance()))
+	assert.Contains(t, contract, "pub fun setMinterAllowance(minter: &Minter, allowedAmount: UFix64)")
+	assert.Contains(t, contract, "access(contract) fun setAllowedAmount(_ allowedAmount: UFix64)")
+
+	_, err := parser2.ParseProgram(contract, nil)
+	assert.NoError(t, err)
+
+	contract = string(customToken(t, "100.0", contracts.WithAdjustableMinterAllowance(), contracts.FixedSupply()))
+	assert.NotContains(t, contract, "setMinterAllowance")
+	assert.NotContains(t, contract, "setAllowedAmount")
+}
+
+func TestCustomTokenWithBurnReason(t *testing.T) {
+	contract := string(customToken(t, "100.0"))
+	assert.NotContains(t, contract, "TokensBurnedWithReason")
+
+	contract = string(customToken(t, "100.0", contracts.WithBurnReason()))
+	assert.Contains(t, contract, "pub event TokensBurnedWithReason(amount: UFix64, reason: String)")
+	assert.Contains(t, contract, "pub fun burnTokensWithReason(from: @FungibleToken.Vault, reason: String)")
+
+	_, err := parser2.ParseProgram(contract, nil)
+	assert.NoError(t, err)
+
+	contract = string(customToken(t, "100.0", contracts.WithBurnReason(), contracts.FixedSupply()))
+	assert.NotContains(t, contract, "TokensBurnedWithReason")
+	assert.NotContains(t, contract, "burnTokensWithReason")
+}
+
+func TestCustomTokenWithHeader(t *testing.T) {
+	header := "SPDX-License-Identifier: MIT\n\nExampleToken, issued by Example Inc.\n"
+
+	contract := string(customToken(t, "100.0", contracts.WithHeader(header)))
+	assert.True(t, strings.HasPrefix(contract, "// SPDX-License-Identifier: MIT\n//\n// ExampleToken, issued by Example Inc.\n\nimport FungibleToken from 0x"+addrA+"\n"), contract[:200])
+
+	_, err := parser2.ParseProgram(contract, nil)
+	assert.NoError(t, err)
+
+	unresolved, _ := contracts.HasUnresolvedImports([]byte(contract))
+	assert.False(t, unresolved)
+
+	t.Run("Should leave the contract unchanged without a header", func(t *testing.T) {
+		assert.Equal(t,
+			customToken(t, "100.0"),
+			customToken(t, "100.0", contracts.WithHeader("")),
+		)
+	})
+
+	t.Run("Should keep the imports after the header", func(t *testing.T) {
+		headerEnd := strings.Index(contract, "\n\n") + 2
+
+		locations := contracts.ImportLocations([]byte(contract))
+		require.Len(t, locations, 2)
+		assert.Equal(t, headerEnd, locations[0].Start)
+		assert.Equal(t, "FungibleToken", locations[0].Name)
+		assert.Equal(t, "MetadataViews", locations[1].Name)
+	})
+}
+
+func TestCustomTokenWithFixedSupply(t *testing.T) {
+	contract := string(customToken(t, "100.0", contracts.FixedSupply()))
+
+	assert.NotContains(t, contract, "pub resource Administrator")
+	assert.NotContains(t, contract, "pub resource Minter")
+	assert.NotContains(t, contract, "pub resource Burner")
+	assert.NotContains(t, contract, "mintTokens")
+	assert.NotContains(t, contract, "AdminStoragePath")
+	assert.NotContains(t, contract, "MinterCreated")
+	assert.NotContains(t, contract, "TokensMinted")
+
+	assert.Contains(t, contract, "pub resource Vault")
+	assert.Contains(t, contract, "self.totalSupply = 100.0")
+}
+
+func TestCustomTokenWithDisplayDecimals(t *testing.T) {
+	contract := string(customToken(t, "100.0"))
+	assert.NotContains(t, contract, "DisplayDecimals")
+
+	contract = string(customToken(t, "100.0", contracts.WithDisplayDecimals(2)))
+	assert.Contains(t, contract, "pub struct DisplayDecimals")
+	assert.Contains(t, contract, "Type<UtilityCoin.DisplayDecimals>() ,")
+	assert.Contains(t, contract, "return UtilityCoin.DisplayDecimals(decimals: 2)")
+}
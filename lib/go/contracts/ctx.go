@@ -0,0 +1,183 @@
+package contracts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/parser2"
+
+	"github.com/onflow/flow-ft/lib/go/contracts/internal/assets"
+)
+
+// LoadOption configures the context-aware loaders, e.g. FungibleTokenCtx.
+type LoadOption func(*loadConfig)
+
+type loadConfig struct {
+	strictValidation  bool
+	importAnnotations bool
+	importPathPrefix  string
+}
+
+// StrictValidation makes a context-aware loader parse the contract it returns
+// and check that all of its imports were resolved, returning an error if not.
+//
+// The context is checked before each step, so a cancelled context
+// stops the validation and its error is returned.
+func StrictValidation() LoadOption {
+	return func(config *loadConfig) {
+		config.strictValidation = true
+	}
+}
+
+// WithImportAnnotations makes a context-aware loader keep the path each resolved import
+// was imported from in a trailing comment, e.g. for documentation generated from the contract:
+//
+//	import FungibleToken from 0xf8d6e0586b0a20c7 // was "./FungibleToken.cdc"
+//
+// Imports that weren't imported from a path are left as they are.
+// Use AnnotateImports to annotate code that isn't returned by a context-aware loader.
+func WithImportAnnotations(enabled bool) LoadOption {
+	return func(config *loadConfig) {
+		config.importAnnotations = enabled
+	}
+}
+
+// WithImportPathPrefix makes a context-aware loader import every contract from a file
+// under the given path prefix instead of an address, e.g. for the file-based imports
+// of the Flow CLI:
+//
+//	import FungibleToken from "./FungibleToken.cdc"
+//
+// The addresses passed to the loader are ignored, so they can be empty.
+// Strict validation then checks the imports are paths, not that they were resolved.
+// Use ImportFromPaths to import code that isn't returned by a context-aware loader from paths.
+func WithImportPathPrefix(prefix string) LoadOption {
+	return func(config *loadConfig) {
+		config.importPathPrefix = prefix
+	}
+}
+
+// FungibleTokenCtx returns the FungibleToken contract interface like FungibleToken,
+// honoring cancellation of ctx while it is validated.
+func FungibleTokenCtx(ctx context.Context, opts ...LoadOption) ([]byte, error) {
+	return validateCtx(ctx, "FungibleToken", filenameFungibleToken, FungibleToken(), opts)
+}
+
+// NonFungibleTokenCtx returns the NonFungibleToken contract interface like NonFungibleToken,
+// honoring cancellation of ctx while it is validated.
+func NonFungibleTokenCtx(ctx context.Context, opts ...LoadOption) ([]byte, error) {
+	return validateCtx(ctx, "NonFungibleToken", filenameNonFungibleToken, NonFungibleToken(), opts)
+}
+
+// MetadataViewsCtx returns the MetadataViews contract like MetadataViews,
+// honoring cancellation of ctx while it is validated.
+func MetadataViewsCtx(ctx context.Context, fungibleTokenAddr, nonFungibleTokenAddr string, opts ...LoadOption) ([]byte, error) {
+	return validateCtx(ctx, "MetadataViews", filenameMetadataViews, MetadataViews(fungibleTokenAddr, nonFungibleTokenAddr), opts)
+}
+
+// ExampleTokenCtx returns the ExampleToken contract like ExampleToken,
+// honoring cancellation of ctx while it is validated.
+func ExampleTokenCtx(ctx context.Context, fungibleTokenAddr, metadataViewsAddr string, opts ...LoadOption) ([]byte, error) {
+	return validateCtx(ctx, "ExampleToken", filenameExampleToken, ExampleTokenWithMetadataViews(fungibleTokenAddr, metadataViewsAddr), opts)
+}
+
+// ExampleTokenLegacyCtx returns the frozen snapshot of the ExampleToken contract like ExampleTokenLegacy,
+// honoring cancellation of ctx while it is validated.
+func ExampleTokenLegacyCtx(ctx context.Context, fungibleTokenAddr string, opts ...LoadOption) ([]byte, error) {
+	return validateCtx(ctx, "ExampleToken", filenameLegacyExample, ExampleTokenLegacy(fungibleTokenAddr), opts)
+}
+
+// CustomTokenCtx returns the ExampleToken contract with a custom name like CustomTokenWithMetadataViews,
+// without any CustomTokenOptions, honoring cancellation of ctx while it is validated.
+func CustomTokenCtx(ctx context.Context, fungibleTokenAddr, metadataViewsAddr, tokenName, storageName, initialBalance string, opts ...LoadOption) ([]byte, error) {
+	code, err := CustomTokenWithMetadataViews(fungibleTokenAddr, metadataViewsAddr, tokenName, storageName, initialBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	return validateCtx(ctx, tokenName, filenameExampleToken, code, opts)
+}
+
+// WrapperTokenCtx returns the WrapperToken contract like WrapperToken,
+// honoring cancellation of ctx while it is validated.
+func WrapperTokenCtx(ctx context.Context, fungibleTokenAddr, underlyingTokenAddr, underlyingTokenName string, opts ...LoadOption) ([]byte, error) {
+	code := WrapperToken(fungibleTokenAddr, underlyingTokenAddr, underlyingTokenName)
+	return validateCtx(ctx, "WrapperToken", filenameWrapperToken, code, opts)
+}
+
+// MockTokenCtx returns the MockToken contract like MockToken,
+// honoring cancellation of ctx while it is validated.
+func MockTokenCtx(ctx context.Context, fungibleTokenAddr string, opts ...LoadOption) ([]byte, error) {
+	return validateCtx(ctx, "MockToken", filenameMockToken, MockToken(fungibleTokenAddr), opts)
+}
+
+// TokenForwardingCtx returns the TokenForwarding contract like TokenForwarding,
+// honoring cancellation of ctx while it is validated.
+func TokenForwardingCtx(ctx context.Context, fungibleTokenAddr string, opts ...LoadOption) ([]byte, error) {
+	return validateCtx(ctx, "TokenForwarding", filenameTokenForwarding, TokenForwarding(fungibleTokenAddr), opts)
+}
+
+// CustomTokenForwardingCtx returns the TokenForwarding contract for a custom token like CustomTokenForwarding,
+// honoring cancellation of ctx while it is validated.
+func CustomTokenForwardingCtx(ctx context.Context, fungibleTokenAddr, tokenName, storageName string, opts ...LoadOption) ([]byte, error) {
+	code := CustomTokenForwarding(fungibleTokenAddr, tokenName, storageName)
+	return validateCtx(ctx, "TokenForwarding", filenameTokenForwarding, code, opts)
+}
+
+// PrivateReceiverForwarderCtx returns the PrivateReceiverForwarder contract like PrivateReceiverForwarder,
+// honoring cancellation of ctx while it is validated.
+func PrivateReceiverForwarderCtx(ctx context.Context, fungibleTokenAddr string, opts ...LoadOption) ([]byte, error) {
+	return validateCtx(ctx, "PrivateReceiverForwarder", filenamePrivateForwarder, PrivateReceiverForwarder(fungibleTokenAddr), opts)
+}
+
+// TokenMemoCtx returns the TokenMemo contract like TokenMemo,
+// honoring cancellation of ctx while it is validated.
+func TokenMemoCtx(ctx context.Context, fungibleTokenAddr string, opts ...LoadOption) ([]byte, error) {
+	return validateCtx(ctx, "TokenMemo", filenameTokenMemo, TokenMemo(fungibleTokenAddr), opts)
+}
+
+// validateCtx returns code, the contract in filename with its imports resolved,
+// after annotating and validating it if the options enable it.
+// Loading the embedded code is not worth interrupting, so ctx is only checked
+// around the validation steps.
+func validateCtx(ctx context.Context, name, filename string, code []byte, opts []LoadOption) ([]byte, error) {
+	config := &loadConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	if config.importPathPrefix != "" {
+		code = ImportFromPaths(code, config.importPathPrefix)
+	}
+
+	if config.importAnnotations {
+		code = AnnotateImports(code, assets.MustAsset(filename))
+	}
+
+	if !config.strictValidation {
+		return code, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := parser2.ParseProgram(string(code), nil); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if config.importPathPrefix != "" {
+		return code, nil
+	}
+
+	if unresolved, paths := HasUnresolvedImports(code); unresolved {
+		return nil, fmt.Errorf("%s: unresolved imports:\n\t%s", name, strings.Join(paths, "\n\t"))
+	}
+
+	return code, nil
+}
@@ -0,0 +1,137 @@
+package contracts_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+func TestLoaderCtx(t *testing.T) {
+	t.Run("Should return the same code as the loaders", func(t *testing.T) {
+		code, err := contracts.FungibleTokenCtx(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, contracts.FungibleToken(), code)
+
+		code, err = contracts.ExampleTokenCtx(context.Background(), addrA, addrB, contracts.StrictValidation())
+		require.NoError(t, err)
+		assert.Equal(t, contracts.ExampleTokenWithMetadataViews(addrA, addrB), code)
+
+		code, err = contracts.MetadataViewsCtx(context.Background(), addrA, addrB, contracts.StrictValidation())
+		require.NoError(t, err)
+		assert.Equal(t, contracts.MetadataViews(addrA, addrB), code)
+
+		code, err = contracts.ExampleTokenLegacyCtx(context.Background(), addrA, contracts.StrictValidation())
+		require.NoError(t, err)
+		assert.Equal(t, contracts.ExampleTokenLegacy(addrA), code)
+
+		code, err = contracts.CustomTokenCtx(context.Background(), addrA, addrB, "UtilityCoin", "utilityCoin", "100.0", contracts.StrictValidation())
+		require.NoError(t, err)
+		assert.Equal(t, customToken(t, "100.0"), code)
+
+		code, err = contracts.WrapperTokenCtx(context.Background(), addrA, addrB, "UtilityCoin", contracts.StrictValidation())
+		require.NoError(t, err)
+		assert.Equal(t, contracts.WrapperToken(addrA, addrB, "UtilityCoin"), code)
+
+		code, err = contracts.MockTokenCtx(context.Background(), addrA, contracts.StrictValidation())
+		require.NoError(t, err)
+		assert.Equal(t, contracts.MockToken(addrA), code)
+
+		code, err = contracts.CustomTokenForwardingCtx(context.Background(), addrA, "UtilityCoin", "utilityCoin", contracts.StrictValidation())
+		require.NoError(t, err)
+		assert.Equal(t, contracts.CustomTokenForwarding(addrA, "UtilityCoin", "utilityCoin"), code)
+	})
+
+	t.Run("Should return the context's error when it is cancelled during strict validation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		code, err := contracts.FungibleTokenCtx(ctx, contracts.StrictValidation())
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, ctx.Err(), err)
+		assert.Nil(t, code)
+
+		_, err = contracts.TokenForwardingCtx(ctx, addrA, contracts.StrictValidation())
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("Should ignore a cancelled context without strict validation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		code, err := contracts.FungibleTokenCtx(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, contracts.FungibleToken(), code)
+	})
+}
+
+func TestLoaderCtxWithImportAnnotations(t *testing.T) {
+	code, err := contracts.ExampleTokenCtx(context.Background(), addrA, addrB, contracts.WithImportAnnotations(true))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(code), "import FungibleToken from 0x"+addrA+` // was "./FungibleToken.cdc"`)
+	assert.Contains(t, string(code), "import MetadataViews from 0x"+addrB+` // was "./MetadataViews.cdc"`)
+
+	_, err = parser2.ParseProgram(string(code), nil)
+	assert.NoError(t, err)
+
+	t.Run("Should pass strict validation", func(t *testing.T) {
+		_, err := contracts.ExampleTokenCtx(context.Background(), addrA, addrB, contracts.WithImportAnnotations(true), contracts.StrictValidation())
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should not annotate imports that weren't imported from a path", func(t *testing.T) {
+		code, err := contracts.MetadataViewsCtx(context.Background(), addrA, addrB, contracts.WithImportAnnotations(true))
+		require.NoError(t, err)
+		assert.Equal(t, contracts.MetadataViews(addrA, addrB), code)
+	})
+
+	t.Run("Should not annotate when disabled", func(t *testing.T) {
+		code, err := contracts.ExampleTokenCtx(context.Background(), addrA, addrB, contracts.WithImportAnnotations(false))
+		require.NoError(t, err)
+		assert.Equal(t, contracts.ExampleTokenWithMetadataViews(addrA, addrB), code)
+	})
+}
+
+func TestLoaderCtxWithImportPathPrefix(t *testing.T) {
+	code, err := contracts.ExampleTokenCtx(context.Background(), addrA, addrB, contracts.WithImportPathPrefix("./"))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(code), `import FungibleToken from "./FungibleToken.cdc"`)
+	assert.Contains(t, string(code), `import MetadataViews from "./MetadataViews.cdc"`)
+	assert.NotContains(t, string(code), "0x"+addrA)
+	assert.NotContains(t, string(code), "0x"+addrB)
+
+	_, err = parser2.ParseProgram(string(code), nil)
+	assert.NoError(t, err)
+
+	t.Run("Should pass strict validation", func(t *testing.T) {
+		_, err := contracts.ExampleTokenCtx(context.Background(), addrA, addrB, contracts.WithImportPathPrefix("./"), contracts.StrictValidation())
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should replace imports of placeholder addresses", func(t *testing.T) {
+		code, err := contracts.MetadataViewsCtx(context.Background(), addrA, addrB, contracts.WithImportPathPrefix("../contracts"))
+		require.NoError(t, err)
+
+		assert.Contains(t, string(code), `import FungibleToken from "../contracts/FungibleToken.cdc"`)
+		assert.Contains(t, string(code), `import NonFungibleToken from "../contracts/NonFungibleToken.cdc"`)
+		assert.NotContains(t, string(code), "0x"+addrA)
+	})
+
+	t.Run("Should ignore the addresses", func(t *testing.T) {
+		ignored, err := contracts.ExampleTokenCtx(context.Background(), "", "", contracts.WithImportPathPrefix("./"))
+		require.NoError(t, err)
+		assert.Equal(t, code, ignored)
+	})
+
+	t.Run("Should not annotate imports from the original path", func(t *testing.T) {
+		annotated, err := contracts.ExampleTokenCtx(context.Background(), addrA, addrB, contracts.WithImportPathPrefix("."), contracts.WithImportAnnotations(true))
+		require.NoError(t, err)
+		assert.Equal(t, code, annotated)
+	})
+}
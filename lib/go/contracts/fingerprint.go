@@ -0,0 +1,44 @@
+package contracts
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+
+	"github.com/onflow/flow-ft/lib/go/contracts/internal/assets"
+)
+
+// SuiteFingerprint returns a SHA-256 digest of every contract this package embeds,
+// before any imports or names are substituted, as a hex string.
+//
+// The digest only changes when an embedded contract is changed, added, removed or renamed,
+// so it can be pinned to detect changes to the contracts between versions of this package.
+func SuiteFingerprint() string {
+	return fingerprint(assets.AssetNames(), assets.MustAsset)
+}
+
+// fingerprint hashes the named files in order of their names.
+// Each name and file is prefixed with its length, so moving bytes
+// from the end of one file to the start of the next changes the digest
+func fingerprint(names []string, asset func(name string) []byte) string {
+	sorted := append([]string{}, names...)
+	sort.Strings(sorted)
+
+	hash := sha256.New()
+	length := make([]byte, 8)
+
+	for _, name := range sorted {
+		code := asset(name)
+
+		binary.BigEndian.PutUint64(length, uint64(len(name)))
+		hash.Write(length)
+		hash.Write([]byte(name))
+
+		binary.BigEndian.PutUint64(length, uint64(len(code)))
+		hash.Write(length)
+		hash.Write(code)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,52 @@
+package contracts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/flow-ft/lib/go/contracts/internal/assets"
+)
+
+func TestSuiteFingerprint(t *testing.T) {
+	suite := SuiteFingerprint()
+	assert.Len(t, suite, 64)
+	assert.Equal(t, suite, SuiteFingerprint())
+
+	t.Run("Should not depend on the order of the assets", func(t *testing.T) {
+		names := assets.AssetNames()
+		reversed := make([]string, len(names))
+		for i, name := range names {
+			reversed[len(names)-1-i] = name
+		}
+
+		assert.Equal(t, suite, fingerprint(reversed, assets.MustAsset))
+	})
+
+	t.Run("Should change if an embedded file changes", func(t *testing.T) {
+		stubbed := func(name string) []byte {
+			code := assets.MustAsset(name)
+			if name == filenameTokenForwarding {
+				return append(append([]byte{}, code...), '\n')
+			}
+
+			return code
+		}
+
+		assert.NotEqual(t, suite, fingerprint(assets.AssetNames(), stubbed))
+	})
+
+	t.Run("Should change if a file moves between names", func(t *testing.T) {
+		files := map[string][]byte{"A.cdc": []byte("ab"), "B.cdc": []byte("c")}
+		moved := map[string][]byte{"A.cdc": []byte("a"), "B.cdc": []byte("bc")}
+
+		asset := func(files map[string][]byte) func(string) []byte {
+			return func(name string) []byte {
+				return files[name]
+			}
+		}
+
+		names := []string{"A.cdc", "B.cdc"}
+		assert.NotEqual(t, fingerprint(names, asset(files)), fingerprint(names, asset(moved)))
+	})
+}
@@ -3,6 +3,14 @@ module github.com/onflow/flow-ft/lib/go/contracts
 go 1.16
 
 require (
+	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/kevinburke/go-bindata v3.22.0+incompatible
-	github.com/stretchr/testify v1.6.1
+	github.com/onflow/cadence v0.21.3-0.20220422215834-5ba7ff3666fd
+	github.com/stretchr/testify v1.7.1-0.20210824115523-ab6dc3262822
+	golang.org/x/net v0.0.0-20220127200216-cd36cc0744dd // indirect
+	golang.org/x/sys v0.0.0-20220209214540-3681064d5158 // indirect
+	golang.org/x/text v0.3.7 // indirect
+	google.golang.org/genproto v0.0.0-20220222213610-43724f9ea8cf // indirect
+	google.golang.org/grpc v1.44.0
+	google.golang.org/protobuf v1.27.1 // indirect
 )
@@ -0,0 +1,194 @@
+package contracts
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	placeholderImport = regexp.MustCompile(`import\s+\w+\s+from\s+"([^"\s]*/[^"\s/]+\.cdc)"`)
+	importStatement   = regexp.MustCompile(`(?m)^[ \t]*(import\s+(\w+)\s+from\s+(?:"[^"\n]*"|0x\w*))`)
+)
+
+// importPlaceholders maps each contract name to the pattern
+// that matches its import path in the embedded contracts
+var importPlaceholders = map[string]*regexp.Regexp{
+	"FungibleToken": placeholderFungibleToken,
+	"ExampleToken":  placeholderExampleToken,
+	"MetadataViews": placeholderMetadataViews,
+}
+
+// ReplaceImports replaces the import path of each contract in addresses
+// with the address it maps to.
+//
+// addresses is keyed by contract name, and any contract can be named,
+// e.g. the underlying token imported by a wrapper token.
+// Like the other functions in this package, it expects addresses without a 0x prefix.
+func ReplaceImports(code []byte, addresses map[string]string) []byte {
+	for name, address := range addresses {
+		placeholder, ok := importPlaceholders[name]
+		if !ok {
+			placeholder = regexp.MustCompile(fmt.Sprintf(`"[^"\s].*/%s\.cdc"`, regexp.QuoteMeta(name)))
+		}
+
+		code = placeholder.ReplaceAll(code, []byte("0x"+address))
+	}
+
+	return code
+}
+
+// ImportPatterns returns the patterns this package uses to find import paths,
+// keyed by the name of the imported contract.
+//
+// The returned map and patterns are copies, so changing them
+// does not affect how this package resolves imports.
+func ImportPatterns() map[string]*regexp.Regexp {
+	patterns := make(map[string]*regexp.Regexp, len(importPlaceholders))
+	for name, pattern := range importPlaceholders {
+		patterns[name] = regexp.MustCompile(pattern.String())
+	}
+
+	return patterns
+}
+
+// HasUnresolvedImports reports whether the given code still imports
+// any contract from a file path (e.g. "../contracts/FungibleToken.cdc").
+//
+// The returned list contains every unresolved import path, in the order
+// it appears in the code.
+func HasUnresolvedImports(code []byte) (bool, []string) {
+	matches := placeholderImport.FindAllSubmatch(code, -1)
+
+	paths := make([]string, 0, len(matches))
+	for _, match := range matches {
+		paths = append(paths, string(match[1]))
+	}
+
+	return len(paths) > 0, paths
+}
+
+// ImportLocation is the position of an import statement in a contract's code.
+type ImportLocation struct {
+	// Name is the name of the imported contract
+	Name string
+	// Start is the byte offset of the import keyword
+	Start int
+	// End is the byte offset right after the imported location,
+	// i.e. code[Start:End] is the whole import statement
+	End int
+}
+
+// ImportLocations returns the location of every import statement in the code
+// that imports a contract from a file path or an address, in the order they appear.
+//
+// The code doesn't need to be valid, so it can be used on code being edited.
+func ImportLocations(code []byte) []ImportLocation {
+	matches := importStatement.FindAllSubmatchIndex(code, -1)
+
+	locations := make([]ImportLocation, 0, len(matches))
+	for _, match := range matches {
+		locations = append(locations, ImportLocation{
+			Name:  string(code[match[4]:match[5]]),
+			Start: match[2],
+			End:   match[3],
+		})
+	}
+
+	return locations
+}
+
+// AnnotateImports appends a comment with the original import path to each import in code
+// that original imported from a path, e.g. after the imports were resolved with ReplaceImports:
+//
+//	import FungibleToken from 0xf8d6e0586b0a20c7 // was "./FungibleToken.cdc"
+//
+// Imports that original didn't import from a path, or that code still imports
+// from the same path, are left as they are.
+func AnnotateImports(code, original []byte) []byte {
+	originalPaths := make(map[string]string)
+	for _, location := range ImportLocations(original) {
+		statement := string(original[location.Start:location.End])
+		if path := strings.Index(statement, `"`); path >= 0 {
+			originalPaths[location.Name] = statement[path:]
+		}
+	}
+
+	annotated := make([]byte, 0, len(code))
+	previousEnd := 0
+	for _, location := range ImportLocations(code) {
+		path, ok := originalPaths[location.Name]
+		if !ok || bytes.HasSuffix(code[location.Start:location.End], []byte(path)) {
+			continue
+		}
+
+		annotated = append(annotated, code[previousEnd:location.End]...)
+		annotated = append(annotated, " // was "+path...)
+		previousEnd = location.End
+	}
+
+	return append(annotated, code[previousEnd:]...)
+}
+
+// ImportFromPaths replaces each import in code with an import of the contract's file
+// under prefix, e.g. "./FungibleToken.cdc" for the prefix ".", whether it was imported
+// from an address or another path.
+//
+// The imported addresses are discarded, so code can be loaded with any addresses,
+// e.g. empty ones, before it is passed to ImportFromPaths.
+func ImportFromPaths(code []byte, prefix string) []byte {
+	prefix = strings.TrimSuffix(prefix, "/")
+
+	replaced := make([]byte, 0, len(code))
+	previousEnd := 0
+	for _, location := range ImportLocations(code) {
+		replaced = append(replaced, code[previousEnd:location.Start]...)
+		replaced = append(replaced, fmt.Sprintf(`import %s from "%s/%s.cdc"`, location.Name, prefix, location.Name)...)
+		previousEnd = location.End
+	}
+
+	return append(replaced, code[previousEnd:]...)
+}
+
+// DeduplicateImports removes all but one import of each contract the code imports more than once,
+// e.g. after an option added an import the code already had.
+//
+// The import that is kept is the first one from an address, so a resolved import
+// is kept over one from a file path, or the first one if none is from an address.
+// The lines of the other imports are removed.
+func DeduplicateImports(code []byte) []byte {
+	locations := ImportLocations(code)
+
+	kept := make(map[string]int, len(locations))
+	for i, location := range locations {
+		keptIndex, ok := kept[location.Name]
+		if !ok || (!isAddressImport(code, locations[keptIndex]) && isAddressImport(code, location)) {
+			kept[location.Name] = i
+		}
+	}
+
+	deduplicated := make([]byte, 0, len(code))
+	previousEnd := 0
+	for i, location := range locations {
+		if kept[location.Name] == i {
+			continue
+		}
+
+		lineStart := bytes.LastIndexByte(code[:location.Start], '\n') + 1
+		lineEnd := len(code)
+		if newline := bytes.IndexByte(code[location.End:], '\n'); newline >= 0 {
+			lineEnd = location.End + newline + 1
+		}
+
+		deduplicated = append(deduplicated, code[previousEnd:lineStart]...)
+		previousEnd = lineEnd
+	}
+
+	return append(deduplicated, code[previousEnd:]...)
+}
+
+// isAddressImport reports whether the import at the location is from an address
+func isAddressImport(code []byte, location ImportLocation) bool {
+	return !bytes.HasSuffix(code[location.Start:location.End], []byte(`"`))
+}
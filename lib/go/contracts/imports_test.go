@@ -0,0 +1,172 @@
+package contracts_test
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+func TestHasUnresolvedImports(t *testing.T) {
+	t.Run("Resolved contracts should have no unresolved imports", func(t *testing.T) {
+		unresolved, paths := contracts.HasUnresolvedImports(contracts.ExampleTokenWithMetadataViews(addrA, addrB))
+		assert.False(t, unresolved)
+		assert.Empty(t, paths)
+
+		unresolved, paths = contracts.HasUnresolvedImports(contracts.TokenForwarding(addrA))
+		assert.False(t, unresolved)
+		assert.Empty(t, paths)
+	})
+
+	t.Run("Should report an import the loader did not handle", func(t *testing.T) {
+		code := []byte(`
+			import FungibleToken from 0x0A
+			import MetadataViews from "./MetadataViews.cdc"
+
+			pub contract Test {}
+		`)
+
+		unresolved, paths := contracts.HasUnresolvedImports(code)
+		assert.True(t, unresolved)
+		assert.Equal(t, []string{"./MetadataViews.cdc"}, paths)
+	})
+}
+
+func TestImportPatterns(t *testing.T) {
+	patterns := contracts.ImportPatterns()
+
+	require.Contains(t, patterns, "FungibleToken")
+	require.Contains(t, patterns, "ExampleToken")
+	require.Contains(t, patterns, "MetadataViews")
+
+	assert.True(t, patterns["FungibleToken"].MatchString(`import FungibleToken from "./FungibleToken.cdc"`))
+	assert.True(t, patterns["ExampleToken"].MatchString(`import ExampleToken from "../contracts/ExampleToken.cdc"`))
+
+	t.Run("Should return a copy", func(t *testing.T) {
+		delete(patterns, "FungibleToken")
+		patterns["ExampleToken"] = regexp.MustCompile(`nothing`)
+
+		fresh := contracts.ImportPatterns()
+		require.Contains(t, fresh, "FungibleToken")
+		assert.True(t, fresh["ExampleToken"].MatchString(`"./ExampleToken.cdc"`))
+
+		assert.Contains(t, string(contracts.ExampleTokenWithMetadataViews(addrA, addrB)), "0x"+addrA)
+	})
+}
+
+func TestReplaceImports(t *testing.T) {
+	code := []byte(`
+		import FungibleToken from "./FungibleToken.cdc"
+		import UnderlyingToken from "../contracts/UnderlyingToken.cdc"
+	`)
+
+	code = contracts.ReplaceImports(code, map[string]string{
+		"FungibleToken":   addrA,
+		"UnderlyingToken": addrB,
+	})
+
+	assert.Contains(t, string(code), "import FungibleToken from 0x"+addrA)
+	assert.Contains(t, string(code), "import UnderlyingToken from 0x"+addrB)
+
+	unresolved, _ := contracts.HasUnresolvedImports(code)
+	assert.False(t, unresolved)
+}
+
+func TestAnnotateImports(t *testing.T) {
+	original := []byte(`
+		import FungibleToken from "./FungibleToken.cdc"
+		import UnderlyingToken from "../contracts/UnderlyingToken.cdc"
+		import MetadataViews from 0x0B
+	`)
+
+	code := contracts.ReplaceImports(original, map[string]string{
+		"FungibleToken": addrA,
+	})
+
+	code = contracts.AnnotateImports(code, original)
+
+	assert.Contains(t, string(code), "import FungibleToken from 0x"+addrA+` // was "./FungibleToken.cdc"`+"\n")
+	assert.Contains(t, string(code), `import UnderlyingToken from "../contracts/UnderlyingToken.cdc"`+"\n")
+	assert.Contains(t, string(code), "import MetadataViews from 0x0B\n")
+	assert.NotContains(t, string(code), `"../contracts/UnderlyingToken.cdc" // was`)
+}
+
+func TestImportFromPaths(t *testing.T) {
+	code := contracts.ImportFromPaths(contracts.ExampleTokenWithMetadataViews("", ""), "../contracts/")
+
+	assert.Contains(t, string(code), `import FungibleToken from "../contracts/FungibleToken.cdc"`)
+	assert.Contains(t, string(code), `import MetadataViews from "../contracts/MetadataViews.cdc"`)
+	assert.NotContains(t, string(code), "from 0x")
+}
+
+func TestImportLocations(t *testing.T) {
+	code := contracts.ExampleTokenWithMetadataViews(addrA, addrB)
+
+	locations := contracts.ImportLocations(code)
+	require.Len(t, locations, 2)
+
+	assert.Equal(t, "FungibleToken", locations[0].Name)
+	assert.Equal(t, "import FungibleToken from 0x"+addrA, string(code[locations[0].Start:locations[0].End]))
+
+	assert.Equal(t, "MetadataViews", locations[1].Name)
+	assert.Equal(t, "import MetadataViews from 0x"+addrB, string(code[locations[1].Start:locations[1].End]))
+
+	t.Run("Should bound the import lines exactly", func(t *testing.T) {
+		lines := bytes.SplitAfter(code, []byte("\n"))
+
+		offset := 0
+		for i, location := range locations {
+			assert.Equal(t, offset, location.Start)
+			assert.Equal(t, offset+len(bytes.TrimSuffix(lines[i], []byte("\n"))), location.End)
+
+			offset += len(lines[i])
+		}
+	})
+
+	t.Run("Should locate indented imports of file paths", func(t *testing.T) {
+		code := []byte("pub contract Test {}\n\n  import FungibleToken from \"./FungibleToken.cdc\"\n")
+
+		locations := contracts.ImportLocations(code)
+		require.Len(t, locations, 1)
+
+		assert.Equal(t, "FungibleToken", locations[0].Name)
+		assert.Equal(t, 24, locations[0].Start)
+		assert.Equal(t, len(code)-1, locations[0].End)
+	})
+}
+
+func TestDeduplicateImports(t *testing.T) {
+	code := []byte(`import FungibleToken from "./FungibleToken.cdc"
+import MetadataViews from 0x0B
+import FungibleToken from 0x0A
+    import FungibleToken from 0x0C // added by an option
+
+pub contract Test {}
+`)
+
+	deduplicated := contracts.DeduplicateImports(code)
+
+	assert.Equal(t, `import MetadataViews from 0x0B
+import FungibleToken from 0x0A
+
+pub contract Test {}
+`, string(deduplicated))
+
+	locations := contracts.ImportLocations(deduplicated)
+	require.Len(t, locations, 2)
+
+	t.Run("Should keep the first import if none are resolved", func(t *testing.T) {
+		code := []byte("import FungibleToken from \"./FungibleToken.cdc\"\nimport FungibleToken from \"../FungibleToken.cdc\"")
+
+		assert.Equal(t, "import FungibleToken from \"./FungibleToken.cdc\"\n", string(contracts.DeduplicateImports(code)))
+	})
+
+	t.Run("Should leave code without duplicates unchanged", func(t *testing.T) {
+		code := contracts.ExampleTokenWithMetadataViews(addrA, addrB)
+		assert.Equal(t, code, contracts.DeduplicateImports(code))
+	})
+}
@@ -1,9 +1,15 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// ../../../contracts/ExampleToken.cdc (7.845kB)
-// ../../../contracts/FungibleToken.cdc (7.268kB)
+// ../../../contracts/ExampleToken.cdc (10.905kB)
+// ../../../contracts/FungibleToken.cdc (7.27kB)
+// ../../../contracts/MetadataViews.cdc (28.2kB)
+// ../../../contracts/MockToken.cdc (3.445kB)
+// ../../../contracts/WrapperToken.cdc (4.028kB)
+// ../../../contracts/legacy/ExampleToken.cdc (7.845kB)
+// ../../../contracts/utilityContracts/NonFungibleToken.cdc (3.466kB)
 // ../../../contracts/utilityContracts/PrivateReceiverForwarder.cdc (2.601kB)
 // ../../../contracts/utilityContracts/TokenForwarding.cdc (2.353kB)
+// ../../../contracts/utilityContracts/TokenMemo.cdc (1.122kB)
 
 package assets
 
@@ -73,7 +79,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _exampletokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x51\x6f\xe4\xb6\x11\x7e\xf7\xaf\x98\xde\x43\xbb\x46\xec\xb5\x0b\x14\x7d\x30\x7c\xc9\xdd\xb5\x77\x40\x1e\x1a\x1c\x92\xb4\x7d\x35\x25\xcd\x7a\xd9\x93\xc8\x05\x49\xed\x7a\x63\xf8\xbf\x17\x33\x24\x25\x92\x92\xd6\x76\x8c\xdc\xcb\x79\x25\xce\x70\x38\xfc\xe6\x9b\x8f\x94\xec\x76\xda\x38\xf8\xd2\xab\x7b\x59\xb5\xf8\xab\xfe\x86\x0a\x36\x46\x77\xf0\x6e\x7d\x95\x3d\x5d\xd7\x4d\xfd\xee\xec\x6c\xd7\x57\x50\x6b\xe5\x8c\xa8\x1d\x7c\x7e\x10\xdd\x2e\xbc\xbf\x29\x9c\x3c\x9e\x9d\x01\x00\x5c\x5d\x5d\xc1\xaf\xda\x89\x16\x6c\xbf\xdb\xb5\x47\xd0\x9b\xcc\xcc\x82\x54\x80\x0f\xd2\x3a\x54\x35\xb2\x09\x4d\xb1\x17\x06\x1c\x99\xfd\xc2\x56\x37\xf0\xef\x2f\xf2\xe1\xef\x7f\x1b\x7d\xfe\xe2\xb4\x11\xf7\x08\x42\x35\xf0\xb5\xaf\x5a\x59\xc3\x57\xe1\xb6\x76\xf0\xd0\xa2\x83\xff\x88\xbe\x75\x61\x24\xbd\xbd\x81\xe4\x47\x36\xf2\x67\xac\x51\xee\xd1\x78\x57\x7e\xec\xf8\x77\x36\xf4\x93\x68\x85\xaa\xf1\x05\x23\x3f\x36\x9d\x54\x8b\xd3\x27\xe9\xa1\x3c\xfc\xa8\xa4\x93\xa2\x95\xbf\x61\x13\xdf\x8c\x23\xb6\x08\xb8\x47\xe5\xc0\x6d\x85\x03\x69\x01\x3b\xe9\x1c\x36\x70\xd8\xa2\x02\xb7\xc5\x71\x4f\xa4\x85\xda\xa0\x70\xc1\x0d\xc5\xe2\x4d\x27\xd3\xac\xa4\xff\x3b\x4f\xf1\x79\x19\xd8\x7f\xa5\xdb\x36\x46\x1c\xd4\xab\xc3\xf2\xfb\x2b\x0c\xc2\x21\xfa\xf0\xd8\x12\x7e\x67\x66\x03\x1c\xa6\x5b\x89\x4e\xf7\xca\xc5\xb8\x2e\xd8\xf4\x06\x3e\x36\x8d\x41\x6b\x7f\x98\xc4\xf9\x4f\xdc\x69\x2b\xdd\xef\x48\xdf\x18\x67\x13\x7d\x80\xd3\x27\xa3\x1c\x26\x9b\x44\xe9\xf4\x89\x18\xff\x25\xd5\xef\x08\x50\xe1\x21\x0d\xb2\x1b\x9d\x94\x61\x79\xff\x45\x4c\x93\x28\x3e\xf5\x46\xbd\x31\x4d\xd6\x19\x7d\x5c\x08\xc2\xbb\x5f\x0e\x82\x83\x34\xff\x48\x40\xfa\x8a\x28\x04\x67\x83\x53\x60\xc0\xa0\xd5\xbd\xa9\x71\x19\xf4\xd9\x5c\x2b\xd1\xb6\xfa\x80\xcd\xc7\xa5\xc8\x38\xf2\xb7\x45\x56\xb1\x8b\x17\x44\x96\xcd\xb5\x4a\x82\x18\x41\x97\x4e\xfe\x59\xd4\x5b\xe8\x2d\x1a\xb0\x4e\x1b\xb4\x20\x14\x48\x65\x1d\x51\x11\x71\xaa\x56\xed\x91\x89\x80\xcd\x89\x54\xdd\x16\xa5\x1f\x2d\xee\x31\x5b\xc4\xa6\x57\xb5\x93\xda\x73\xef\x68\x43\x54\x7a\xaf\xf7\x48\xbb\x07\x95\xf7\xb6\x33\x9e\x62\x77\xda\x3a\xe2\x98\x46\xb2\xe1\xe0\x4e\xaa\x82\xf6\x23\x21\x1d\x19\x28\xb5\x68\x5b\x6c\xd6\xd9\xec\xf5\x16\xeb\x6f\x16\xb6\x62\xb7\xa3\xac\x39\x30\xbd\x72\xb2\x43\x36\xc5\x3d\x1a\x10\x43\x84\x9c\xbe\xdc\xc7\xe0\xeb\xe7\x90\x62\x1a\xa1\xfc\xfa\x2b\x8c\xc9\x8e\x2b\x23\x5a\xc4\x07\x47\x19\xca\x58\x92\x77\x90\xc2\x1c\xdc\x79\x5c\x6f\xa4\x62\xe3\x0b\xb0\x9a\xde\x1b\xde\x41\xa5\xe1\x20\x8e\xb0\xd1\x14\x5b\x27\x5a\x59\x4b\xdd\x5b\xbf\x1d\x4e\x87\x39\x7d\x16\xc7\xd4\xe8\x3e\x4c\x2b\x15\x08\x69\xd6\xf0\x11\xec\x0e\x6b\x29\xda\x80\xca\x11\x24\x0a\xb1\xb1\xe4\xa9\x1a\x63\x70\x9a\x51\x3e\xb8\x1b\x49\x20\x4f\x05\x21\x6a\x70\xc4\x21\x14\x9d\x78\xfd\xd5\xe8\xbd\x6c\xd0\x5c\x14\xcf\x63\xcf\x2b\x9f\x87\x06\x17\x3b\x78\xba\x77\xdc\x92\xa1\x0a\x03\xfc\xea\x2c\xec\x07\xc4\xa6\xed\x3b\x8c\xca\x5b\xb7\x77\x06\x72\xe8\x42\xbc\x2d\xd1\x21\x81\x21\x2e\x85\x93\x4a\x10\x20\x6c\x0c\xb6\x64\xb8\x2a\x3c\x9f\xc3\xe3\xf0\x9e\xfe\x59\x6c\x37\xeb\xe8\xf2\x7d\x74\x3e\x0c\x79\xca\x97\x15\x5b\x53\xfa\x30\x1b\xf0\x25\x62\xd1\x63\x46\x7c\xf3\xc5\xe7\xe9\x0d\x84\xff\x61\xee\xfb\x0e\x95\xcb\x0c\xa9\x6e\xa2\x77\xeb\xad\x83\x11\x37\xc1\xa1\xf0\xd6\x8b\x53\xff\xe8\x02\xb6\x6c\x60\x17\x87\xa4\xd7\x84\x39\x86\x92\x8d\x44\xd4\x5b\x8f\x98\xad\x6e\x9b\xcc\x03\x4d\xd2\x69\x85\xc7\x61\x68\x85\x52\xdd\x83\x33\x42\xd9\x0d\x1a\x83\xcd\x9a\xa6\x31\xe8\x7a\xa3\x2c\x8f\x57\x78\x68\x8f\x99\x97\x58\x54\x61\x52\x9d\x95\x16\x3b\xf6\x45\x4a\x45\x23\x1d\xd7\x63\x95\x34\xd3\xcc\x17\xb6\x16\x0f\x54\x58\xf3\xcb\x26\xf4\x6c\x7a\x35\x24\xae\x6c\x23\x37\xf0\x21\x47\xab\x8f\xe9\x24\x02\xb2\x9f\x97\x61\x13\x32\x03\x22\xf2\x45\xfd\xe1\xff\x8f\xfa\x83\x9d\xe9\x83\x42\xf3\xc3\x5a\xf8\x3e\x7f\x9e\xf9\xf2\xa9\x84\xdb\xcb\x94\x16\x46\xcc\x7a\x6f\xe7\x4b\x70\x0c\x49\x7b\x1d\x1a\xc3\xc6\xe8\xea\x7f\x58\x97\x90\x64\x18\x8a\xa6\xb1\x99\x1b\xe9\xec\x50\x75\x61\x3f\xb3\xaa\x46\xe0\x25\xda\x17\x20\x54\x5a\x08\x7d\x95\x3c\x05\x69\xc0\x2e\x2c\x4d\xef\x43\xab\xb0\x16\xbd\xc5\x11\xf4\x79\x0d\x52\xc8\x09\xb8\x09\xc6\x68\x62\x24\x81\xf5\x98\x80\xd8\xf6\x2f\x63\xec\x5b\x91\xaf\xab\x42\x54\x84\x4c\xdb\x77\xd8\xf0\xd2\x99\xc4\x37\x9a\x9b\x51\x80\x65\x10\x2f\xa7\x01\x18\x36\x62\xe5\x77\x7d\x0e\x74\x25\xef\x90\xe4\x67\x2a\x84\xdb\xcb\xa0\x73\xed\x9f\xe0\x43\x7a\xda\x59\xe7\x6b\x7f\x0e\xab\xdf\x79\x7f\xeb\x92\xc2\x0a\xc8\x4e\xc5\x68\x66\xe6\x35\xe9\xb3\xb8\xcd\x6c\xe0\x3d\x5c\xaf\xaf\xb3\xf7\x71\x67\x73\xb6\x4f\xe0\x1b\x06\xac\xca\xbc\x64\x09\x48\x0e\x75\xf0\x7e\xf9\xd5\x65\x96\x88\x64\xb6\x64\xce\x91\x99\x3e\x77\x3b\x77\x9c\xd7\x4d\x79\xbd\xe4\x6c\xea\xa1\x49\x4c\x03\x22\x85\xff\x6f\x68\xf4\xa8\x0b\x54\x33\xb0\xa3\x1c\xc9\x4f\xb4\x2d\xf1\x68\x20\x41\x6a\xee\xac\x06\xba\xde\x7a\x32\xf4\x9d\x31\xea\x98\xcc\x1b\x0b\x38\xf6\xe2\xfd\x0e\xc4\x5a\x8a\x36\x7a\xa0\x4d\xe3\x45\x06\xd7\x98\x7f\x3f\x7a\xab\x6b\xee\x27\x5e\x39\x88\xaa\xe5\x62\x36\xbe\xaf\x47\x04\xdb\xa1\x4f\x73\x21\x81\x3b\xee\x70\x2a\x21\x08\xf2\x65\x32\x57\xc4\xb6\x25\xbf\x3e\x43\x6f\xd7\xeb\xeb\xf3\x74\x93\x32\x79\xc2\x07\x62\x69\x9d\x11\x4e\x9b\x52\x5f\x78\x7f\x3f\xe1\xc1\xab\xa3\x17\x32\xe0\xb0\xa3\xc9\x36\xcd\x9e\x11\x4e\x16\x7b\x31\xf7\xc2\x41\xe1\x06\x3e\x04\xe5\xf6\x38\x2d\xc5\x93\x27\x8d\xec\xe7\xe9\x76\x31\x1f\xc1\x82\x83\xa7\x85\x14\xfa\xc3\xc5\x9b\x53\x58\x1c\x66\x5e\x96\x42\x3f\x37\x63\xc7\xff\x39\x97\xad\xf2\xf4\x73\x2a\x23\xd1\xe1\x32\x0b\x24\x88\x99\x3b\x21\xc4\xc6\xe8\x5b\x26\x17\x81\x20\x24\xc6\xfa\xf1\x27\x08\x6a\x3a\x51\x75\xbf\x4c\x6d\x0f\x60\x98\xe8\xe4\xa0\xf3\xa8\xf0\xfc\xa9\x39\x9e\x37\x22\x2a\xf3\xa6\x39\x08\x7d\x48\xf4\xf3\x2c\x06\xf3\xa9\xc8\xce\xb7\x80\x17\x6e\x35\x19\xd8\x64\x71\x17\xac\x0c\x28\xb0\x2e\x32\x9b\x4b\x2e\xeb\x2e\x26\x7a\x36\xd1\x89\xdd\x12\x17\x9e\x84\xc9\x18\xf2\x8c\xb2\x9b\xb6\xca\x02\x3b\x74\x1c\xcd\x9f\xd0\xbf\x90\xed\xef\x89\x7c\x6e\xe0\x9d\xcf\x58\xb8\x26\xf1\x8c\x5c\x21\xdc\x33\x98\x0c\xe5\x41\x31\xc3\xbf\x5b\xf2\x73\x1b\xba\x70\xb1\x01\x0b\x7e\x5b\xb4\xd6\x3b\xa5\x5c\xc4\x4d\xf5\xae\xf2\x29\x9e\xde\xdc\x15\xbf\x9b\xd3\xae\xd3\x58\x61\x6e\x01\xcf\x0a\xdf\xe2\xee\xa8\xd4\xa9\xf0\x26\x69\xcb\xe7\xb6\x79\x56\x9d\xd3\xee\xe5\x72\xb2\xdf\xcb\x3c\x90\xd0\xde\xdb\x79\x80\xc8\xef\x79\x0e\x18\x28\x2e\x97\xa1\xbd\x51\xaf\x2a\xcc\xa0\x9d\x46\x2d\x1f\xef\x76\x2e\x00\x37\x1b\xac\x9d\xdc\x63\x7b\x64\xbf\x7c\x7c\x1b\x65\xf1\xe2\x04\x3f\x69\x87\x37\x5e\xd9\x7b\x91\x91\x5c\xe0\x89\xde\xe9\x4e\x38\x49\xa5\x7b\x04\xdb\x57\x7c\x2b\x82\xcd\x70\x32\xcd\x0f\x91\xe9\xfd\x7d\x76\x65\xc4\x61\xf7\xb5\xd3\xe6\x74\xd5\x8f\xf9\xf8\xc3\xf5\x34\x59\x89\x88\x9b\x65\xf9\x3c\xaf\x66\x8b\x92\x28\x6e\x32\xa7\xf8\x4e\xf0\xc7\x08\x4f\x97\xc0\x40\xce\x0b\xfb\xaf\xd7\xd7\xa4\xaa\xf3\x21\xe5\x47\x0a\x78\x0f\x57\x41\x00\x5e\x61\xb2\xd6\x7c\xa9\x6c\x3a\xfd\x6a\x41\xc6\x3b\xfe\x95\xd9\xc6\x81\xb9\xf9\xe4\x4b\xc6\x82\xf5\xa7\x22\x7f\x6c\x5c\x7e\xdc\x58\x0a\x9b\xc7\x65\x97\x3f\xbe\xeb\x27\x28\x62\x05\x5e\xf6\x9e\xa4\x79\xb2\x68\x16\x7b\x24\xfd\x2d\x55\x76\xa5\xe9\x5d\x9e\xcd\x42\x66\x9e\xa4\xca\x6d\x39\xcf\x97\x15\xa8\x60\x4d\xf3\xad\x6e\x2f\xd9\x59\x72\x80\x2a\x37\xeb\x7c\x6e\x65\x02\x7c\x12\xa1\x16\x3b\x51\xc9\x56\xba\x63\xec\x95\xac\xfd\x9b\xf4\xf6\x86\x2f\x2e\xf1\x61\xa7\x2d\xa6\x64\xc1\xa3\xef\x82\x84\xbf\x83\x0e\xdd\x56\xd3\x61\xd6\xe8\xfe\xde\x27\xeb\x2e\x6e\xea\x1d\xb0\xa6\xd8\x88\x7a\x36\x27\xd9\xb2\x5a\xa9\xbe\xdd\xfe\xf9\x71\xfe\x22\xf0\xe9\xfb\xd5\x94\x8a\xa7\x18\xbb\xc8\x06\x39\x61\xee\xd1\x2d\xa4\x67\x18\xf9\x07\xe7\x29\xec\xee\x1d\x6c\x24\xb6\x45\x9a\x3e\xc5\x77\xaf\xcd\xd2\x94\x68\x1e\x67\x2f\x4a\x67\xd3\x36\xa9\xad\x37\x66\x8d\x69\x8d\x9b\xd5\x88\xec\xec\x38\xb5\x3a\x0d\x64\xb6\x4d\x80\x5c\x96\x6f\xbe\x41\x9f\x89\x03\x85\x4a\x3f\x77\xd8\xad\x3e\x24\x3a\x76\xb8\x49\x3f\x08\x9b\x5c\xe7\x36\x73\xb9\x4d\x18\xf5\xc4\xe7\xc7\xf9\xc2\x7c\x3a\x7b\x3a\xfb\x7f\x00\x00\x00\xff\xff\xc4\x0a\xc4\xd0\xa5\x1e\x00\x00"
+var _exampletokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\x6d\x8f\xdb\x36\xf2\x7f\xbf\x9f\x62\xea\x17\xfd\xdb\xe8\xae\xbd\x4d\x93\xfc\x5b\x23\x8f\xbd\x66\x71\x01\x2e\x45\xd0\x6e\xdb\x17\x45\x91\x50\xd2\xd8\xe6\x45\x22\x7d\x24\x65\xaf\xb3\xf0\x77\x3f\x0c\x1f\x24\x52\x96\xbc\xde\xcd\x15\x0e\x82\xb5\xc4\xf9\x71\x38\x4f\x1c\xfe\x68\x5e\xad\xa5\x32\x70\x55\x8b\x25\xcf\x4a\xbc\x96\x9f\x50\xc0\x42\xc9\x0a\x46\xd3\x59\xf2\x74\x9a\x17\xf9\xe8\xcc\x8f\x7f\x87\x86\x15\xcc\xb0\xdf\x39\x6e\x75\x33\x3e\x79\xea\xc6\x9f\xad\xeb\x0c\x72\x29\x8c\x62\xb9\x81\x37\x37\xac\x5a\x7b\xbc\x79\x67\xd2\xdb\x33\x00\x80\xd9\x6c\x06\xd7\xd2\xb0\x12\x74\xbd\x5e\x97\x3b\x90\x8b\x44\x4a\x03\x17\x80\x37\x5c\x1b\x14\x39\x5a\x11\x9a\x61\xc3\x14\x18\x12\xfb\xd5\x4a\xcd\xe1\xb7\x2b\x7e\xf3\xf4\xf1\x59\x83\xf9\xab\x91\x8a\x2d\x11\x98\x28\xe0\x7d\x9d\x95\x3c\x87\xf7\xcc\xac\x74\x83\x50\xa2\x81\xdf\x59\x5d\x1a\x3f\x92\xde\xce\x21\xfa\x92\x8c\xfc\x05\x73\xe4\x1b\x54\x0e\xca\x8d\x6d\xff\x4e\x86\xfe\xc8\x4a\x26\x72\x3c\x61\xe4\x7b\x25\x37\xbc\x40\xf5\x5e\xf1\x0d\x33\xe8\xc7\xb6\x5f\x92\xc1\xaf\x8b\x8a\x8b\x41\x5d\x23\x5b\x92\xd1\xde\x0a\x6e\x38\x2b\xf9\x67\x2c\xc2\x9b\x76\xc4\x0a\x01\x37\x28\x0c\x98\x15\x33\xc0\x35\x60\xc5\x8d\xc1\x02\xb6\x2b\x14\x60\x56\xd8\xfa\x8f\x6b\xc8\x15\x32\xe3\x61\xc8\xf2\x4e\xf4\x60\x9a\x31\x77\x53\xa6\xfe\x98\xb4\x0e\x71\x12\x7f\x70\xb3\x2a\x14\xdb\x8a\xf0\xfc\x64\xb5\xac\x38\x30\x85\xb0\x0d\x18\x2e\x0e\x99\x73\x63\xaf\x82\xcd\x74\x63\x56\xc9\x5a\x98\xa0\xd7\xb9\x15\x9d\xc3\xeb\xa2\x50\xa8\xf5\xcb\x03\x3d\x7f\xc2\xb5\xd4\xdc\x3c\xc0\x7c\xad\x9e\x45\xc0\x00\x23\x8f\x6a\xd9\x4c\x76\xa0\xa5\x91\x47\x74\x7c\xc7\xc5\x03\x14\x14\xb8\x8d\x95\xac\x5a\x90\xae\x5a\x0e\xbf\xa3\xd3\x81\x16\x3f\xd6\x4a\x7c\xa1\x99\xb4\x51\x72\x37\xa0\x84\x83\x1f\x56\xc2\x2a\xa9\xfe\x11\x05\xe9\x3d\xb4\x60\xd6\x1a\xd6\x04\x0a\x14\x6a\x59\xab\x1c\x87\x83\x3e\x99\x6b\xcc\xca\x52\x6e\xb1\x78\x3d\xa4\x99\xd5\xfc\xcb\x34\xcb\x2c\xc4\x09\x9a\x25\x73\x8d\x23\x25\xda\xa0\x8b\x27\x7f\xc3\xf2\x15\xd4\x1a\x15\x68\x23\x15\x6a\x60\x02\xb8\xd0\x86\xea\x16\x15\x60\x29\xca\x9d\x2d\x04\x56\x9c\x2a\xb0\x59\x21\x77\xa3\xd9\x12\x1b\x1c\x32\xef\xa2\x16\xb9\xe1\x52\x68\x3f\xcc\xc5\xb9\xad\xbb\x4b\xb9\x41\xf2\x1e\x64\x0e\x6d\xad\x5c\x3d\x5e\x4b\x6d\xa8\xc6\x14\xdc\x0a\x36\x70\x5c\x74\xb6\x88\x50\x90\x76\x36\x50\x72\x56\x96\x58\x4c\x93\xd9\xf3\x15\xe6\x9f\x34\xac\xd8\x7a\x4d\xfe\x34\xa0\x6a\x61\x78\x85\xd6\x8a\xb8\x41\x05\xac\xd1\xd0\x3a\x36\xc5\x68\xb0\x7e\xf1\x26\xa6\x11\xc2\xad\x3f\xc3\x60\xec\xb0\x32\x2a\x8b\x78\x63\xc8\x42\x49\x95\xb4\xb1\x65\x56\xb8\x6b\xe0\x48\xdd\x02\x17\x9c\x16\xcf\xc5\x39\x68\x49\xcb\x50\xd6\x83\x42\xc2\x96\xed\x60\x21\x49\xb7\x8a\x95\x3c\xe7\xb2\xd6\xce\x1d\x46\xfa\x39\x9d\x15\x75\x03\x28\x6b\x3f\x2d\x17\xc0\xb8\x9a\xc2\x6b\xd0\x6b\xcc\x39\x2b\xe1\x5d\x27\x7c\x05\x62\xa1\xa9\xe4\x64\xad\x0e\x46\xda\x28\x6f\xe0\xda\x22\x90\x9a\x82\x62\xbd\x01\xb2\x2a\x74\x76\xed\x69\xd8\xb3\xce\x3b\xcf\xc3\x06\xd9\x7d\xee\x77\xc3\x73\x48\x7b\x05\xb2\x77\x49\xee\xb9\x75\xb1\x1a\x14\xa3\x88\xb2\xfb\x3a\x64\x4e\xd0\xaf\x5a\xc3\xa6\x89\xe4\xa0\x28\xf5\x00\x7e\x54\x48\xbf\x18\x0c\xfc\x8e\xc4\x3f\x23\x19\xbf\x01\x64\xa6\x5d\xa2\x35\x36\x85\x06\xc5\x4c\x23\x4b\x82\xe3\x0e\xf2\x04\x6e\x9b\xf7\xf4\xd1\x58\x2e\xa6\x01\xf2\x79\x00\x6f\x86\xec\xd3\x65\x85\x2d\x2b\x7e\x98\x0c\xb8\x0a\x31\xea\x62\x89\x7d\x72\x49\xe9\xca\x1e\x30\xf7\x45\x2d\xeb\x0a\x85\x49\x04\x29\x9f\x02\xba\x76\xb5\xc4\x0b\xd1\x0e\xd7\x26\xe4\x34\x96\x4a\x10\xde\x1a\x1f\x73\xda\x57\x1d\x83\xd4\xf3\x31\xb5\xf3\xa9\x1c\x0a\x54\xad\xdd\x56\xb6\x92\x65\x91\x20\xd0\x24\x95\x14\xb8\x6b\x6a\x59\x86\x5c\x2c\xc1\x28\x26\xf4\x02\x95\xc2\x62\x0a\x6f\xc9\xec\xa6\x56\x82\xb4\x44\x9a\xa8\xdc\x25\x28\x21\xd9\xfc\xa4\x32\x49\x39\x0b\xec\x0a\x00\x25\x13\x37\x36\x4f\xb3\x68\x93\x4d\xb0\xb0\xd4\xb8\xa5\x84\xeb\x5f\x36\x45\xcf\xa2\x16\x8d\xe1\xba\xdb\xcb\x1c\x5e\xa5\x51\xec\x74\x3a\x1a\x01\xc9\xd7\x0b\xef\x84\x44\x80\xb6\x9e\xc1\xbe\xc4\x8d\x0f\x7d\x89\x05\x93\x5b\x81\xea\xe5\x94\xb9\x1e\x65\x92\x60\x39\x53\xc2\xb3\x8b\xb8\x5c\xb4\x31\xeb\xd0\x26\x43\xe1\xe8\x8d\x16\x3f\xbb\x3b\x1a\xbd\x63\x64\xf6\x6f\xcc\xbb\x21\x69\xcb\x3a\x2b\x0a\x9d\xc0\x70\xa3\x9b\xac\x33\x32\x49\x42\x5f\x42\xed\x12\xf5\x09\x11\xca\x35\xf8\xfd\x96\x22\xd0\xb7\x0c\x16\x42\xd3\xce\x6c\x01\x20\xc3\x9c\xd5\x1a\xdb\xa0\x4f\x50\xb6\xa4\x72\x14\xdc\x14\xc6\xa8\x82\x26\xbe\x1a\xc2\x75\x90\xfd\xbf\x56\xf7\x15\x4b\xd7\x95\x21\x0a\xda\xbf\x74\x5d\x61\x61\x97\x6e\x28\xd6\x16\x52\x61\x1b\x96\xbe\xa9\x39\x1e\x80\xde\x11\x63\xe7\xf5\xbe\xa0\xeb\xd6\x1d\x3a\xb6\xd8\x52\x08\xcf\x2e\x7c\xff\xab\xbf\x82\x57\xf1\x91\x69\x9a\xae\xfd\xae\x58\xfd\xc6\x95\xd6\xf0\x7d\x28\x64\x0f\x9b\xd4\x44\xec\x1c\x8c\x3c\x21\x6e\x13\x19\x78\x0e\x97\xd3\xcb\xe4\x7d\xf0\x6c\x5a\xed\xf7\x67\x07\x96\x5b\xa2\xb1\x3b\xc9\x78\x02\x73\xf8\xf3\x7a\xb7\xc6\xbf\xe0\xb6\x2f\x45\xfe\x4c\x1e\xd2\x3f\x1a\xfc\x2c\xdd\x8e\xae\xae\xad\xcd\x7e\xe2\x7a\x5d\xb2\xdd\x8b\xf1\x04\xce\xe1\x3e\x72\xcc\xb0\x63\x42\x5f\xdf\xf6\x6f\x96\x7b\x2b\x74\x9a\x8c\xdf\x48\xad\x48\x22\xf1\xd7\x31\x33\xd1\x4e\x57\x6e\x90\xb4\x1d\x7f\x80\x0d\xc7\xed\xdc\xc2\x93\xd5\x5e\x8b\xdd\xaf\x46\xd5\xb9\x79\xd9\xb1\x9c\xde\x72\x93\xaf\xec\xe8\xce\x1b\xfa\x97\x33\x8d\x27\xd8\x62\x7e\x20\x18\x39\x65\x50\x72\xdc\x2b\x15\x3e\x36\x49\x5f\x97\x9c\xe9\x39\x8c\xe2\x90\x1f\x9d\x1f\x95\xf3\x7d\xab\x3b\x36\x1f\xa6\x4a\x74\x90\x3e\x8e\xa3\xbc\xdf\x7a\x80\x82\x4b\xdb\xa3\xfe\x71\x28\x9f\x02\x3d\x48\xde\xd1\xa7\x02\xad\x03\x89\x70\x88\xd4\xc3\x2f\x1c\xc7\xb2\x59\x47\xae\x9d\xfb\x18\x4c\xe0\xac\x93\x5e\x8c\x27\xa7\xd9\xe8\x38\xcc\x91\x84\x38\xc9\x6e\xf7\x42\x8f\x52\xe7\x34\x5b\xde\x0b\x3d\x58\xf9\x6e\xf8\xbc\xd6\x46\x56\x51\xb4\xcd\xe1\xb6\x67\x12\xcb\xf4\x70\x6d\x14\x33\x52\x51\xba\x77\xdc\xda\x65\x82\xf6\xa7\xcc\x1a\xc5\xc0\x1c\x6e\xf7\x3d\x95\xaa\x47\xa6\x09\x41\x2b\x72\x5c\xc2\xf6\x21\x6f\xaa\xb5\xd9\xf9\x73\x03\x95\x1f\xab\x7c\x7f\x2f\xe5\x2b\xc1\xb3\x8b\x74\x71\x5d\x9c\xf1\x64\x3f\x38\xaf\x3f\xe7\xde\xa7\x38\xb5\x05\xfe\x01\xf5\xc9\x09\x0f\x97\x28\xc1\x2a\x3c\xbd\x30\x15\xa8\x73\xc5\xd7\x74\x04\x9e\xc3\xe8\x7a\xc5\x35\x75\xce\x4c\xc0\x89\xf2\x78\x63\x50\x09\x56\xfe\xf6\xcb\xbf\xe6\x1d\x85\xdf\xb4\xaf\xc6\xb5\x2a\xe7\x30\x5a\x19\xb3\xd6\xf3\xd9\x6c\xc9\xcd\xaa\xce\xa6\xb9\xac\x66\x52\x2c\x4a\xb9\x9d\xd1\x7f\x17\x62\x61\x66\x59\x29\xb3\x59\xc5\xb4\x41\x35\x0b\x47\x5b\x3d\xf3\xca\xfc\x7c\x75\x6d\xf9\xdd\x23\x11\xae\xff\x53\x33\x85\x6f\x2b\xb6\xc4\xae\x3e\xef\xb0\xe0\x6c\xbc\xe0\xe5\xc1\x9b\x7f\x5e\x5f\xbf\xbf\xe2\x25\x76\xd4\xd4\x8f\xa6\xb9\xe4\xa2\x62\xea\x13\x9a\x9c\xad\xad\xc2\xda\x30\xc3\xf3\x19\xaf\x96\x33\x7a\xa9\x67\x8f\x2e\x2f\x6f\x1e\x5d\x5e\xce\x1e\x3f\x79\xf2\xfd\x74\x2d\x96\xa3\xc9\x39\x54\x34\x15\x25\xd5\x1c\x46\x9c\x74\x99\xb9\x17\x83\x6a\x67\x4c\x08\x54\xff\x1b\xb5\x99\xd6\x68\xf4\x74\x8b\x19\x75\x4a\x17\xb4\x60\x6d\x55\x7f\xb2\x78\xfa\xe8\x87\xc7\xf9\x65\xfe\xff\xec\xfb\xbc\x28\x9e\x3e\xfe\x2e\xfb\x36\xff\xfe\xd1\x65\xe7\x05\x7b\xf2\x24\xcf\xbe\xcd\x7f\xf8\xee\xe9\x87\xab\x52\x6e\x3f\xfc\x21\x55\x41\x36\x98\xea\xcd\xc0\xe2\xf4\xe6\xe8\xe2\xb4\x24\x8a\x40\xcf\xe1\x76\x74\xbd\x25\xe2\x4b\x8d\x4e\x8f\x15\xe3\x24\xec\x02\x28\x4a\x3e\x64\xa5\xcc\x3f\xe5\x2b\xc6\xc5\x68\x20\x2b\xd3\x66\x8f\x3e\x77\x3f\x68\x53\xf6\x68\x9b\x74\x34\x5f\xc7\x5f\x53\xe7\x49\x87\x92\x41\x8c\x97\x93\xaf\xce\xee\x31\x77\xb4\x67\x3c\x74\xea\x00\xd1\x3b\x73\x81\x0b\xaa\x29\x30\x87\x63\xe0\x82\x97\xe9\xfb\x7d\x5f\xb3\xe7\x9b\xe6\x71\xf7\xac\x10\x17\x92\x69\x74\x5b\x02\xcf\x87\x5f\x5d\x24\x87\x83\x06\xce\xcd\xbb\x6f\x89\xc5\x6e\x9d\x4e\x68\xa4\xc3\x33\x64\xca\x30\xd8\x5a\x6c\x4f\xdf\xc0\xc2\x8e\x4e\x07\xb1\xcf\xa8\x64\x03\x40\xe7\xaa\xc0\x18\xf0\x96\x10\x60\x65\xc9\xc5\x32\x10\x03\x44\x84\x59\xe6\xac\xaa\x89\x53\x64\x65\x49\x1c\x99\x6e\x38\xbf\x04\x8d\xda\x40\x77\x3a\x74\xb8\x58\x0c\x10\x9c\xf4\x40\xaa\xc2\x11\x72\xf6\xdc\x49\x52\x5c\xb5\x68\x79\x4e\x67\x20\xcf\xb2\xb1\xac\xa4\x03\x64\x68\x7d\xc2\xa9\x4e\x37\xdc\x95\x21\x0f\x80\xd9\xad\x71\x9a\xd8\x29\x74\xe9\x87\x9b\xde\x1c\x5e\x75\x39\x87\x3b\x8e\xfc\x97\xd3\xcb\x49\xec\xa4\x84\xca\x4b\x5a\x8a\x2e\xe7\xe6\x66\xff\x19\xb7\x8e\x49\x8c\xdf\x1d\x61\x05\x1a\x8f\x46\x6e\xea\xe5\xd3\x7b\xf1\xd2\x95\x37\x73\x0f\x90\xea\x73\x78\xe5\x59\xce\x34\xc0\x2d\xa3\x72\x94\x95\x4f\xbe\x4e\xce\x7a\x52\xac\xb1\x67\xbf\x06\x03\x00\xfb\xb3\x78\x59\xed\x32\x1c\x11\x1f\xbf\x7b\x90\x09\x3b\xc4\xff\x69\x26\x74\x73\xdb\xd8\x71\x7f\x76\xca\x81\xb5\x56\xf7\xa6\xe0\x98\x45\x02\xe0\x70\x15\x88\x22\xa6\x8f\x4d\x0f\x64\x91\x5d\xad\x4b\x02\x46\x91\x18\xf2\xc7\xb1\xed\x44\xc4\x04\x86\xfa\x34\x66\xba\x09\x86\x03\xee\xd8\x73\x9f\x94\x78\x16\xc4\x33\x58\x2b\x7f\xd1\xa5\x3a\x44\x52\x43\x8a\x87\x29\x88\x53\xee\x8d\xc1\xd4\xdf\x24\x67\x6b\x67\x42\x0c\x1d\x71\x35\x09\xe8\x68\x71\xe7\x96\x2d\xa3\xaa\x52\x85\xca\x66\xa2\x5b\xf0\x76\x47\xef\x56\xc2\x58\xa2\x5b\x0b\x63\xa1\x83\x30\x69\x55\x1e\xb3\x83\xec\x4a\xb6\x83\x6e\xe1\xa1\x0f\x5d\xdd\xa4\x4f\xe8\xe3\xad\xfd\x82\x68\x9c\x39\x8c\x5c\xce\xf8\x2b\x45\x57\x91\x33\x84\xa5\x0d\x26\x45\x9e\x10\xb6\xc2\x8f\x86\x70\x9e\x79\x66\xaa\xe3\x80\x01\xdc\x12\x35\x99\x83\x91\x85\xb1\x71\xaa\x53\x69\x34\xb0\x69\x3e\x74\x57\xfc\xc6\xab\x98\x00\x1d\xea\x0a\xcf\xfb\x1e\xde\x45\x06\x77\xee\x59\xd9\x09\xb5\xea\x74\xba\xd7\xde\x65\xf4\x46\x74\xb7\x5b\xe8\x5d\x4e\xf2\x7d\xb8\x0e\x44\x65\xef\xcb\xeb\x00\x15\xbf\xbb\x6b\x40\x53\xe2\x1a\xad\x68\x46\x92\xbd\x57\x62\xfa\xde\xa9\xe5\xb7\xc3\x3d\xe8\x39\xe0\x62\x81\xb9\xe1\x1b\xa4\xcb\xc0\x5a\x09\x2e\x96\x31\x55\x3c\x38\xc1\xcf\xd2\xe0\xdc\x8e\x24\x6d\xb0\x88\x2f\xbb\x59\x6d\x64\x45\xc7\x18\x56\x96\x3b\xd0\x75\x66\x7f\x67\x81\x45\x73\x5b\x93\x20\xc5\x25\x21\x5c\x42\x3a\x2d\xad\xda\x75\x6e\xa4\x3a\x9e\xf5\xad\x3d\xfe\x76\x8e\x99\x98\x69\x9f\xc8\xcf\x53\x6e\x38\x19\xd6\xcf\xf0\x76\x52\xa2\x73\xeb\x7f\x18\xdf\x51\xfc\xd9\x08\x8f\x97\x60\x03\x39\x4d\xec\x6f\x2f\x2f\x63\xa6\xd9\x8e\xe8\x61\xc7\xe0\x39\xcc\xd6\xee\xeb\x0c\xa3\xd5\xa6\x8b\xb5\xd2\x5d\xe2\x90\x44\x7d\xf7\x78\x97\xe8\x21\x55\x48\xc2\x6b\x4b\xb6\x24\xb2\x61\x60\x2a\x7e\xc0\x0f\x0e\x48\xfb\x71\xa9\x70\x97\x3b\x1a\x52\xdb\x8e\x8b\xf7\x3c\x70\x2d\x43\x14\x82\xb6\x7d\xef\x6e\x5c\xd1\xce\x4b\xbb\x96\x66\x1b\x04\x4e\x49\x15\x5a\xeb\x08\xf2\xac\x37\xde\xfa\x2b\x5c\xd7\xa7\x6d\x2c\xd8\x37\xbe\x8e\x4c\x69\xbe\xf1\xb3\x0b\x1b\x5b\xd1\x8d\x44\xd7\x59\x93\xbe\x95\x31\xaa\x2f\xf4\x3b\xb1\x9c\xad\x59\xc6\x4b\x6e\x76\x61\xa3\x25\xdd\xdb\x9b\x49\xea\x28\xec\x2f\x04\xf0\x66\x2d\x35\xc6\x95\xc6\x9a\xe7\xa3\xef\xff\x3f\x42\x85\x66\x25\x0b\x30\x2b\x25\xeb\xe5\xca\xbd\x0c\x4e\xfd\x08\x54\xf6\xd5\x82\xe5\xbd\x36\x49\x96\x55\x72\xf1\x69\xf0\x74\x3c\x74\xb5\xbe\x7f\x91\x32\x55\x03\xb1\x97\x92\x07\x86\xa9\x25\x9a\x01\xb3\x9d\xf5\x10\x6f\x7f\x87\xfd\xbc\xd7\x3f\xc2\x82\x63\xd9\x31\x9f\x8f\xea\xfb\x5b\xef\xb0\x7a\xdd\xde\xeb\x97\x0a\xbd\xe6\x3c\xc8\xc5\x2f\xb4\xa6\xad\xa1\x94\x7a\x51\x26\x24\x67\xb7\xf1\xa4\x7f\x8d\x3e\xf0\x6d\x77\x1d\x05\x7e\x37\xdd\x53\xc7\xbd\xa1\x1e\x84\x89\xf8\x77\x48\x7a\x25\xc3\xcf\x08\x92\x9f\xb8\x6c\x99\x8e\x7e\x4f\x11\x5f\xb6\x9f\xf5\x94\xef\x23\xbf\x0b\xec\x4f\xe4\xfd\xd9\xfe\xbf\x03\x00\xc8\x04\xf4\xf4\x99\x2a\x00\x00"
 
 func exampletokenCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,11 +95,11 @@ func exampletokenCdc() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "ExampleToken.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x42, 0x18, 0x5e, 0xb6, 0x6f, 0x51, 0x80, 0xef, 0x9f, 0x39, 0xa7, 0x95, 0xbc, 0x9a, 0xfc, 0xcc, 0x39, 0xfa, 0xac, 0xb3, 0xaa, 0xce, 0xe2, 0x5f, 0x92, 0x2a, 0xa1, 0xc2, 0x14, 0x1c, 0x21, 0x58}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1e, 0xce, 0xc1, 0x26, 0x97, 0xc3, 0x16, 0xd4, 0x13, 0x42, 0x7, 0xab, 0x12, 0xa7, 0x9f, 0x8c, 0xd2, 0xb9, 0xfd, 0xfb, 0xc9, 0x72, 0x63, 0x69, 0x10, 0xf5, 0x18, 0x51, 0x80, 0x70, 0x5a, 0xba}}
 	return a, nil
 }
 
-var _fungibletokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x59\x4d\x73\xdb\xba\xd5\xde\xf3\x57\x9c\x49\x16\xb1\xf3\x2a\xf2\x5d\xbc\xd3\x85\x67\x6e\xdb\xdc\xf6\x66\xc6\x9b\x4e\xa7\x75\x7b\xb7\x82\xc8\x43\x09\x63\x10\xe0\x05\x40\xc9\xcc\x9d\xfc\xf7\xce\x39\xf8\x22\x29\xc6\x56\x12\x6f\x2c\x91\xc0\x83\xf3\xf9\xe0\x01\x74\xf7\xfe\x7d\x55\xbd\x85\xc7\x23\xc2\x27\x65\xce\xf0\x69\xd0\x07\xb9\x57\x08\x8f\xe6\x09\x35\x38\x2f\x74\x23\x6c\x53\x55\x6f\xdf\xc2\x2e\xbd\xe4\x77\x3b\xa8\x8d\xf6\x56\xd4\x1e\xa4\xf6\x68\x5b\x51\x63\x55\x11\x50\xfe\x0a\xfe\x28\x3c\x08\xa5\xa0\x4d\xb0\x9e\x61\xd3\x4c\x07\x67\x33\xa8\x06\x8e\xe2\x44\xaf\xe8\x79\x6b\x6c\x07\xde\x6c\xab\x87\x16\x04\x0c\x0e\xad\x83\xb3\xd0\xde\xd1\xfb\x06\x7b\x65\x46\x10\xa0\xf1\xbc\x80\xda\x80\x3f\xa2\xb4\xf9\x7b\x15\x90\x35\x62\x43\x33\x65\xd7\x2b\xec\x50\x7b\x1a\x06\x33\x47\x8a\xbd\x5b\xb6\x7f\x02\xb2\x30\xaf\x35\x8a\x62\x44\x0e\x11\x8a\x1d\x14\x3a\x10\xba\x01\x2d\x3a\xa9\x0f\x15\xbb\xeb\x67\x11\x70\x3d\xd6\xb2\x95\xe8\xb6\x21\x84\xff\x15\x83\xf2\x3b\xb0\xe8\xcc\x60\x29\x60\xbf\x8a\xfa\x08\xa2\xae\xcd\xc0\xb6\x09\x0f\xe6\xac\x5d\x70\x2e\x85\x27\x39\xc1\x76\x08\x32\x98\xf2\x52\x63\x65\x5a\x5e\x8e\x41\x33\x26\x38\x6f\x2c\x36\x20\x75\x0c\x49\x42\xa7\xe7\xe2\x10\xbd\x5c\x4e\x3a\x0a\x07\x1d\xfa\xa3\x69\x1c\x64\x3f\xcc\x59\xa3\x65\x0f\x8d\x3f\xa2\x8d\xe9\xa8\x85\x86\x5a\x28\x15\x5d\xfa\xa7\x35\x27\xd9\xa0\xdd\x6d\x60\xf7\x2f\xac\x51\x9e\xf8\x33\xcd\xda\xfd\x22\x14\x19\x5a\x1c\x2e\xa1\x71\x6c\x86\x9b\x3e\x81\x06\x6b\x25\x2c\x42\x6f\xf1\x43\x6d\x74\x23\xbd\x34\x3a\x84\xb8\x37\xce\x4f\x9f\xb1\x8d\x16\x9d\xb7\xb2\xf6\x15\x19\x8b\xcf\x58\x0f\xf4\x12\x62\x58\xda\x41\xd7\x61\x70\x08\x45\x70\x39\xb8\x3f\x02\xad\xe3\xb0\x17\x56\x78\x84\x3d\xd6\x62\x20\x5b\x3c\x1c\xe4\x09\x1d\x0f\x27\x6f\xf9\x83\xd8\x4b\x25\xfd\x48\x29\x70\x47\x61\xb1\x12\x60\xb1\x45\x8b\xba\xe6\xba\x08\x61\x0e\x01\x0d\x29\xd4\x6a\x04\x7c\xee\x8d\x8b\x50\xad\x44\xd5\xb8\x62\x51\x25\x35\x18\x8d\x60\x2c\x74\xc6\x62\xb2\xb8\x84\x62\x5b\x55\x0f\xd4\x3a\xce\x44\x83\x42\xe8\x17\xd6\x74\xe2\x09\xa1\x1e\x9c\x37\x5d\x8e\x70\x0c\x4d\x2e\x78\x8a\xcd\x3c\xca\xd4\x48\x06\x4e\xc2\x4a\x33\xd0\x68\xa9\x0f\x0e\xce\xd2\x1f\x19\x3e\x54\xde\xb6\xfa\x64\x2c\xe0\xb3\x20\x98\x0d\x08\x68\xc5\x50\xa3\xe7\xdc\xef\xb1\xa0\x63\x03\xfb\x31\xf5\x2d\xf7\x00\x87\x03\x52\x51\xcc\x9a\xeb\x97\x11\x06\x27\xf5\x61\x62\x2b\xa5\xb6\x98\xb6\x89\x6e\x9a\x76\xd1\xa2\x99\x30\x2a\x32\xc0\xa1\x6e\x78\xa6\x0d\xe5\x96\xba\xa5\x47\xb4\x1f\xbc\xf9\x40\xff\x37\xec\x91\x19\x3c\x75\x0d\xad\x49\x24\x40\x0b\x31\x37\x90\xb3\x02\x6a\x24\x54\x05\x0a\x9b\x03\x5a\x70\x9d\xb0\x3e\x2f\xb5\x85\x47\x13\x56\x8a\xe8\xde\x80\xd0\xa5\x0f\x36\x55\xa0\xa7\xd8\xa3\x8e\x42\x32\xf2\xa2\x8d\x15\xe7\x49\x28\xa1\xb5\xa6\x9b\xd6\x08\x53\x55\x68\x21\x2e\xdc\x06\x7b\xe3\xa4\xcf\xd5\x01\x46\xcf\x56\x7a\xe7\x52\x6d\x11\x43\x52\xe4\x3d\x06\x7c\x2b\xb4\x6b\xd1\x6e\xab\xea\xfd\x5d\x55\xdd\xdd\xdd\xcd\xc3\x46\x4f\xf8\xe9\x0a\x2b\x7f\x95\x91\x73\x6a\xb7\x3c\xbd\x1f\xf6\x2b\x44\xbf\x48\xcf\x1f\x55\x05\x00\x90\x96\xf2\xc6\x0b\x05\x7a\xe8\xf6\x68\xb9\xb4\x43\x1c\xa4\x06\x7c\x96\xce\x53\xdb\x6c\xf3\x84\x07\x0f\xd2\xc1\xd0\xc7\x46\x9a\x94\x96\xa5\x47\xa8\xdd\x60\xb1\x50\x52\xc0\x76\x43\xdf\xab\x31\x63\x38\x2f\x46\x47\x3c\x37\x70\x37\x53\x69\x04\xc0\x46\x78\x4c\xa3\xf8\x3f\xb9\x73\x12\x36\xc0\xfc\x9b\x51\xee\xe1\x3f\x9f\xe4\xf3\x9f\xfe\x7f\xe2\x03\xdb\xfb\xa0\xa5\x97\x42\xc9\xcf\xd8\xcc\x20\x92\x97\x78\xc2\x44\xd9\xd2\x01\x76\xd2\x53\x37\x9c\x29\xb5\x64\x68\x09\x9a\x83\xda\xa2\xf0\x0b\x18\xb2\x24\x40\x5c\x2c\x77\x23\xc3\xe7\xb9\x7d\xb7\x4b\x03\x7f\x8b\xb5\xa6\xbf\xd9\xbc\x90\x0f\x62\xc0\x54\xaf\x3a\x54\xa9\x08\x95\xf6\xa2\xa1\x79\xd9\x1b\xd1\xd1\xbe\x92\xec\xdb\x30\xc4\x3d\x7c\x6c\x1a\x8b\xce\xfd\xe5\xc2\xde\xbf\x87\x3a\xff\x8e\x70\x16\x7b\x9b\x84\x41\xb5\x68\xae\xb2\x37\x2f\x7b\x61\xaf\x37\xab\xd6\x26\xee\x5a\x35\x73\xd1\x46\x48\xc4\x57\x47\x96\xb7\xf8\xfb\x20\x2d\x17\xaf\x83\xd6\xd8\x1c\x5d\x22\xc6\x04\xb2\x20\x85\x52\xef\x4c\x52\x63\x5f\x5a\x63\xda\x22\x8d\x41\x07\xda\xe4\x05\xe7\x6b\x19\x0d\xbb\x7d\xda\x6a\x8f\x68\x71\x93\xe7\x4e\x76\x36\x85\x82\x76\x12\xd3\xc7\x0a\xed\x8d\x73\x32\x6e\x26\xa6\x0d\x45\x4a\x46\xc4\x0d\xa5\x8f\x61\x70\xc5\x74\xf2\xb8\x31\x6c\x87\xc6\x1a\x9d\x13\x56\xaa\x31\xea\x13\x26\x38\x73\xd6\x10\x2d\xd9\x5e\x64\xe5\x52\x04\x94\x7d\x22\x52\x48\x5a\x2a\xf3\xa8\x1b\xf6\x91\x98\x96\x81\x63\x71\x92\xb8\x71\x36\x39\x6c\x0d\x7e\xb0\x54\x34\x91\x3b\xf3\xfe\x66\xb1\x33\x27\x6c\xf2\x3e\x37\x99\x38\x03\x79\x9c\x28\x88\x77\x4c\x2e\xe8\x1c\x28\x3c\xa1\xa2\x02\xed\x87\xbd\x92\xf5\x06\xf6\x03\x15\xad\x74\xf4\x8c\xe2\x22\x28\x6e\x7b\x85\xdd\x0c\x2c\x65\x81\x85\x41\x51\x56\xa4\xc8\x38\xed\x6c\x57\x0e\xce\x5c\xb7\xcd\x80\x6a\x96\x7f\xcc\x0e\x6a\xe4\x2d\x24\xac\x9e\x2c\x7d\xd9\x9f\xb0\x6a\x27\x46\x38\x58\xa1\x7d\x54\x75\x71\x9d\xec\x23\x6d\xe8\xa9\x16\xc8\x1d\x79\x4a\x2c\x5a\xac\xe8\xb3\x0a\x89\x12\xdf\x9c\x5d\x12\xbb\xf5\x4c\x2d\x52\x97\x32\xee\x0c\x81\xeb\x2f\xe5\x3e\xbb\xee\x8f\xd6\x0c\x07\xda\x9a\xb3\xbe\xba\xd6\xa1\x20\x95\xd8\x2b\x0a\xca\x2b\x3e\x71\xf2\xae\x71\x89\xb0\x16\x7e\xcc\x6c\x9f\x61\x7c\xbb\x1f\xd4\x15\xed\xa0\x73\xb9\x2f\x28\xea\xf6\x1e\xfe\x1a\xca\xf7\x8f\x3c\x85\xa7\x19\xb7\x7c\x14\x90\x61\x67\xd1\xc5\x13\x46\x1b\xad\x0e\xc5\x45\xdd\x00\x27\xa1\x06\xbc\x98\x16\xa6\x6c\x63\xdb\xc2\xcf\x3f\x43\xb4\xe2\x62\x24\xfd\xbd\x49\xfc\x2f\x54\x1c\x07\xdd\xe0\x3c\xa9\x42\x5a\xc9\x89\x0e\x41\x84\x20\x25\xc4\xa8\x6e\xcb\x5e\xc3\x3e\xbd\x99\xc1\x7f\xa9\xe6\x9f\xbe\x14\x3e\x4e\x87\x8a\x1f\xe7\xe3\xb8\x7b\xac\xd0\x31\xef\x26\x57\xd2\xf1\x6f\x98\x48\x50\xea\x5a\x0d\x0d\x92\x94\x4c\x27\x93\x60\x46\x7d\xc4\xfa\x69\x1e\x84\x48\x01\x19\xe5\x8c\x7c\xae\xa5\x0c\x91\xc2\xbf\x46\xe0\x87\x30\x04\x81\x5f\x4d\x19\xa1\x31\x69\xd0\xba\x9a\xdf\x80\x92\x4f\x74\x18\x55\x92\x55\x54\x47\xf2\x48\xe8\xa6\x08\x28\xd6\xb9\xf4\x82\x44\x93\x6c\xb9\x68\x3d\xf4\x2a\x9c\x45\xe0\x75\x22\x4f\x49\x5a\x12\x79\x12\xb7\x5e\x3c\x61\x61\x63\x62\xe8\xf8\xc6\xd1\xd6\xb4\x1e\xfe\xd2\x4f\x63\x8f\x2f\xf6\x4f\xc4\xba\x09\x0a\x24\xf4\xcc\xed\xb2\x8e\xe2\x61\xf4\x9a\x32\x22\xf1\x26\xa4\x0e\xf9\x28\x5b\x2b\x1f\xe3\x60\x7a\xea\xce\x20\xe4\xd1\xa4\xf8\x84\x0f\xd2\x45\xe3\x39\x0c\x0c\xf2\x25\x0a\xc1\xcd\xb4\x32\x32\x04\x6d\x22\x45\x04\x42\x6d\xac\xc5\xda\xab\xf1\xaa\xf8\x47\xe7\x96\xe1\x2f\x72\x7c\xd2\x8c\x02\x4e\xcb\x3d\x73\x16\x51\x12\xc8\x71\xf8\x5c\x1c\xd3\x1f\x99\x78\xb3\x78\x7b\x7b\x1d\x3f\x39\x54\xed\x94\x66\x12\xca\x3a\xcf\x24\x8f\x12\xbb\x4c\x63\x93\xaa\x25\x3c\x4a\x40\x57\x33\xca\xa5\x68\x4c\xb1\x9a\x50\xf8\xb2\x0c\xca\x75\x82\x37\x5f\x3b\x82\xbe\x90\x2a\x5e\xf3\x3e\x0b\x9e\x4d\xee\x98\xcd\x7a\xee\xd8\x9c\x70\x21\x22\xd2\xad\x06\xf3\x4c\x6d\xf9\xfc\x37\xf6\x2c\x15\xc4\xda\xe9\xac\x43\xa1\x27\x34\x11\x01\xf1\x84\x76\x5c\xde\xf0\xe5\xd9\xf3\x5b\x03\xf7\xd2\x3d\xd9\x14\x94\xb3\xd3\x60\x2b\x35\x4e\xcd\x5b\x5e\x74\xe5\x78\xb6\xc6\x76\x79\x5b\xfa\xca\xdd\xd1\x14\x7f\x7e\x8d\x34\xbd\x2a\x08\x1c\xc2\x17\x46\x2e\x2a\xa6\x48\xf8\x4d\xba\x6f\xa1\x21\xe5\xce\xe5\xf5\xc6\x20\x9b\x7e\xa0\x35\x22\x6c\xb9\x0d\x09\x59\x8a\x21\x0a\x57\x5b\x45\xbf\xc9\xcf\x33\xf9\x30\x93\x1d\xbd\x95\x14\x98\xa4\x0d\x17\x75\x7e\xc9\x40\x01\xe2\xe5\x1e\x7d\x55\x60\xef\xc2\x76\xbe\x2b\x12\x9b\x17\x78\xe7\x66\x4c\x05\xab\x22\x3b\xf3\x5c\xd9\x7a\x12\x30\x36\x6b\xf3\x7f\x58\x02\x59\x7c\x8d\x61\xfe\xfc\x8a\x90\xf9\x18\xd4\x4b\x91\x25\x89\x69\x54\x50\x79\x42\x83\xb1\x80\xbf\x0f\x42\x85\x6f\x2b\x9a\xe6\x45\x25\x03\x2f\x4a\x35\x3a\x0f\x70\x9c\x48\x35\x0b\x55\xae\x7f\x76\x7b\x6c\x8d\xc5\x1d\x4b\x03\xf4\xb1\x2a\xd5\x90\x17\x5d\x6c\x48\x6b\xe0\xf1\xb6\x64\x8f\x07\xa9\x35\x95\xd1\xe2\x4e\xb4\xdc\x96\xae\xcc\x7e\x9d\xb8\xd9\xc0\x9b\xe9\xe3\x5b\xf8\xf0\x72\xb4\xff\x91\x2b\x64\xbf\x20\x76\xbe\x03\x8b\x9a\xa3\x44\xb6\xb7\x78\xe2\x0b\xca\x34\x5c\x04\x89\x72\xbd\x8c\xbc\x52\x87\x88\xa6\x21\x0d\x52\x16\x8a\xe4\x34\xcb\xb4\x5c\x39\x67\x5e\xa7\x42\x16\xc9\xbf\xbb\x83\x8f\xce\xa1\xf5\xe5\x4a\x6b\xce\xe9\xd1\xfd\x72\xd1\xc1\x84\x44\xe2\x20\xc9\xeb\x25\x5e\x54\xdb\xa7\x72\xff\x2c\xc3\xb9\xa7\xf7\x89\x40\x22\xda\x15\x1d\x44\xb6\x6f\xa5\x7b\x88\x3f\x31\x84\x1c\x1f\xd0\x3f\x8e\x3d\xde\xdc\xde\xde\xc3\x7a\x76\xff\x26\x34\x09\xe2\x14\x65\x66\xb9\xda\x74\xbd\xf0\x93\x4b\x46\xf2\xef\x3b\x7a\xe5\xaa\xea\xfb\xbf\xf4\x98\x1d\x48\x8f\xbf\xab\x16\xdd\xd0\xbd\x5a\x84\x25\x3d\xdf\x76\x96\x09\x02\xf0\xd7\xae\xf7\x63\xac\xc0\x78\xce\xd4\x63\xfc\xbd\xc1\xc4\x31\x33\x52\xe5\xac\x1e\x05\x15\xee\x67\xb4\x66\x29\x1d\xab\x69\x15\x2e\x97\xb8\x59\xa3\xd0\x95\x50\x5f\x1e\x03\x7f\xda\xfe\x74\x0f\x6f\x68\x4b\xd3\x78\x56\x63\x52\xaf\xd1\x26\x0e\x19\xff\x24\x35\x35\xe9\xcd\x85\xef\x5f\xfe\x17\x00\x00\xff\xff\x1a\xfd\xf3\x08\x64\x1c\x00\x00"
+var _fungibletokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x59\x4b\x8f\xdb\xc8\x11\xbe\xf3\x57\x14\xc6\x07\xcf\x38\xb2\xb4\x87\x20\x87\x01\x9c\xc4\x9b\xac\x81\xb9\x04\x41\x32\xc9\x5e\x55\x22\x8b\x62\x63\x9a\xdd\xdc\xee\xa6\x34\xf4\x62\xfe\xfb\xa2\xfa\xc5\x87\x34\x1a\xd9\x86\x65\x8c\x44\x91\x5f\x3d\xba\xea\xab\xaf\x5b\x9b\x0f\x1f\x8a\xe2\x1d\x3c\x36\x04\x5f\xa4\x3e\xc2\x97\x5e\xed\xc5\x4e\x12\x3c\xea\x27\x52\x60\x1d\xaa\x0a\x4d\x55\x14\xef\xde\xc1\x36\x7d\xe9\xbf\xdb\x42\xa9\x95\x33\x58\x3a\x10\xca\x91\xa9\xb1\xa4\xa2\x60\xa0\xfc\x11\x5c\x83\x0e\x50\xca\x25\x6c\x7a\xd2\xc2\x51\xf7\xb2\x82\x06\x0f\x04\x4e\x33\x62\xad\x4d\x0b\x4e\xaf\x8b\x87\x1a\x10\x7a\x4b\xc6\xc2\x11\x95\xb3\xfc\x7d\x45\x9d\xd4\x03\x20\x28\x3a\x82\x9b\x41\xad\xc0\x35\x24\x4c\xfe\x5c\x04\x64\x45\x54\xf1\x93\xa2\xed\x24\xb5\xa4\x1c\xdf\x96\xdd\x09\xde\x64\x7f\xd7\xde\xff\x09\xc8\xc2\xbd\x5a\x4b\xce\x11\x07\xc4\x28\xa6\x97\x64\x01\x55\x05\x0a\x5b\xa1\xf6\x85\x0f\xd7\xcd\x32\x60\x3b\x2a\x45\x2d\xc8\xae\x43\x0a\xff\x8f\xbd\x74\x5b\x30\x64\x75\x6f\x38\x61\xbf\x60\xd9\x00\x96\xa5\xee\xbd\x6f\xe8\x40\x1f\x15\x07\xfb\x44\xca\xc2\x3c\x08\x9f\x26\x64\x87\x79\x5d\x4a\x2a\x74\xed\xc3\xf1\xa0\x19\x13\xac\xd3\x86\x2a\x10\x2a\xa6\x24\xa1\xf3\x75\xdc\xc7\x28\x97\x0f\x35\x68\xa1\x25\xd7\xe8\xca\x42\x8e\x43\x1f\x15\x19\x1f\xa1\x76\x0d\x99\xb8\x1c\x25\x2a\x28\x51\xca\x18\xd2\xbf\x8d\x3e\x88\x8a\xcc\x76\x05\xdb\xff\x50\x49\xe2\xe0\xdf\xf3\x53\xdb\x9f\x51\xb2\xa3\x63\xc0\x63\x6a\xac\x77\xc3\x4e\xaf\x40\x45\xa5\x44\x43\xd0\x19\xfa\x58\x6a\x55\x09\x27\xb4\x0a\x29\xee\xb4\x75\xd3\x6b\xde\x47\x43\xd6\x19\x51\xba\x82\x9d\xa5\x67\x2a\x7b\xfe\x12\x62\x5a\xea\x5e\x95\xfc\xd9\xc6\x54\x84\x90\x43\xf8\x03\xb0\x1d\x4b\x1d\x1a\x74\x04\x3b\x2a\xb1\x67\x5f\x1c\xec\xc5\x81\x18\x9e\x7c\xb4\xfe\x0d\xee\x84\x14\x6e\xe0\x25\xb0\x0d\x1a\x2a\x10\x0c\xd5\x64\x48\x95\xbe\x2e\x42\x9a\x3d\x7a\x5c\x42\x25\x07\xa0\xe7\x4e\xdb\x08\x55\x0b\x92\x95\x1d\x3d\x2a\x84\x02\xad\x08\xb4\x81\x56\x1b\x4a\x1e\x8f\xa9\x58\x17\xc5\x03\xb7\x8e\xd5\xd1\x21\x76\xc6\x2e\xbd\x69\xf1\x89\xa0\xec\xad\xd3\x6d\xce\x70\x4c\x4d\x2e\xf8\xc2\x2d\xb3\xcc\x8d\xa4\xe1\x80\x46\xe8\x9e\xef\x16\x6a\x6f\xe1\x28\x5c\xe3\xe1\x43\xe5\xad\x8b\x2f\xda\x00\x3d\x23\xc3\xac\x00\xa1\xc6\xbe\x24\xe7\xd7\x7e\x47\x63\x3b\x51\x05\xbb\x21\xf5\xad\xef\x01\xed\x51\x52\x51\x8c\x76\xd7\x45\xf1\xf3\x00\xbd\x15\x6a\x3f\xf1\x95\x97\x76\x74\x6d\x15\x2b\x4c\xd7\xaf\x32\x46\xc1\x1e\x58\x52\x95\xaf\x0a\x13\xea\x2d\xb5\x4b\x47\x64\x3e\x3a\xfd\x91\xff\xae\x7c\x48\xba\x77\xdc\xbe\x6c\x94\x59\x80\x2d\x79\x72\xe0\x68\x11\x4a\x62\x54\x09\x92\xaa\x3d\x19\xb0\x2d\x1a\x97\x4d\xad\xe1\x51\x07\x4b\x11\xdd\x69\x40\x35\x36\xc2\xaa\x08\xfc\x14\x9b\xd4\x72\x4e\x06\x6f\xb4\x32\x78\x9c\xe4\x12\x6a\xa3\xdb\xd8\x8b\xbe\x48\x3c\x57\x85\x1e\xe2\xd5\x81\x8a\x3a\x6d\x85\xcb\xe5\x01\x5a\xcd\x2c\xbd\xb7\xa9\xb8\x98\x22\x39\xf5\x8e\x18\x02\x9c\x41\x65\x6b\x32\xeb\xa2\xf8\xb0\x29\x8a\xcd\x66\x33\xa7\x36\xbe\xc2\xff\xe1\x0c\x2d\xbf\x96\xe0\x71\x6d\xd7\xfc\x68\xd1\xf5\xbb\xfc\xe5\x04\x63\x66\x07\x7e\x2f\x0a\x00\x80\x64\xca\x69\x87\x12\x54\xdf\xee\xc8\xf8\xda\x66\x13\xbe\x0d\xe9\x59\x58\xc7\x7d\xb3\xce\x0f\x3c\x38\x10\x16\xfa\x2e\x76\xd2\xa4\xb6\x0c\x5f\x22\x65\x7b\x13\x7d\x76\x19\xdb\xf6\x5d\x27\x87\x8c\x61\x1d\x0e\x96\x69\xb4\xf7\xed\xcc\xa5\x11\x00\x2b\x74\x94\xee\xf2\x7f\x39\x9c\x03\x32\xb2\x43\xf9\x5f\x8f\x72\x0f\xff\xfb\x22\x9e\xff\xf2\xe7\x49\x0c\xde\xdf\x07\x25\x9c\x40\x29\xbe\x52\x35\x83\x48\x51\xd2\x81\x12\x67\x0b\x0b\xd4\x0a\xe7\xa8\x82\x63\x43\x81\x6d\xc6\xa4\x59\x28\x0d\xa1\x5b\xc0\xb0\x27\x01\xe2\xc4\xdc\xad\x08\xa6\xe7\xfe\xdd\x2d\x1d\xfc\x35\xd6\x9a\xfa\x66\xf7\xbc\x41\x4f\x81\xa9\x5e\x55\xa8\x52\x0c\x95\x76\xd1\xd1\x6c\xf6\x16\x5b\x1e\x5b\xc9\xbf\x95\x87\xb8\x87\xcf\x55\x65\xc8\xda\xbf\x9d\xf8\xfb\xcf\x50\xe7\x54\xfd\x80\xbf\xb1\x57\xfc\x7c\x73\xfa\x2a\x7f\xb3\xd9\x13\x7f\x9d\x3e\xeb\x6d\x22\xaf\xb3\x6e\x8e\x2d\xe0\x57\x9e\x54\xad\x23\xeb\x12\x18\xfa\xad\x17\xc6\xd3\xae\x85\x5a\x9b\xcc\x06\xcc\x8c\x09\x64\x41\x0a\x63\xbd\x7b\x92\x1a\x3a\x5a\x9f\xd8\x7d\x70\x50\x69\xb2\xa0\x74\x36\x38\xb7\xa5\x15\x6c\x77\x69\xd6\x36\x64\x68\x95\x9f\x9d\x8c\x36\x49\xc8\xb3\x4d\x77\xb1\x42\x3b\x6d\xad\x88\xd3\x44\xd7\xa1\x48\xd9\x89\x38\x51\xba\x98\x06\x9b\xb1\x7c\xc4\x95\xf6\x7e\x28\x2a\xc9\x5a\x34\x42\x0e\x51\xa0\x78\x82\xd3\x47\x05\xd1\x93\x79\x1c\x5c\xee\x89\xf7\x27\x3c\x92\x07\x45\xa4\x90\x64\x2a\x65\x0e\x6c\xbf\x8b\xc4\xb4\x4c\x9c\x57\x27\x89\x1b\x67\x0f\x73\xff\x1b\x72\xbd\xe1\xa2\x89\xdc\x99\x07\x9c\xa1\x56\x1f\x28\xb1\xfa\x7a\xfa\xe0\x0c\xe4\x71\x22\x21\xde\x7b\x72\x21\x6b\x41\xd2\x81\x24\xb7\x74\xd7\xef\xa4\x28\x57\xb0\xeb\x99\x03\x84\xe5\x6b\x9c\x17\x84\xce\xe8\x9d\xa4\x76\x06\x96\x56\xc1\x2b\x83\x51\x5a\xb1\x24\xf3\xcb\xde\xd0\x34\x39\x73\xe1\x36\x03\xe2\xf1\x17\xd9\x41\x0e\x7e\x84\x04\xeb\xc9\xd3\xcb\xf1\x04\xab\x2d\x0e\xb0\x37\xa8\x1c\x84\x69\x16\xed\xe4\x18\x77\xc3\x58\x0b\x1c\x8e\x38\x24\x16\x4d\x58\x25\x76\x59\x86\x44\x8d\xaf\x8f\x36\xa9\xdd\x32\xe2\x46\xcd\xa2\x23\xee\x0c\x81\x73\x90\x0a\x6c\x0c\xdd\x35\x46\xf7\xfb\x06\x26\x02\xeb\xda\x80\x82\x56\xf2\x51\x71\x52\xde\x88\xc9\x2f\xde\x35\x21\x31\xd6\x22\x8e\x99\xef\x33\x8c\x6f\x8f\x83\xbb\xa2\xee\x55\x2e\xf7\x05\x45\xdd\xdd\xc3\xdf\x43\xf9\xfe\x9e\x1f\xe1\x17\xab\xe1\xc5\x25\x7e\x6d\x36\xb0\x35\x64\xe3\x16\xa3\x8e\x5e\xb3\xbb\xa1\x1b\xe0\x80\xb2\xa7\x62\xf1\x14\xa7\xbf\x97\x6e\x1d\xdb\x16\x3e\x7d\x82\xe8\xc5\xc9\x9d\xfc\xba\x49\xfc\x8f\x32\xde\x07\x6d\x6f\x1d\xec\x78\xf9\x08\x2c\xb6\x04\xc8\xba\x92\x12\x11\x24\x79\x9b\x82\x54\xa1\x25\x6f\x66\xf0\x2f\xf9\x53\x78\xf7\x32\xf2\x71\xda\x55\xcc\xf8\xe4\xbb\xf8\x38\x4e\x8f\x33\x74\x2c\x94\xd3\xd7\xd2\xf1\xaf\x94\x48\x50\xa8\x52\xf6\x15\x01\x42\xde\x9a\x84\x09\x56\x36\x54\x3e\xcd\x93\x10\x29\x20\xa3\x1c\xc9\x6f\x6c\x59\xa5\xb0\xc4\xbf\x46\xe1\x87\x34\x30\x2c\xe6\x91\xe7\x25\x79\xa5\xd3\x4d\xe7\xe5\xfc\x0a\xa4\x78\xe2\xdd\xa8\x14\xcc\x56\xd4\xb2\xde\x42\x95\x07\x71\xd4\xb9\x0d\xf1\xde\x1b\x2a\x51\xfb\xe6\x73\xd0\x49\x96\xe5\x57\x11\x79\x5a\xa4\xa4\x05\x13\x72\x4c\x39\x38\x7c\xa2\x91\x8d\x99\xa1\xe3\x37\x96\x77\x5d\xe7\xd3\x3f\xf6\xd3\xd0\xcd\x08\xe8\xa4\x7f\x22\xd6\x6d\x50\x20\xa1\x67\xee\x96\x75\x14\x77\xa3\xd7\x94\x11\x8b\x37\x14\x2c\xfa\x1b\x9a\x8c\x56\xbf\x8f\x4b\xe5\x3c\x53\x1e\x7e\x3b\x32\x29\x3e\x74\x41\x09\xf2\x49\x85\xbf\x31\xc8\x97\x28\x04\x57\xd3\xca\xc8\x10\x3c\x44\x46\x11\x08\xa5\x36\x86\x4a\x27\x87\xab\xf2\x1f\x83\x5b\xa6\x7f\x94\xe3\xd1\x1a\x7b\x8f\x70\x58\xce\xcc\xfc\x3e\x09\xe4\x78\x7b\x62\xa2\x11\x95\xa7\xcf\xed\xe2\xdb\xbb\xeb\xf8\xc9\x92\xac\xa7\x34\x93\x50\x4e\x6e\xe4\xd7\x4d\x8a\x28\xb1\xcb\x34\x37\xa9\x5a\xc2\xa5\x04\x74\x35\xa3\xcc\x96\x2e\x5f\x7d\x9c\x4e\xe1\xd3\x32\x48\x23\xd6\x93\xea\x2b\x5b\xd0\x0b\x4b\xe5\x6d\xde\x67\xc1\xb3\xca\xb4\xb6\x3a\xbf\x76\xbe\x2c\xc3\x89\x08\xa6\x63\x0d\xcf\x33\xa5\xf1\xfb\xbf\xa1\xe3\x94\x00\x4e\x36\x1a\xb9\x18\x5a\xc2\x78\x46\x32\x05\xa4\x03\x99\xe1\xb5\x8d\x5f\x54\xde\xa9\xfd\xec\xa5\x83\xb2\x29\xa8\x5f\x9d\x8a\x6a\xa1\x22\x0d\x06\xf7\x96\x27\x5d\xb9\xad\xf8\xa0\x20\x8f\xa5\x57\x0e\x8f\xa6\xf8\xf3\x73\xa4\xec\x82\x5d\xf9\xec\xc7\x13\x23\x1b\x15\x53\x24\xfc\x2a\x1d\xb8\xf0\x2d\x79\xd9\xae\x68\x0c\x8e\xf9\x07\x5a\x23\xc2\x8e\xc7\x21\x61\x95\x62\x8a\xbc\xa7\x30\xea\x37\xf1\x75\x26\x1f\x66\xb2\xa3\x33\x82\x13\x93\xb4\xe1\xa2\xce\x4f\x19\x88\xff\x6d\x36\x97\x7b\x74\x1e\xff\x19\x81\xbd\x0d\xe3\x7c\x3b\x4a\x6c\x6f\xe0\xbd\x4d\x76\x2f\x88\xec\xcc\x73\xe3\xe8\x49\xc0\x54\x9d\x7b\xfe\x84\xc2\xbf\x55\x02\x19\x7a\x8b\x61\xfe\xfa\x86\x90\xf9\xec\x0d\x4d\x64\x49\x62\x1a\x19\x54\x1e\x2a\x3e\xa0\xa3\xdf\x7a\xe4\x03\x5f\x54\xb3\x71\x3e\x5d\x82\xd7\x78\xe7\xb2\x54\xe3\xfd\x00\x23\x7a\xd5\x8c\x32\x17\x2a\x6c\x77\x54\x6b\x43\x5b\xee\x92\x3d\xf9\x81\x1d\x94\x5b\x32\xba\x18\x48\xe7\xc0\xe3\x69\xc9\x8e\xf6\x42\x29\x2e\xa3\xf8\x68\x36\x92\x8f\x4b\xcf\x3c\x7d\x39\xad\x9f\x3e\x41\x70\xf0\x76\x7a\xf9\x0e\x3e\x5e\xce\xf6\xbf\x72\x85\xec\x16\xc4\xce\x21\x25\xcd\x31\x66\xb6\x33\x74\xf0\x27\x94\xe9\x76\x6e\xe6\x6f\x92\x91\x57\xea\x10\xac\x2a\xd6\x20\xa3\xa1\x48\x4e\xe9\xa3\xcf\x9c\x38\xb3\xcf\x3c\x29\xe1\xb3\x2a\x64\xb1\xf8\x9b\x0d\x7c\xb6\x96\x4c\x24\xdc\x78\x52\x34\xe1\xf4\x18\x7e\xc4\xa2\x2a\xcc\x6a\xde\x5e\x26\x79\xbd\xc4\x8b\x6a\xfb\x30\x1e\x40\x8b\xb0\x97\xeb\x5c\x22\x90\x88\x76\x45\x07\xb1\xef\x6b\x61\x1f\xe2\x6f\x0c\x61\x8d\xf7\xe4\x1e\x87\x8e\x6e\xef\xee\xee\xe1\xe4\x09\x7e\xdd\xfc\x03\x15\x0b\xe2\x68\x27\xb0\x1c\x9f\x55\xa2\xe3\xf9\x11\x7f\xac\xe1\xf8\xbe\xa3\x57\xae\xaa\xbe\x3f\xa5\xcb\x3e\x80\x74\xf9\xbb\x6a\xd1\xf6\xed\x9b\x45\x18\x03\xa5\xea\xad\x22\x5c\x28\x8f\x20\x00\x7f\x69\x3b\x37\xc4\x0a\x8c\xfb\x4c\x35\xf8\x7d\x26\xf7\x7c\xb8\x67\x46\xaa\x7e\x55\xf9\x37\x1a\x84\xaf\x64\x74\x72\xe7\x44\x6d\xf0\x5e\x72\x69\xe2\xf6\x1c\x85\x9e\x49\xf5\xe9\x36\xf0\xa7\xf5\x4f\xf7\x70\xc3\x93\x52\xd1\x51\xc6\x1d\x74\x0a\x39\xa4\xcc\xff\x26\x35\x75\x69\xcc\xc4\x4b\x01\x00\xf0\x52\xbc\x14\x7f\x0c\x00\xe7\x1a\xc5\x29\x66\x1c\x00\x00"
 
 func fungibletokenCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -109,11 +115,111 @@ func fungibletokenCdc() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "FungibleToken.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xac, 0x1c, 0xf4, 0x50, 0x78, 0x2d, 0xa7, 0xca, 0x50, 0x3f, 0xc0, 0x48, 0x50, 0x81, 0x99, 0x2d, 0xd4, 0x28, 0x3c, 0x7, 0xd0, 0xe1, 0x9f, 0x3e, 0xc8, 0x5c, 0x12, 0x3b, 0x91, 0x5e, 0xf8, 0x6}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x25, 0x9c, 0x1d, 0xaf, 0x56, 0xca, 0x66, 0xdd, 0xbe, 0x5, 0x14, 0x40, 0xee, 0xae, 0xd1, 0xf3, 0x63, 0x1d, 0x6a, 0x32, 0x37, 0x36, 0x8a, 0x96, 0xd1, 0x8, 0x7c, 0x53, 0x4, 0xab, 0xf0, 0xbb}}
+	return a, nil
+}
+
+var _metadataviewsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7c\x6d\x73\x1b\x39\x8e\xf0\x77\xff\x0a\x8c\xb7\x2a\x6b\xcf\xca\x92\x33\x3b\x4f\xea\x39\xd5\x68\xb3\x49\x1c\xcf\xfa\x2e\xf1\xa6\x6c\x67\xef\xaa\x52\xa9\x98\xea\x86\x24\xae\xbb\xc9\x1e\x92\x6d\x59\xeb\xf2\x7f\xbf\x02\x9b\x64\xb3\x5f\xf4\x62\xc7\x53\xf7\x65\xd7\xa9\x1d\xa9\x1b\x04\x01\x10\x04\x40\x00\xd4\xe8\xc7\x1f\xf7\xf6\xae\x16\x5c\x43\x22\x85\x51\x2c\x31\xc0\xf3\x22\xc3\x1c\x85\xd1\x60\x16\x08\x39\x1a\x96\x32\xc3\x40\x1b\x26\x52\xa6\x52\x28\x94\x2c\xa4\xc6\x74\x8f\x0b\x38\xfd\x70\xf6\xe9\xe8\xf8\xd5\x9f\x5f\x0d\xf7\xf6\x2e\x70\x36\x86\x85\x31\x85\x1e\x8f\x46\x73\x6e\x16\xe5\x74\x98\xc8\x7c\x24\xc5\x2c\x93\xcb\x91\xfd\xbf\x69\x26\xa7\xa3\x9c\x69\x83\x6a\x34\xcb\x78\xa1\x47\x3f\x1d\xff\xf4\xf2\xf8\x3f\x5e\xbe\x3a\x12\x33\x73\xe4\x27\x1b\xe6\xe9\xde\xde\xa5\x51\x65\x62\x34\x30\x91\x82\x42\x2d\x4b\x95\xa0\x86\x84\x89\x9a\x44\x90\x02\x41\x2a\xc8\xa5\xc2\xbd\x40\xa9\x59\x15\xa8\x07\x90\xb0\x2c\xc3\x14\x6e\x39\x2e\xf5\x10\xde\xb3\x64\x61\x3f\xdb\xd7\xa0\xb0\x50\xa8\x89\xcb\x3d\x06\x29\x9f\xcd\x50\xa1\x30\x70\xc3\x45\x0a\x72\x16\xb8\x1e\x80\x2e\x93\x05\x30\x0d\x0c\x12\x85\xcc\x48\x05\x53\x2e\xe7\x8a\x15\x8b\xd5\x9e\x54\xc0\xe0\x3f\x3f\xbd\xff\x15\x78\xce\xe6\x08\x33\x9e\xe1\x70\xef\xc7\xd1\xde\x1e\xcf\x0b\xa9\x0c\x9c\x96\x62\xce\xa7\x19\x5e\xc9\x1b\x14\x30\x53\x32\x87\xe3\xbb\xd3\xcf\xe7\xbf\x9e\xbd\xfd\xf0\xfe\xea\xef\xff\xf5\xfe\xfc\xcd\xc9\xc9\xc5\xfb\xcb\x4b\x3f\xe0\x5c\x8a\xde\x31\xe7\x7f\x3f\xef\x1d\xb6\x57\x94\xd3\x7a\xe9\x3e\x3a\xaa\xff\x41\x2c\xc3\xfd\xde\x1e\x00\xc0\x68\x34\x82\x37\x70\x81\x5a\x66\xb7\xa8\x68\xf5\x6e\x79\x8a\x1a\x58\x92\xa0\xd6\x60\x24\x30\xd0\x68\x62\xae\x9d\xcc\xfc\xf0\x08\x8d\xb6\x6b\x42\x22\xf7\x2b\x02\x07\x38\x9c\x0f\x81\x09\x38\x3f\xbd\x3a\x6c\x2d\x8f\x21\xcd\xe2\xc2\xa0\x9a\xb1\x04\x03\x1e\x23\x3d\x19\x11\x15\xa4\x6c\x76\x5e\x30\x0b\x66\x80\x1b\xd0\x65\x41\x52\x6c\x11\x42\x1c\x87\xc9\x03\xee\x9a\xc1\x7b\x0b\xe5\x21\x67\xa5\x80\x39\x1a\x2b\x91\x83\xc3\x31\x7c\xb9\x5a\x15\xf8\xb5\x03\x42\x08\xb3\x5b\x24\xb0\x83\x6f\x96\xfd\x31\x10\xe4\xe1\x18\xde\x88\x55\xa5\x89\xaf\xed\xa8\x87\x3e\xa9\xbe\x93\x59\x86\x89\xe1\x52\x00\x27\x55\x99\x2b\x59\x16\x24\x51\xc2\xe4\x91\x2b\x12\x45\x8a\x77\x30\x5d\xc1\xd9\xc9\xa3\x98\x8a\xf0\x77\xd9\x9b\x4a\xa5\xe4\x92\x48\xf7\xe0\x07\x3c\x1d\xc3\xe7\x33\x61\x5e\xfd\x7c\x38\x86\x17\xf7\xfe\xf9\x43\x67\xec\x1c\xcd\xd9\x49\x25\x98\x0a\xfe\x6b\x9b\xc9\x13\xae\x8b\x8c\xad\x2a\xbe\xa6\x4c\xf3\xc4\xed\x22\xbb\x48\x22\xc9\x4a\x52\x26\x5a\x3c\xc1\x72\x1c\x40\x8a\x3a\x51\xbc\xb0\xb4\x32\x91\x06\x3c\x66\x51\xe6\x53\xc1\x78\x06\x33\xda\x36\x02\xe4\xf4\x9f\x98\x98\x21\x7c\x94\xda\xb8\x2f\x1a\xf4\x42\x96\x59\xda\xd6\x20\x9a\xb0\x2b\x2f\xa7\x8b\x9e\x40\xa7\xee\x7e\xbe\x2b\x47\x11\xad\x02\x51\xe7\xa7\x8b\x81\x5a\x03\xb8\x86\x19\xc7\x2c\x85\x25\xcf\x32\x98\x22\xa4\x15\x6a\x4c\x81\x0b\xc8\xb8\x76\xc6\xc8\x2c\x50\xe1\x4c\x2a\x74\xe4\x36\xd0\x4c\xed\x53\x65\x88\xc5\x44\x8a\x84\x6b\x1c\xc6\x00\xe1\x33\xb1\x90\xa1\xb1\x44\x8e\xe1\xd2\x28\x2e\xe6\x4d\x16\xde\xc0\x52\x71\x63\x50\x34\x84\xfa\x5c\xfc\x30\x48\xd1\x30\xee\x4d\x64\x53\x4e\x83\x06\x2a\x2d\xed\xbe\x9e\xa2\x35\xb4\x70\x8b\x6a\x2a\x75\xd8\xf9\x50\x30\xc5\xac\x45\x04\x2e\xb4\x41\x66\x2d\x28\x03\xcd\xc5\x3c\x43\xc8\xb8\xc0\xc3\xcd\x22\x88\xd8\x5b\x27\x09\x9d\xb3\x2c\x8b\x94\x28\xd8\x6f\xd6\x23\x94\x78\xec\x3a\x99\x38\x4d\x9b\x22\x30\x58\xe2\xf4\x68\xa6\x38\x8a\x34\x5b\x59\x23\x0e\x07\x7c\x88\xd6\xb2\x0f\xe0\xd3\xf9\xaf\x87\x0d\x24\xd6\x3c\x39\x79\x74\x35\x64\x40\x0c\xdf\x40\xa1\x90\xa4\xaa\x07\x80\x26\xd9\xcc\x7d\x60\x2a\xb2\x35\xf7\xa7\x3c\x43\xb7\x0b\xe9\x1f\x17\xdc\x1c\x84\x6f\xf4\x2f\x56\x9b\x7a\xb5\xe8\xaf\x47\x9a\x4d\x80\x0d\x13\x7a\x90\xc3\xc8\x8c\xd2\x3f\x8d\xd9\x6c\x48\x53\xc2\xc4\x2a\x6c\xf7\x65\x34\x2b\x4c\x62\x1a\xba\xa0\x61\x7e\x98\xd4\xb4\x04\xb0\x87\xae\x99\x5d\x60\x56\xa0\x22\x57\x35\xc7\x7a\xc3\x5b\x1d\x26\x67\xae\xd9\x0c\x61\xc9\x56\x7b\x2d\xcb\xe6\x00\x9d\x41\xf7\x46\xb0\x61\x10\x0f\x61\xec\xf1\xbd\x8e\x58\xe6\x33\xbb\x32\xb4\x82\x30\x69\x8c\x1e\xc6\x9e\x82\x3c\xc4\x2f\x6e\xf8\x5f\x0e\x0e\xdb\x42\xf3\x58\x1c\x0a\x60\xfa\x75\x20\xbe\x09\x49\x7f\x0a\x4d\xa9\x04\xdc\x36\x5e\x3c\xec\x75\x3f\x39\x40\xc1\xb3\xb6\xa4\x48\x69\x9c\x03\x42\x81\x8a\x27\x91\x2b\xb1\x5a\x5b\x47\x3d\xc0\x2a\x45\xd7\x46\x2a\x4c\x81\xb6\x90\x02\x39\x9b\x41\xb2\x60\x5c\x34\x8d\xad\x47\xad\xbd\x15\x28\x35\xa6\xb4\x1a\x0a\x6d\xd8\x44\x61\x99\x0d\x80\xf4\x00\xc8\xa9\xcb\xca\x4c\x4a\xb2\x93\x90\x63\xca\xd9\x5a\xe3\x5d\xd3\x47\x13\xc0\x7d\xc7\x41\x95\x8a\x1f\x1c\x06\xa3\xd0\xe2\xf7\x6f\x57\x57\x9f\x6a\x9e\x2d\x3f\x96\x4d\xee\xa3\x1b\x8a\xa3\x80\x59\x5b\x4c\xb0\x70\x20\x95\xfd\x70\x79\x08\x9f\x2f\x3e\x0c\x61\x1d\x59\x1e\xf1\xb8\x8f\x2c\xd2\x8c\x52\x65\x81\xa8\xf0\xd2\x6e\xd2\xe8\x4d\xef\x26\x2a\x55\x06\x13\x28\x55\xac\xf0\x9b\xb9\x6e\x61\x71\xcb\xef\x91\xad\xdf\x37\x67\x9f\x4e\x2f\x2d\xf9\xd5\x08\x12\x51\xbd\xf3\x5c\xc0\x1a\xbb\xe2\x30\xd0\xe9\x04\x05\xbd\x22\x8a\x6c\xc9\x69\x10\xce\xae\x72\xd0\x53\xa7\x01\xc0\x14\xd6\x7a\x91\x52\xac\x63\x16\xc8\x95\x0d\x52\x29\xc2\xe6\x29\x0a\xc3\x67\x1c\x15\x1c\xbc\x3b\x3b\x39\x0c\x48\x14\xb3\xfa\x62\x16\x4c\x00\x85\xe4\x0a\x13\x03\x9f\x2f\xce\x86\xf0\x06\x92\x8c\xd3\x58\x56\x14\x19\x4f\x2a\x8b\x4f\xaa\x58\x6a\xac\x02\x84\x77\x67\x27\x01\x8f\x91\x30\xa3\x28\x9e\x54\x30\x93\x2c\xb5\xde\xd2\x12\x07\xb7\x9c\x11\x4b\x96\xdc\x39\x33\xb8\x64\xab\xb5\x9a\xe9\xa5\x17\x54\x20\x08\x9a\x88\x7d\x77\x76\x42\x4a\x47\xa8\x7b\x18\xa3\x08\xc7\xd2\x45\x48\xdc\xa9\x20\x1a\xdd\xc0\xd4\x38\x35\xa5\x32\xd1\x43\x5e\xcc\xf4\x90\xcb\x51\x22\x45\x82\x85\xd1\x23\x37\xc3\x11\x4b\x53\x45\x4a\x2d\xe6\xa3\x5e\x74\x5e\x39\x13\x9e\x76\x95\x93\xa8\xfe\xc4\xcc\x82\xc8\x66\x02\xa4\xb5\xd4\x2c\x83\x82\x9e\xb9\xf0\x9b\x28\x8d\xc3\xd0\x20\xac\x6a\x35\xa4\x5a\x0d\x63\x7c\xeb\x5c\x2b\xd7\x20\x45\xb6\x02\x81\x98\x52\xac\x31\xab\x91\xdb\xe3\x80\xe6\x29\x86\x25\xde\x88\x74\x07\xe1\x10\xda\x23\xbd\xd2\x06\x73\x3d\xea\x45\xe4\xc5\x42\x9c\x7a\xb9\xbc\xae\x05\x63\x77\x6d\x24\xb2\x41\x13\xb0\x77\x13\x27\x3c\x85\x09\x24\x3c\xed\xbe\xa2\xc1\x30\xb1\x38\xfa\x76\x78\x2d\xaa\x52\x54\x07\x06\xbf\x3b\x49\x46\x56\xd8\x82\x19\x7e\x8b\x64\xa0\x6a\x45\x7a\xaa\x0e\x2d\xe4\xf2\xc8\xc8\x91\xd3\x9c\x23\x7a\x7c\x24\xc5\xd1\x12\xa7\xa3\x3f\x54\xf3\x1c\x95\x2a\xd3\xbd\x98\x77\x31\x46\xce\xc5\x39\xa6\x6b\x01\x34\xa1\x22\xb3\xb5\x4f\x24\x8c\x47\xa3\xfd\x21\xad\x20\x33\x07\x5e\x9e\x87\xfe\xc1\xfe\x68\x3f\x7c\x26\xbc\x75\xf0\xd5\x12\xe5\x4e\x58\xd7\x9b\xc7\xf7\x29\x27\xf9\xeb\xf6\x86\x20\x6f\x6f\x05\x9f\x84\x43\x97\x76\xa7\x1d\xad\x4b\xd4\x90\x97\x99\xe1\x45\xe6\xa3\x4d\x1d\x30\x2e\x39\x6d\xa5\x05\x82\xa6\x40\x49\x2a\xd0\x3c\xe7\x19\x53\x51\x36\x81\xf0\xe2\x1d\xa3\xe3\x0d\x6d\xae\xff\xa1\xc0\xf5\xe5\xf1\x31\x1d\xc0\x87\xd5\x16\xe2\x62\x26\x55\xce\xfc\x59\x32\x20\x2f\x35\xce\xca\xea\xf0\xb4\xa4\xc4\x86\x3b\x8b\xe4\x4c\xdd\xa0\x29\x32\x86\x7d\xe7\x75\x7b\x28\x87\x9c\xcf\x17\x86\xce\x33\x05\x53\x86\x66\x0c\x1c\xa0\x93\xc1\x00\x96\x0b\x9e\x58\xdb\xb0\x5c\x58\x8b\xed\x5f\xc5\xf4\x04\xbc\x5c\x3b\xc1\x07\x2f\xc1\xd4\x94\x1b\xc5\xd4\x0a\x34\xff\x17\x3d\x55\xca\xc5\x63\x24\x98\xb6\x6d\x0d\xa2\x6f\x59\xd5\x37\x11\xa2\xa3\x0a\x11\x9d\xba\x9c\x9b\x5a\x81\x28\xf3\x29\x52\x98\xe2\xa9\x6b\x28\x6e\xb5\x48\x44\x7b\x83\x69\xe6\xd9\xee\x18\x04\x62\xed\x03\xd7\x66\x0c\x5f\x1c\x45\x5f\x6b\x7a\xac\x59\xf8\xd6\x07\xd3\x6b\x11\x3c\x1c\x4c\xc2\x90\xf5\xaa\xd7\x8e\x68\x1d\x66\xbd\x3d\xa4\xf5\x90\xdb\x62\x5a\x0f\xf7\xd4\xa0\xd6\x8f\xdf\x31\xaa\xf5\xe0\xeb\xf7\xfd\xf7\x84\xb5\xef\xbb\xaa\x58\xa9\x84\x3f\x62\x3a\x6d\x58\xa3\x66\xdb\x32\x03\xf1\x68\x0f\x73\x5a\xef\xd3\x41\x65\x81\x13\x7f\x5c\xbc\x44\x33\x80\x4f\x19\x5b\x0d\xe0\x12\x15\x47\x5d\x1f\xae\x68\xa4\x53\xd5\x2a\xf8\x5d\xb2\x15\x30\xca\x75\x91\xa1\x70\x28\x92\x8c\x69\xcd\x67\x2b\xe0\x46\x77\xf5\xb8\x2f\x2b\xf0\xba\x4b\xbf\x1b\x17\xed\x88\x5d\x8e\xbf\xc4\x15\x13\xb0\xff\xd3\xcf\xde\xee\x1c\xfc\xe1\xa7\x9f\x47\x2f\x8f\x8f\x0f\xf7\x81\x1b\xcc\x89\x57\xf4\x48\xb9\x86\x9f\x7e\x5e\x93\x60\x08\x64\x5a\x50\x9f\x6c\xea\xd2\x99\xb3\x3b\xcf\xa3\x47\x6b\x69\xa5\xd8\x89\xd2\xb0\x72\xe6\xad\x68\x83\x6a\xe8\xf1\x97\xf1\xa9\xdd\x86\x17\x64\xd0\xaa\x2c\x62\x6a\xd5\x21\xe3\x39\x37\x98\x1e\xb9\xf9\x30\xed\x47\xbd\x83\x10\x88\x6a\xae\xe1\xe5\xf1\x71\xef\x50\x92\x54\x65\xec\x4b\xe1\x26\xf5\x4c\x56\x63\xeb\xdc\x02\x25\x56\x8d\x24\xa5\x6e\x62\xea\x08\x32\x67\x77\x5e\x8a\xed\xd8\xa4\xa1\x0a\x83\x96\xc8\x07\x8d\x91\x3d\x7b\x95\xe8\xf9\x61\x42\x14\xf4\x6c\x4e\xa6\x35\x2a\x73\xe0\x56\xe6\x97\x09\x21\xfb\x61\x00\x39\x6a\xcd\xe6\x38\x86\xfd\xab\x5a\x1d\x12\x26\x84\xb4\x16\x75\x4e\x89\x70\x1f\xaa\x1b\xb7\xca\x15\xd4\x0f\xfb\x6d\x77\xdd\xb1\x94\x1b\xb3\x08\x6e\xae\x89\x43\xd7\x05\xa0\xa9\x2c\x99\x5d\xeb\x1a\x99\x57\x97\x76\x75\x87\x5d\x0a\x5b\xc2\x2e\x3c\x4a\x71\xc6\x05\xa6\xa0\x51\x71\x96\xb9\x89\xbc\x3d\x29\x30\xe1\x33\x9e\x90\xdb\x0c\xe8\x3e\x55\xdb\x57\xc3\x82\xdd\x62\x54\x2b\xb0\x88\x9c\xdd\xa3\xe1\x4b\x72\x3f\xac\x85\x37\xa8\x42\x40\x77\x29\x73\x92\xd8\xca\x9d\x5e\x90\xe6\x22\x7f\x3a\x2f\x29\x54\x38\x3b\xb1\x6e\x5d\xc7\x40\x71\x81\xc2\x59\x0f\x7f\x0a\xaa\x02\xdf\x80\x9b\x96\xab\x39\x3f\xd7\x80\x77\x05\x26\xa4\xa3\x46\xd2\xea\x95\x82\xff\x56\x22\xb0\x5c\x8a\xb9\x3b\xa3\x5b\x0a\x48\xa1\x39\xad\x27\x33\x5e\x56\x1e\x6f\xdb\xb0\x92\xdd\x63\xb1\x3e\x6d\x33\x07\xce\x99\x36\x5f\xb7\x95\x75\xa3\x02\x6c\xf5\xa1\x8e\xa6\xad\x1e\xb4\x82\xdb\xe6\x3f\x2b\xa8\xa7\x7a\xcf\x6a\xf4\x8e\xbe\xb3\x23\xcc\x96\x43\x7c\xb2\xe7\xfc\xd1\x7e\xfb\x11\xe0\x42\xae\x58\x66\x56\x40\x04\x6a\xff\xf0\x84\xb4\xd7\x65\xee\x13\x99\x17\x52\x33\x4a\x96\x28\x07\x1b\xaa\x7d\x56\x1f\xe6\xfc\x16\x75\x1d\x6b\x52\xc2\x87\x41\x29\xe8\x18\x9f\xd6\x39\x26\x8f\xda\x48\x5f\xba\x21\xdd\x76\x28\xb9\x0f\x50\x03\x59\x1f\x63\x74\x74\x9c\xff\xad\x44\x45\xc1\x27\xd7\x70\x7d\xe1\x07\x5d\x7b\xa5\xb3\x95\x30\xab\xa9\x1e\x01\x6d\x14\x4a\x37\xc4\x0a\x5e\xb0\x55\x3d\x21\x4c\x19\x65\xaa\x24\x69\x35\x6a\x0c\xdb\xdb\xee\x9d\x26\x39\x1d\x1d\x0f\x04\xb4\xc3\x87\x37\x14\xda\xba\x58\x53\xb1\xe4\xa6\x12\x21\x17\x29\xbf\xe5\x69\xc9\xb2\x7a\xfa\x30\xac\x2a\x72\xd9\xa3\xce\xa1\xd5\xa2\xa4\x34\x67\x62\x26\xf5\x18\xbe\xb8\xc5\x89\x22\x4f\x22\xc2\x6d\x98\x1e\xb8\xb6\x4a\x8d\x46\xf0\x0f\x96\xf1\x94\x19\x97\xfc\xd2\x65\x4e\x8e\x8d\xd2\xe5\x49\x69\x7c\xac\xcf\x51\x85\xea\x4a\x9f\x19\x7f\x39\x3c\x6e\xa0\xbd\x65\xb4\xb3\x0c\xcb\xde\x95\x06\x26\x70\xdc\x7a\x4d\xf6\xce\xab\x0a\x17\x81\xce\x1e\x2d\x8e\x90\x84\x8f\x7f\xf2\x63\x87\x49\x69\xd6\xa8\x77\xe4\x9e\xc2\xb8\x5f\x26\xf0\x72\x78\x1c\xfb\xa7\xcb\x8a\xd9\x30\xff\xee\xdc\xb6\x1c\x15\x59\x14\xad\xf9\xdc\xaa\x4a\xc0\xd7\x00\xa1\xe5\x1b\x86\x99\x26\x5d\xa0\x87\xa6\xa2\x5c\x54\x91\x6f\x8c\xcf\x96\x08\x02\x50\x64\x95\x82\xb6\x1d\x1c\x46\x6b\xdd\x12\xa7\xdb\xe7\x0d\x3a\x76\x37\x90\xb5\x42\x6f\xb5\x91\x35\x35\x5b\xcc\x64\x00\x7c\xaa\xa5\x0c\x08\x76\x34\x96\x01\xbe\x05\xfb\x1c\xf6\x92\x7c\x73\x65\x6a\xc8\x80\x51\x0e\xd4\x55\xc8\x74\xa3\x9e\xe5\xd5\x9e\x36\x17\x6d\x03\x66\xad\xa3\x08\x21\x43\xc7\x86\x74\x4a\x93\xbf\xba\xe4\x7c\xb3\xd2\x7f\x81\x09\xf2\xdb\x90\x3d\x44\x98\xa2\xc0\x19\x4f\x38\x9d\xaa\x5d\x80\xef\xe6\x6e\x60\x7b\xc7\xec\x9a\xf9\x5c\x64\xa2\xd0\x60\x08\xae\xe9\xa1\xf2\x88\xc9\xc3\x87\x6f\xc3\x39\x1a\xaa\x5f\x1c\xd4\xfb\x80\xb4\xe6\x02\x13\x99\xe7\x28\x52\x6b\x23\xe1\x08\x3e\xeb\x68\x2f\xd9\x16\x08\x0a\x48\x04\x2e\xab\x3a\x17\x11\xcb\xe0\x34\x93\xcb\x8a\x8b\x30\x59\x48\x5d\x95\x24\x37\xb8\x0e\x6a\xb5\xf2\x8c\x7e\x2a\xa7\x19\x4f\x28\x27\x79\x70\x78\xdd\x3c\x44\x31\x51\xed\x5b\x1f\x22\xa5\x38\x63\x65\x66\x7a\xe6\x69\x86\xd5\xf6\xa4\x60\xab\xb7\x2c\xcb\xe4\x92\x42\x2c\x65\x9b\x0b\xca\xc2\x19\x48\x84\x84\x15\x6c\xca\x33\x5e\x59\x2e\xc2\x3e\x2b\x4d\xa9\xd0\x82\x69\x62\x2e\xa7\xa5\x9f\xbb\x45\xaa\xc1\x3b\xd1\x8e\xa7\x61\x0c\xef\x02\xd0\x2f\x2f\xde\x88\xd5\x85\x4b\xa1\xde\x37\x56\x78\xe8\x19\x7f\xf8\x4b\x53\x1f\x3e\x06\xa3\x15\x2a\x2b\x09\xcb\x92\x32\xf3\x24\xb3\x5c\x96\xd4\xec\x32\x03\xcd\x32\x84\x5b\x96\x95\x08\x46\x31\xa1\x67\xa8\x94\xab\xc5\x38\x5d\xeb\x17\xcc\xb9\x34\x08\x47\x70\x66\xfc\x4a\x4e\x49\xbf\xcc\x12\x51\x90\x6d\xb7\x31\xe7\xcb\xe1\x71\xf3\xdc\xf5\xfe\x8e\x86\xcc\x5c\x4a\x2b\x4c\xcc\x35\xdc\xd9\x01\xb5\xb1\xa5\xc4\xd1\xf1\xf0\xff\xbd\x22\x50\x11\x6b\xaa\x1b\xb2\xf4\x73\x5a\xa0\x1f\xe1\xae\x41\x5d\x63\xd2\x5f\x49\xee\x2c\xcb\x56\x50\xa0\x4a\xa8\xc0\x3b\xa7\xc5\x88\x6a\x57\x94\x52\x16\x60\x50\xe5\x9a\x44\x42\x3d\x09\x1a\x0a\xc9\x85\xd1\x0d\x4c\x5c\x80\x96\x19\x4f\x69\xa5\xab\x40\x40\xe7\x94\x09\xf2\xed\x32\x9a\x92\x5e\x19\x29\x44\x4a\xb5\x0a\xaa\x7e\x91\xaa\x5f\x7f\x3e\xe5\x77\xaf\x7e\xbe\x26\xbf\x68\x80\x65\x0a\x59\xba\x0a\xbd\x28\x8d\x19\x88\xd1\x78\x7a\x5a\x3e\x48\x98\x26\xd9\x26\x8c\xbe\xd0\x19\x5f\x16\xa8\x58\x37\x5b\x65\x23\x17\x61\xb8\xc2\x6c\x45\x86\x06\x55\xce\x05\xd7\xc6\x55\xed\xe6\xa8\xa2\x91\x56\xde\x3e\xa8\x2a\x0b\xd2\xd5\xff\xef\x27\x95\x33\xaa\x36\x27\x5c\x73\x29\x86\x1d\x25\x4d\x4a\x33\x86\x8a\xa5\xa6\xd6\xfd\xdd\x27\x3c\xa3\x5a\xed\xb8\xca\x41\xba\x32\x1f\xb1\x57\xb1\x41\x53\xb0\x15\xed\xe3\x68\x6d\x9b\x5b\xd6\xbe\xc0\xac\x62\x74\xc1\x8b\xa0\x5e\xf4\xe2\xba\x4a\x59\x5e\xfb\xf6\x09\x32\x96\x03\x97\x2a\xa1\x53\xc7\x1c\x30\xd3\x2e\x7e\x21\x78\xb9\x14\x54\x3a\xb4\x79\xcb\x25\xa3\x36\x10\xe9\x82\xb7\xee\x2e\x6c\x90\xdf\x5b\x92\xa3\x2d\x51\x50\x01\xe9\xf1\x1b\x75\x10\xcb\x6f\xd0\x37\x57\xdb\x6b\x15\x2a\xae\x17\xfa\xff\x91\xb3\xf8\x8b\x0d\xa3\xe0\xc5\x0b\xc2\xe9\x62\x19\x18\xc3\x3e\x45\x58\xd5\x36\xa9\xf7\x26\x17\xb4\x73\x78\x0a\x8a\x89\x39\x02\x75\x23\x7c\x39\x1e\xbc\xfc\xba\xbf\xc6\xb9\x85\xf8\xc4\x6f\x7f\x98\x40\x60\xbb\x0b\x45\x04\xd8\x00\xa6\xfb\x6a\x7b\x1d\xbf\x13\x69\xfc\xea\xbc\x0f\x65\xeb\x5d\x0c\x1a\x18\xb1\x06\x8d\x7c\x01\x51\xc3\x6f\x69\xa9\x9b\xf1\x71\x30\xdb\x94\x86\x77\xd5\x28\x42\x61\xad\x38\xde\xa2\x30\xa5\xb5\x06\x31\xae\xba\xbe\xad\x97\xdc\x24\x8b\xa9\xa4\xbe\x44\xcf\xfa\x20\xe0\x5d\xd8\x7d\xed\x7b\x93\x60\x5a\x3a\xb4\x52\xb4\x10\x06\x92\xec\x37\x21\x97\xc3\xfe\x90\xa8\xd7\x77\x8d\xa1\xfe\x06\xf7\xed\x28\x63\x54\xd8\x97\x23\xe7\xf4\x4f\xaf\x3c\x8a\xb6\x14\x3f\x52\xa1\xfc\xb1\xd5\x08\x9f\xe2\xaa\xd2\x51\xce\x20\x63\x85\x0b\x7c\xcb\x24\x17\xc0\xd7\x9e\xe5\xdd\xb4\x4f\xce\xc4\xdb\xf1\x36\x6f\xa6\x3b\x5b\xd3\x3e\x1d\xc3\x17\x0b\xd3\x93\x5b\x6f\xbc\x6e\x6f\x24\xab\xce\x16\x03\x4c\x5a\xf8\xb7\xc6\xba\x5e\x96\xdb\x02\xdd\x0a\x6e\x5b\x94\x5b\x41\x3d\x35\xc4\xad\x46\xef\x18\xdf\x86\xd5\xf0\x40\x2d\x6d\xfa\x9e\xe0\xd6\xa5\xc5\x8c\xac\x5d\x69\xa5\x28\x03\xdf\x3c\x61\x95\xc8\xf6\xad\x29\x85\xba\x90\x3c\xa5\xfd\x6a\x3b\x38\xae\x56\x05\x0e\x7b\x75\xa7\x1d\xdd\xda\x72\xb9\x8f\x61\xed\xd8\x8e\x5e\x50\x35\x73\x43\xb3\x13\x61\xb1\x03\x8f\x68\xed\x20\x91\x39\x6a\x77\x88\x27\x05\xb4\x27\x3e\x7a\x33\xd2\xe5\x94\xfe\x4b\x35\xa8\xca\x50\x4d\x31\x05\x6a\xce\xab\x8b\xa1\x78\x8b\x19\x39\xe0\x61\x2e\xff\xc5\xb3\x8c\x0d\xa5\x9a\x8f\x50\x1c\x7d\xbe\xb4\x85\xd2\xd1\x7f\xe3\x74\x44\xdd\x1e\xa3\xb7\xd4\xda\xa8\xbf\xc9\xd9\x37\xfb\xf5\xe3\xd9\xc7\xf7\xdf\x08\x79\x57\xab\x83\x3c\xd6\xb8\x9b\x5e\xee\x06\xdd\x61\x4d\x7d\xb0\xea\x4e\x43\x27\xf4\x7f\xed\x17\x61\xf0\x24\x7c\xda\xb4\x1b\x32\x9e\xa0\xa0\x58\x36\x49\xa4\xb2\x8b\x68\x64\x90\x89\x2e\xd2\x3b\x2b\x06\x07\xa5\x47\x0d\xdb\x10\x0c\xb2\xd5\x16\x17\x0a\x94\xba\x2e\xe4\xfb\x86\x07\x3a\x14\xd9\x63\x0f\xd9\x2c\x87\x2b\x1d\x42\x5b\x49\x3e\x38\x5a\xee\x3b\x82\x24\x42\xce\x42\xd7\xc4\x1a\x69\x7e\x03\xde\x01\x69\xef\x24\x2b\xbb\x26\x36\x98\x44\xe3\x36\x89\xaa\x69\x38\x3c\xb1\x5b\x2d\x87\x03\xdc\x66\x3a\x1c\xd8\x53\x6d\x87\x1b\xbe\xa3\xf1\x70\xd0\xcf\x6b\x3d\x7a\xcc\x07\xde\x15\x92\x94\xcb\xb6\x28\xd8\xd6\x11\x72\xdf\x54\x14\xb1\x3d\xbf\x80\x77\x06\x15\x45\x96\x9a\x1b\x1c\xf6\x2b\x57\xac\x57\xd3\x55\xdc\xd9\x43\xba\x74\x83\x30\x0c\x4d\x3c\x6f\x33\x99\xd0\x2c\xd2\x37\x05\x85\x53\x1d\x69\xa3\x54\x7c\xce\x69\xb2\xfa\x38\x6a\x95\xb2\x63\xac\xde\x3b\xaa\x88\xe8\xae\x2e\x46\x6d\x5b\x1d\x05\x8c\xde\xf5\x6a\x5e\xa9\xb2\xc9\xc6\x4e\xac\xb6\x96\xc5\xa4\x6c\xd5\xb4\x08\x78\x9b\xb6\x45\xa0\x4f\xd5\xb8\x08\xc5\x8e\x5a\x17\x8d\x78\x5e\xcd\xf3\x69\xb9\xb6\xde\xd1\xaa\xc7\xa5\x60\xd7\x66\x64\xbb\xd6\xdc\x65\x11\xa3\x38\xde\xa2\xd3\x04\x87\xc8\xe3\xdb\xaa\x7e\x94\xfd\x41\x53\x16\xc0\x48\x8f\xa2\xc0\xab\x0a\xb2\xe8\xfa\x0b\x2a\x9b\x20\xa2\x9e\x32\x9a\xb0\x0a\xf1\xea\xcc\x42\xc8\x75\x47\xea\x77\x7e\x7a\x55\x77\xf1\x9f\xd0\x85\x9a\xfb\x9e\xde\x2c\x11\xf0\x2e\xad\x1f\xb3\x19\x14\x67\x62\x95\x4f\xcd\x84\x52\x0e\xc1\x62\xda\x3d\xf3\x39\x1c\x9f\x5c\x1f\x53\xf8\x52\x6b\xb6\x9d\xb1\xa2\xda\x86\xdc\x55\xfb\x47\x5e\x6a\x9b\x19\xa6\xad\x84\x69\x24\xf2\x1e\x06\x43\x1d\xd7\x4b\xd8\xa3\xad\xee\x04\x90\xcf\x09\x35\x04\xcb\x80\xaf\x12\xb8\xbe\x15\xd7\x12\x43\x6b\x1b\x5d\x11\xe9\x7a\xdb\x22\xc4\xd6\x71\x9c\xdd\xe2\x44\xf1\x5b\xca\x95\x44\xac\xd4\x47\xa9\x0e\x33\x66\x11\x0a\xc7\x75\x7d\x89\xd0\x04\xf6\x56\x04\x4d\x8b\x9d\x2a\xb6\x24\x25\xd0\x55\xf5\x81\x46\x46\xea\xb0\x90\x99\xf5\xad\xe7\xa7\x57\x3d\x74\xbb\x19\x1c\xe5\x15\x85\x6b\x17\x21\xc2\x4a\x41\x87\x8f\xf0\x9b\xa5\x0d\x77\x65\x46\x97\x33\x4a\x0b\x92\x0b\xa6\xdc\xc4\x91\x3d\xcd\xd4\xf7\x68\xbc\xd4\x1b\xd3\xf8\x6e\x33\x0d\x07\x29\x16\x52\x73\x03\x7f\xa2\x00\xf8\xec\x44\xc3\x9f\xdc\x0d\x92\xf3\xd3\xab\x66\x2e\xb0\xd9\xd2\x47\x21\xdd\x94\x25\x37\x4b\xa6\x52\x6a\x87\xc8\x0b\x66\xb8\x13\x17\xc9\xaa\x7b\x42\xb1\xf5\x52\x97\xc6\xab\xda\x30\x7b\x69\x6b\xdf\x79\x1a\xd6\xfb\xc4\x49\x27\xa8\x07\x2c\x29\xbf\xa4\xd1\x18\x2e\xe6\x50\x16\xf1\x9c\x43\x5b\x9e\x17\xb8\x6c\x20\x8f\x00\x5c\x23\x07\x55\x55\xeb\xca\xfc\x14\x01\x7f\xa3\xaa\x8d\x73\x27\x56\xfa\xae\x4e\x44\x21\x8f\x80\xeb\x4a\x03\x3f\x58\x35\xa2\x30\xf8\xba\xbb\xe1\x2a\x90\x9a\xee\xea\xf2\x50\x73\xa5\xaf\xc2\xba\x76\x74\xd3\x65\x3d\x18\xdd\x29\xa1\x5b\x2f\x5c\x0a\x0c\x56\x85\x94\xda\xf7\x86\x69\x10\xd4\x8c\x45\xe7\x61\xd6\x40\xae\x50\x1b\xc5\xab\x22\x18\xcd\x63\x17\x24\x67\x62\x15\x6d\xad\x21\x9c\x4b\xc3\xa6\x19\x95\xd8\x10\xae\xc9\x47\xb6\x25\xdd\x4a\xc3\x5a\x18\x7f\x5e\xbd\x1e\xd8\x8d\x7b\xdd\xb8\x64\x36\xf4\x9e\xa4\xc6\x74\x1d\x4d\xe9\xba\x81\x7f\x2b\x79\xaf\x9d\x6a\x4b\xf6\x79\xc4\x16\x19\x83\xae\xdc\x1a\xb8\x59\xbf\xdc\x6c\x19\x9f\x92\x71\x79\x99\xd7\xb2\xfa\xe4\x36\x74\xc4\x5f\x97\x21\x07\xb3\x99\xa5\x53\xb7\x19\x7d\x86\x31\x93\x4b\x5d\xdd\x2e\x74\x57\x59\x98\x00\xcc\x0b\xb3\x6a\xfb\x1f\x6f\x15\x88\x00\xef\x06\xc8\xd6\xd7\xc7\x05\x42\xef\xad\x72\x57\xde\x76\x0e\x7c\x4f\xa8\xeb\xf5\x1a\xc3\x01\x15\x9d\xfe\xba\x61\x1b\x1e\x6e\xba\x88\xb2\xce\xd9\xd4\xaa\xe4\x48\xe8\x31\xe3\x2d\x98\x75\x26\xb3\x0f\x55\xcc\x00\x89\xb8\x0f\xa6\xbd\x0c\xfd\xd3\x6d\x86\xea\x95\x99\x5f\xc1\x9d\x64\xe7\x31\xed\x96\x29\x6c\x53\x3e\xe4\xfa\xb2\x3a\xe6\x1e\xc8\x59\x45\xe0\x2f\x2f\xee\x37\x4c\x58\xed\xe4\x01\x74\x40\xfc\x46\x1e\xc0\xb6\x2d\xfc\x40\xa1\xdf\x18\xf6\x9d\xf9\xa5\xc9\xab\xd8\xa0\xf2\xed\x08\xdf\x37\x3d\x99\x91\x6d\x24\x44\x46\x64\xd8\x4c\x7a\xf6\x2f\xdd\x8e\x62\xf2\x9b\x78\xb0\x0b\x0b\x3b\x8b\xc9\x21\xdd\x45\x50\x8f\x22\xe0\x71\x82\x1a\xee\xaf\x09\xb2\xeb\x23\x72\xbd\x3b\x27\xd1\xe7\x2e\x60\xbd\x5b\x27\xf5\xc7\x1e\x30\xc7\xcc\x27\xd7\xf3\x1e\x7d\x5d\x87\xb3\x26\x7c\xd2\x7e\xb0\x6e\x48\xbd\xc8\x93\xf6\x83\xf5\x24\xd5\x30\x11\x61\x9b\x06\xf6\xee\xf3\xc9\xc6\xdd\xbf\xfb\x81\xaf\x1b\xfc\xdb\x63\xdf\xd2\x37\x92\xd8\xac\xb4\x3b\x01\x31\x61\xf5\x28\x0d\x05\x9a\xf6\x81\xb0\x83\x6c\xdb\xb1\xb0\x33\xe0\xa9\x87\xc3\x0e\xa2\x1d\x8f\x88\x9d\x71\xff\xa7\x07\x45\x3a\xdf\x2d\xe4\xd2\xd6\xe9\xbc\xaf\xfc\xa3\x8e\xfc\xac\x43\xf8\x98\x03\x23\xb5\x00\xd0\x71\x53\xde\xa2\xaa\xd8\x16\x29\xd8\x3b\xb1\x3c\xd1\xbe\x10\xdf\xf4\xe6\x1e\xbd\x27\x01\xa6\x98\x49\x31\xa7\xc4\xc6\x96\xc3\x63\xe7\x0a\x21\x05\xd1\x2c\xef\x84\x49\x96\x58\x1b\x31\xbb\x6b\xaa\x14\x34\x87\xe9\x6a\x4a\xba\x81\x42\xdc\x72\x1a\xc7\x2e\x70\x52\xd7\x83\x7a\x67\xeb\x13\x85\x3f\x28\x6e\x9a\x30\xaa\x33\xf5\xce\xeb\x93\x0c\x55\xd3\x81\x91\x75\xf2\xc9\x4a\xdb\x56\xec\xe3\xa5\x66\x53\x59\x9a\xed\xd3\xfa\x4c\xd5\xe7\x8b\x0f\x8d\xec\x49\x63\xee\xcb\xdf\x4a\xa6\xd0\x55\x41\xaa\x8b\x64\x8d\x1c\xfa\xd6\x59\xb4\x45\x70\x46\x23\x5d\x31\xa1\x81\xff\x2d\x13\x02\x55\x03\x7f\x68\x9e\xa8\xd1\x0e\xda\xe7\x7f\x7b\xba\x62\xf6\xbe\x05\x08\x64\x0a\x5e\xfe\x74\x7c\x7c\xf7\xea\xcf\xc7\x5d\x02\xa6\x76\x86\xb5\x04\x5c\xca\x84\x3b\xd1\x92\xf6\x81\x42\xfa\xad\x8c\xd6\xfc\x7f\xd4\xa0\x2b\xb8\x85\xcc\xb1\xa0\xdb\x9e\xf5\x44\x94\x4d\x90\xee\xba\xe5\x0d\xae\xc2\x21\x6b\x9f\x2e\x84\xd3\xdd\xf0\x7c\x7f\x00\xfb\x66\x49\xb7\xd9\x15\x7d\x4c\xb9\xa6\xcc\xf4\x7e\xeb\x7a\x74\x90\x98\x9d\x49\x8f\xe1\xbe\xd2\x85\xc6\xe2\x3c\x6c\x8a\x46\x63\xcd\x6d\x06\x72\x3d\x2a\xd6\x04\x58\xa7\x0c\x4d\xa8\xee\x62\x36\xdf\x77\x65\xdd\x1a\xbf\x99\xb5\x75\xc1\xe2\xb3\x5e\xc0\x8e\x38\x85\x49\xcc\x77\x17\x34\x62\x17\x26\x31\xf3\x5d\xd0\x88\x73\x98\xc4\x72\xe8\xc1\x5a\x09\x81\x30\x56\x9f\x9e\xea\x4a\x9d\x29\x7c\x36\x6f\xba\xdb\x8d\xf1\xbe\x31\xcf\xe3\x53\x1f\x75\x97\xbc\x6f\xe8\xef\xec\x59\x6b\xab\x17\xfa\xe1\xaa\x3b\x9f\xf1\xaf\xc7\x48\xd1\xc8\xc1\xb7\x3c\x29\xd7\xb5\xab\xa0\x1c\x8c\x62\xb6\x49\x66\x16\xb7\xd0\xde\xe0\x6a\x54\xb5\x44\x14\x8c\x2b\x0d\x8c\x3c\x63\x75\x38\xb7\x9d\xf1\xb6\x04\x75\x47\x9d\x01\xd6\x68\x52\x7c\x1c\x4c\xbe\xed\x8c\xe2\xa6\xed\x42\xaf\x68\x9e\x48\x3a\x3d\x17\x81\xec\xb8\x21\x7c\xe0\x37\x08\x6f\x59\x72\x43\xbf\xe2\x22\xd2\x01\xbc\x5f\xd1\xcf\x09\xfd\x8d\x71\xb5\xc6\x5c\xad\x75\x97\xd4\x8f\x5f\x8a\x14\x55\x66\x9b\x67\x2a\x96\xe2\xd9\x06\xae\x6f\x86\x7e\x02\xc5\x5f\x93\xc5\x2c\x75\x1d\x87\x96\x20\x1f\xbd\x7a\xa6\x7d\xa6\xca\x22\xeb\xd2\x62\x1f\x47\xe5\xc7\x06\x3d\x2e\x0e\xa0\x08\x38\x5e\x07\xbd\x90\xcb\x86\x60\x9d\x30\xed\x8d\x06\xeb\x07\x88\x43\x1b\xd3\x58\xf4\xb5\xf3\x8b\x91\x93\xd3\xb2\xf6\x5e\x24\x38\x80\x95\x2c\xdd\x85\x27\xed\xa9\xa2\xa9\x6c\xdb\xf8\x1d\x18\x9e\xa3\x36\x2c\x2f\xaa\xa4\x92\xeb\xed\xf1\x3f\x50\x72\xe5\x8a\xba\xfb\x27\xcc\xe0\x3e\x0d\x33\x98\xd5\xf5\x95\xd1\x08\x8a\x8c\x19\xf2\xf6\xd6\x5f\x25\x52\xe8\x32\x77\xc1\x5c\x25\x33\xba\xbb\x0e\xb6\xe5\xcf\xb7\x12\xb2\xf6\x8d\x02\x2f\xb0\x68\xce\xde\x0b\x57\x70\xc1\x14\xb5\x90\x51\xc5\x8a\x65\x5a\x86\x58\xa7\xaa\x46\x65\x2b\xa7\xef\xcc\x18\xc5\xa7\xa5\xaf\x78\x45\x9a\xdf\xd2\xfe\xd0\xdb\xe1\x9b\xc4\x2c\x79\x59\x56\x63\xd0\xf6\xea\xb4\x63\xcd\x3d\xf3\xcb\x5e\x05\xab\xca\xd2\xd4\x5d\xfd\xea\xf9\xd8\xd1\xbc\xe9\x26\xd1\xa0\xa3\x29\x83\x5e\x51\x0c\xda\x38\x1f\xef\x99\xec\x44\xf4\x23\x18\xf4\xdf\xee\xeb\x68\x56\x98\xc4\x34\x74\x41\x2b\x52\xa8\xb7\xc9\x7e\x58\xe7\x35\x1a\x06\xcb\xda\x34\xea\x86\x0f\x9b\x4e\xef\x6e\xa1\xdc\x70\x37\x8c\xe9\xe7\x31\x52\x55\x7c\x45\x38\x3b\x91\xbe\x35\x53\x71\x03\x88\x5f\xd9\x8a\x04\xfa\x01\x2b\xfa\xd0\x6d\xa6\x69\xbd\xef\x5d\x25\xc7\xc6\xc4\x01\x07\x80\x87\xbd\xc6\x74\xe4\x19\x59\x9a\x5a\x52\x0e\xbe\x81\x19\x83\xfd\xb8\x01\xe5\x90\x15\x05\x8a\xf4\xc0\x1c\xae\x5b\x92\xae\x23\x77\x9c\x5a\x6f\xb6\xb5\x08\x5a\x01\x6f\xf3\xcb\x15\xd4\x53\x3d\x71\x35\x7a\x47\xdf\xdb\x59\x27\xff\xf7\x1c\xde\xd6\x09\xca\x17\x6d\x48\x62\xc8\x34\xcf\x56\xe4\x03\x6e\x91\x7e\x5c\x0a\x52\x6e\xdd\x3e\x35\x87\x93\x65\xb0\xe4\x54\x25\x90\xe6\xe1\xce\xd5\x62\x52\x69\x1b\xaa\xad\x5d\x41\x6e\x16\xe1\xd2\xa8\x9f\xb5\xee\x38\xa3\x5d\xea\x1c\xd0\x95\xf7\x51\x54\x37\x41\xb3\x90\xe1\x06\x66\x55\x84\xc2\x70\xf6\x35\x0b\xcc\x69\x4f\x31\x7b\x3b\x86\x7e\xf9\xcc\xb6\x2f\x3a\xba\x1a\xcb\x49\x94\x5f\x49\xb7\xa2\xf4\x25\x8a\x85\x83\x31\x7a\x18\x00\xde\xd9\x64\x63\x7a\xce\x72\xa4\x9b\x32\x95\x41\xfa\xfa\xfa\x70\xdc\x95\x3e\xf5\xc1\x07\x96\xab\xf6\x51\xed\xfa\x47\x89\x6d\xdb\x43\xea\x6d\x80\xf3\xb0\x55\xf3\x37\xaf\x6f\xe7\xfb\xa4\x5d\x54\xa8\xb0\x16\x01\x57\xad\x4e\xd4\x05\x13\x69\x86\xd5\x26\xb7\x9e\x8b\x0a\x0b\xb6\x9d\xd5\xd4\xc0\xff\x2c\x75\x34\xb7\x15\x8f\xc7\x6f\x0b\x0c\x59\x74\x23\x94\xcf\x9a\xcc\xf6\x5f\xd8\x24\xff\x7a\x43\x15\xa9\x06\xec\x0f\x2d\x28\xfa\x47\x42\x1d\x2a\xcc\xe5\x2d\x1e\xdc\xe0\x6a\x0c\x37\x87\x6b\xd5\x31\x7c\xec\x31\x32\x30\x81\x2f\x5f\xf7\x3a\xf3\x5b\xf4\xf6\x98\xd7\x9c\x3a\x60\x80\x49\xb5\x42\xce\xe1\xdc\x04\x5f\x43\x23\xbf\xdc\x7c\xfd\xa1\xe5\x6a\x04\xcf\x6a\x37\x23\x78\xd6\xa4\xb6\x65\x62\xe8\xdb\x61\x1f\x03\x6e\x43\x39\xc5\xaa\x46\x1d\xb6\xad\x90\x73\xe6\x6b\xaf\x92\x47\x8e\x25\x78\x05\xd7\x3e\x6f\xf7\x11\x73\x74\x5a\x0f\xad\xc3\x39\xdc\x6d\x29\x3a\xd4\x52\x4b\x4a\x7c\xfc\xb2\x6d\xa7\xdc\xd8\x0c\x92\x3d\xb3\x4f\xa5\x59\xb4\xcd\xbe\x23\xeb\xbe\x73\x81\xda\x61\x74\x37\x39\x2a\x28\xa6\xbb\xd1\x8c\x6f\xb0\xf1\x78\x69\x2d\xec\x58\xdf\xbc\xfc\xba\xf7\x72\xb6\x2d\x71\x85\xb0\xd4\x0e\x88\xc1\x3a\x28\xab\xcb\xc6\xeb\x10\xc6\x5c\xbb\x70\xd6\x49\xcb\x92\x4c\x25\x4a\x31\x1f\xf6\x4e\x50\x37\x06\xf9\x7c\xd2\x07\x9c\xd3\x35\x14\xb5\x1a\xc0\xfb\x82\x2a\x0a\x17\x4c\xe1\x00\x3e\x0b\xea\x82\xa0\x7c\xc8\x3b\xfb\xdf\xe6\x85\xfb\x6a\x8a\x56\xa4\xe1\x89\x8f\xc8\x0b\xe1\x4d\xcd\x84\xad\x6c\x35\x45\xe6\x2f\x57\xfb\x6f\x7d\x08\x7a\x9c\x85\x45\x02\x93\x6a\x0f\xbf\x78\x11\x0f\xf3\x4f\x9b\x63\xe8\xaf\x60\x82\x27\x07\xfb\x6f\xe0\xa2\xa5\x5f\xda\xaf\x64\x63\x7e\x62\x9b\x14\xa9\x73\xdf\xba\xf1\xd5\xba\x68\x47\x4e\x6b\x75\x37\xdc\xa8\x0e\xaf\x1a\x84\xc7\xb3\xef\xee\xe5\x1d\x37\xbb\x79\xf9\x0a\x78\x9b\x97\x77\x71\xe8\x13\xbd\x7c\x35\x7a\x47\x2f\xef\xa8\x6f\x02\x3e\x83\x97\xf7\x3c\x73\x7d\x7e\x7a\xb5\x8d\xdf\xb7\x52\xc6\x0a\xe3\xa7\x8e\xb9\xe4\xfa\xcc\x1d\xbc\xaa\x50\xa6\x5b\x90\x3c\x3f\xbd\x22\x9e\x5b\xcb\xf5\xdd\x7f\x0e\x91\x4d\xcf\xb9\xab\x1d\xee\xbe\x48\xa3\x6e\x45\xda\x10\x1a\xb1\xbe\xff\xaf\x2d\xc4\xdf\x47\x86\x4d\x01\xfe\x83\x2e\xa0\xb5\x45\xe8\x49\xa0\x5f\x98\xa5\xf7\x6f\x59\x66\x11\x6c\xa1\xc6\x19\x94\x27\x6a\xf0\x5f\x5b\xb7\x55\xdc\xa4\x0f\x3b\xea\xf4\x8b\x35\xc3\x5b\x43\x63\x31\x0d\xa7\x15\xcc\x77\x6a\xbb\x97\x92\xaf\x04\x6f\x13\xd3\x8b\xfb\xfe\x0a\xf2\xc3\x73\x49\x2e\x20\x7c\xa2\xe8\xc2\xf8\x0d\xb2\x7b\xa2\xcc\x9e\x5c\xcf\xc2\xf0\xa3\xd3\x60\x99\x74\xd8\x9e\xbd\x98\xd5\x33\x4b\x14\xcf\x9c\x5e\xd9\xfd\xf2\x0c\xa5\xaa\xe6\x3c\xc3\x8e\x53\xff\x5d\x4a\x55\xdb\x26\xed\x09\x04\x1a\x73\x3f\xb5\x5c\x85\x5b\x67\x5e\x57\xa4\xd8\x56\xb1\x5a\xbf\x6a\x31\xf6\x6e\x71\x63\x5b\xa5\xea\x39\x8b\x52\x8d\x88\xf2\xdf\x55\xa9\xdf\xb7\x2a\xf5\xfa\xdf\x65\xa9\x47\x95\xa5\xc2\xc7\xc8\x9b\x55\x2a\xaa\x0f\x0e\xa1\x4e\x4f\xb4\x04\xe4\xac\x7b\x8c\x7e\x48\xba\xb8\x1b\x66\x6a\x58\x39\xf8\x06\xf6\x0c\xef\x2f\x20\x34\x56\xe6\xf5\xf6\xe9\xbe\xdc\xe0\xea\xeb\x0e\x01\xbb\xcf\x36\xb5\x5d\x76\xd3\x94\x6f\xf3\xd9\x4d\xe8\xa7\x3a\xea\x26\x96\x1d\xbd\x73\x73\x50\x6b\xc0\x33\x05\xed\x2d\xf7\xd6\x6c\x65\xf1\x76\xc2\x90\xe9\x7e\x93\x71\xa6\xfd\xaa\x75\x40\xd6\x35\x4d\x76\x00\xfd\xa5\xd6\x76\xe7\x64\x07\xd0\x85\x68\x5b\xe1\x7c\x2f\x54\xa7\xc9\xb2\x03\x79\x4b\x2c\xd6\x6d\x91\x6b\x49\xdb\x00\xe2\x88\xda\x00\xe1\xc9\xd9\x00\x92\x94\xda\xc8\x3c\x12\xd2\x18\xee\x09\x10\x1a\x92\x7b\x58\x33\x2e\xe2\xb0\x1e\x17\x3d\x5c\x3b\x2e\x88\x30\x1a\x16\x9e\xf5\x8c\xaa\x3b\xc5\xac\x1a\xfa\xd6\xd0\x9e\x53\xc4\xc6\x76\xda\xae\xf2\x0c\xa0\x01\xb0\x4e\x75\x9a\xf6\x7c\x9d\xde\x34\xa1\xd6\x28\x4d\x13\x68\xad\xc6\x34\xc1\x5a\xea\xd2\x4f\xce\xba\xf7\x1d\x45\xe9\x27\x61\xdd\xfb\x1d\x55\xa4\x6f\x50\xc4\x4f\xb4\xd0\xf5\xc3\xfe\x41\x41\x54\xd1\x98\xf0\xac\x3d\xe4\x31\x9a\xb1\xce\xd1\xf6\x37\x0b\xc7\x62\xed\xef\x80\x5d\x73\x60\x71\x9d\xab\xfe\x7b\x4f\xe7\x6a\xff\xc0\xcd\x6d\xb9\xd1\x2a\xee\x42\x4d\x7c\x70\x1d\xc3\xbe\xfb\xba\x95\x16\x0f\xb7\x91\x94\x58\x63\x76\xa1\xc5\xf7\xe5\xee\xd0\xd3\xdb\x3f\xb0\x26\xa7\x45\xcd\x43\x37\xce\xa8\xf7\xf8\xa4\xfe\xd8\x05\xdb\xb9\x5d\xd7\xab\x01\xe9\xeb\x24\xfe\xd2\x05\x75\x4b\x44\x2f\x27\xd1\xe7\x2e\xe0\xa3\x7a\x7b\xc3\xde\x9f\x84\x4f\xeb\xa9\xa4\x25\x09\x54\xf6\x83\x3a\xca\xe8\xa5\xa7\xb2\x1f\xd0\x93\xd5\x6a\xf4\xed\x07\xee\x58\x09\x98\x74\x2d\xc7\xba\x61\x91\x9d\x80\x49\xd7\x76\xac\x1d\x16\xac\x42\x3d\x2a\x3c\xea\x19\xd4\xb2\x15\x71\x03\xb2\x7d\x10\x86\x3c\xec\x35\x5c\x90\x0b\xd6\xde\xc5\xec\x90\x14\xaa\xd8\x94\x3e\x6d\x8a\x4c\x3b\x52\xd8\x25\x46\x7d\x17\xcb\xe0\xb1\x73\x45\xa2\x7b\xc4\x5c\x56\x72\x8f\x9e\x2a\xc8\xbb\x3d\xd3\x53\x43\xe1\x1d\x7a\xae\x23\xd0\xef\x0d\x82\x77\xef\xb3\x8e\x46\xb4\xa0\xbf\x33\xfc\x7d\xf8\xdf\x01\x00\x6a\xb2\x3f\xf9\x28\x6e\x00\x00"
+
+func metadataviewsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_metadataviewsCdc,
+		"MetadataViews.cdc",
+	)
+}
+
+func metadataviewsCdc() (*asset, error) {
+	bytes, err := metadataviewsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "MetadataViews.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x40, 0xbf, 0x40, 0xb5, 0xf7, 0x4e, 0xcb, 0x1a, 0x84, 0x70, 0x1a, 0x93, 0xf3, 0x85, 0x4e, 0xe0, 0xda, 0x23, 0x20, 0x5d, 0xef, 0xc6, 0xbc, 0x81, 0xc, 0x43, 0x81, 0x8e, 0x67, 0x69, 0x4, 0xc4}}
+	return a, nil
+}
+
+var _mocktokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xdf\x6f\xdb\x36\x10\x7e\xf7\x5f\x71\xcb\xc3\x20\xa1\x8e\xec\x01\xc3\x1e\x8c\xb8\x4d\x86\xb5\x40\x1f\x3a\x04\xeb\x7e\x3c\x9f\xa5\x73\x4c\x44\x22\x05\xf2\x64\x37\x0d\xfc\xbf\x0f\x22\xf5\x8b\x94\xe4\x64\xc8\x80\x14\x35\xc9\xef\xbe\xbb\xfb\xee\x78\xa2\x28\x4a\xa5\x19\x3e\x55\xf2\x41\xec\x72\xfa\x53\x3d\x92\x84\xbd\x56\x05\x5c\x25\x2b\x6f\x37\x49\xb3\xf4\x6a\xb1\x58\xad\x56\xf0\x45\xa5\x8f\x76\x0f\x84\x01\x84\x42\x48\x51\x60\x0e\xfb\x06\x0e\x6c\xcf\xf6\x4a\x03\x93\x61\x93\xd4\x46\xf5\x3f\xf8\xcc\x90\x2a\xb9\x57\xba\x30\xc0\x0a\xf8\x40\x81\x67\x21\x99\xf4\x1e\x53\x02\x94\x19\x54\x86\x8c\x05\x19\x2c\xc8\x12\xec\x05\xe5\x99\x3d\xd3\x64\x54\xa5\x53\x02\x89\x05\x19\x40\x03\x1f\xbf\x61\x51\x36\x44\x4b\x30\x8e\x9e\x35\x4a\x83\x29\x0b\x25\x81\xa9\x28\x73\x64\x32\x96\xea\xa4\xf4\x23\x9c\x04\x1f\x40\xf0\x12\x76\x15\x83\x60\x38\xa0\x01\xa9\xa0\x20\xc6\x0c\x19\xe1\x28\xe8\x64\xac\x3f\xa9\x60\x57\x69\x49\xda\x65\x53\x56\xbb\x3a\x15\xd6\x98\x72\xaf\xc7\x26\x48\xe7\x79\xb1\x00\x00\xa8\xc1\x47\xd4\xc0\x8a\x31\xff\x5a\x95\x65\xfe\xb4\x81\xbf\x3e\x89\x6f\xbf\xfc\xdc\x03\x72\x62\xf8\x1b\xab\x9c\xbf\xb2\xd2\xf8\x40\xf7\xc8\x87\x0d\x0c\x16\x1e\xf2\x0f\x4a\x49\x1c\x49\xdf\x57\xbb\x5c\xa4\x0e\xdb\xff\xf6\xa0\xbf\x62\x8e\x32\xa5\x57\x20\xef\xb2\x42\xc8\x59\xf7\x1d\x94\x8e\x24\x19\xac\xce\xe6\xb3\x14\x2c\x30\x17\xdf\x29\x8b\x84\xfb\xed\x27\x18\x4f\x9a\xfd\x23\xf8\x90\x69\x3c\xc9\x08\x0b\x55\x49\x6e\xd1\x4b\xdb\x7b\x1b\xb8\xcb\x32\x4d\xc6\x7c\x98\xb6\xfe\x8d\x4a\x65\x04\x53\x36\xb2\x66\xf5\x92\xed\x17\x21\xc7\x86\x71\x5f\x86\xae\xaf\x6c\x2d\x82\x82\x26\xf7\x5a\x1d\x45\x46\x7a\x19\xec\xb7\xe5\x08\xf7\x1b\xed\xdb\x46\x68\x9d\xd4\xcd\xb0\x73\x47\x6d\x08\x3d\xa0\x96\x31\x0a\x4e\x63\x78\xee\xce\xeb\x3f\x43\xf9\x3e\x69\x30\xb0\x6d\xb9\x3a\xc8\xd9\x77\xb7\xaf\xa4\xed\xf4\x5a\xf1\x30\xf3\x0d\xdc\xfa\x21\xdb\xbc\x2f\xbb\xf3\x96\xd7\xe0\x18\x3d\x03\x2a\xc4\x7c\xa9\xdd\xff\x6d\xa9\x2d\x99\x3a\x49\xd2\x1f\x12\x74\x65\x8f\x3d\x2e\x4d\x5c\x69\x09\x37\xd7\xa9\x26\xe4\xa6\x30\xbd\x40\x8e\x2d\xbe\x94\x7b\xe6\xfa\x25\x72\x0e\xa7\xf2\x0d\xf5\xad\x2f\xe3\xb1\x3e\x80\x9b\x6b\x1b\x27\xa0\xf9\x01\x6e\xbb\x8b\xee\xac\x5e\xad\xd1\x3b\x47\xd6\xae\xe7\xa4\x1a\xf7\xb5\x67\xb6\x04\x56\xaf\xd0\xcb\xb3\x81\x2d\xac\x93\xb5\x77\x9e\x91\x61\xad\x9e\x5c\x48\x53\xb2\x35\x80\x28\x14\xa5\xcf\x7e\x30\xc8\x60\x3b\xb3\x7f\xed\x49\xd0\x11\x9d\x17\x03\x6f\x6d\x81\x5c\x65\x3f\x16\x25\x3f\x59\x61\xa3\x78\x03\xb7\x61\x23\xbe\xd0\x07\xeb\x64\x1d\x87\xdc\xdd\x65\xb6\x93\x4d\x18\xd6\xc8\x4a\x87\xb7\xb1\x8f\xe0\x77\x3a\xd9\xf9\xa0\x23\xcc\x73\x75\xa2\xec\x6e\x74\x59\xdc\x39\x3c\x5f\x6c\xd1\x69\x12\x6f\x19\xcf\x2b\xd2\x45\xdd\xf9\x1a\x0d\x8f\x80\x39\x1c\x21\x6d\x56\x85\x90\x6c\x2b\x66\x22\x1c\x67\xd2\x95\x6d\xea\xca\x97\x9a\x82\x9d\xfa\xcf\xb1\xc0\xfb\xba\xa9\x36\x70\xe5\x32\xab\x1f\x00\x4c\x19\x14\x95\x61\xd8\x11\x3c\xd8\xfa\x68\xe0\x03\x4a\xf8\x4e\x5a\x5d\xcd\xf1\xdc\x34\xf7\x24\x48\x67\x86\x37\x27\x53\x3f\x07\x50\xda\x2f\x7b\x63\xd3\x50\xf9\x2e\xce\xde\xea\xbf\xf5\xed\xbb\x86\xd1\xa3\x18\x47\x09\xdb\xa9\xcd\x97\x86\x61\xf0\xf5\x09\x67\xd7\xdb\xc6\x9d\xfd\x70\x4c\x76\x46\x78\x93\x27\xd3\xf1\xd6\xd3\xdd\x69\x3d\x0c\xc9\x2c\x91\xaf\xea\x4f\xeb\xf5\x70\xe2\x58\x44\xf8\xae\x81\x2d\xac\x8c\x5b\xae\x8a\xb6\x0a\xfe\x48\xb5\x76\xe3\x57\x4e\x6d\x59\xda\x97\x4c\x6f\xd8\xa2\x7c\xdb\xd1\xb3\x67\xca\xb4\x01\xf9\x96\xe1\x33\x68\x32\x5a\x0b\xea\xb5\xb7\xe1\x62\x9a\xd6\x5a\x26\x06\x8f\x14\xcd\x15\x30\x94\x2c\x1e\x8c\xf5\x50\xa7\x78\xc6\x41\x2e\xe4\xe3\xcd\x8f\x7d\xff\x5a\xbb\x67\xff\xbb\xd6\xaa\x72\x7e\x1f\x75\x24\x1d\xd1\x58\xd8\xa5\x07\x62\xd4\x0f\xc4\x33\x51\x75\xc8\xb7\x84\xd7\x28\x3f\x19\xdd\xa8\x74\xff\x67\x70\x7e\x71\xbc\x0f\x43\x34\xac\x45\xd8\x05\x03\xbe\xc1\x85\xbe\xf0\xfe\x1d\x15\x7a\x01\x00\x70\x5e\x9c\x17\xff\x0e\x00\x87\xed\x8a\x96\x75\x0d\x00\x00"
+
+func mocktokenCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_mocktokenCdc,
+		"MockToken.cdc",
+	)
+}
+
+func mocktokenCdc() (*asset, error) {
+	bytes, err := mocktokenCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "MockToken.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x78, 0x73, 0x86, 0x37, 0x23, 0x94, 0x6, 0x31, 0xb2, 0x7f, 0x81, 0x30, 0x6f, 0x5, 0x18, 0x66, 0x28, 0x3d, 0x9f, 0xbc, 0x5c, 0xfd, 0x28, 0xc3, 0xf3, 0xb6, 0x6f, 0xda, 0x98, 0xe9, 0x2a, 0x16}}
 	return a, nil
 }
 
-var _utilitycontractsPrivatereceiverforwarderCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\xc1\x8e\xdb\x36\x10\xbd\xeb\x2b\x26\x2e\xd0\x4a\xc1\x46\xbe\x14\x3d\x18\xeb\x6c\x83\x6d\xf7\x58\x2c\x92\xb4\xd7\x62\x44\x8d\x2d\x36\x32\x29\x90\x23\xab\x8b\x85\xff\x3d\x20\x25\x51\xa2\x64\x07\xd8\xbd\xac\x28\x71\x66\xde\x7b\x7c\x33\xf4\xf6\x7d\x92\xfc\x04\x4f\xad\x3a\xca\xa2\x26\xf8\xaa\xbf\x91\x82\x67\x23\xcf\xc8\x04\x9f\x49\x90\x3c\x93\x81\x47\xad\xd8\xa0\xe0\x24\xf9\x5a\x49\x0b\x62\x58\x82\x3c\x35\x35\x9d\x48\xb1\x05\x04\xdb\x90\x90\x58\x83\x21\xab\x5b\x23\x08\x50\x95\x60\xc6\x14\x52\x31\x99\x03\x0a\x82\xa4\xab\xb4\x25\x28\xa9\xd1\x56\x32\x1c\x5a\x25\x58\x6a\x05\xd2\x82\x56\xf5\x0b\x08\xac\x6b\x74\x60\x8a\x17\x40\x05\x58\x9e\xa4\x02\xae\x8c\x6e\x8f\x15\x20\x34\x6d\x51\x4b\x01\x02\x1b\x2c\x64\x2d\xf9\x25\x4f\x92\xf7\xdb\x24\x91\xa7\x46\x1b\x0e\x54\x7a\x26\x07\xa3\x4f\xb0\xc9\xb7\x79\xbe\x8d\x3e\xe4\xa2\x14\x9b\x24\x69\xda\x62\x22\x33\xb0\x1e\x49\x3f\x69\xd3\xa1\x29\xc9\xc0\x6b\x92\x00\x00\x6c\xb7\xf0\xe7\x99\x14\x03\x57\xc8\x0e\x2d\x9d\x24\x33\x95\xd0\x55\xa4\x80\x5d\x5a\x0b\x68\x02\x33\x2a\x81\x35\x70\x45\xc0\x68\x8e\xc4\x41\x0b\x9f\xcd\x95\x26\x9f\x6e\xa8\xfb\x47\x1f\x95\xe2\x49\xb7\x8a\x77\xf0\xf7\x93\xfc\xff\xb7\x5f\xef\x80\xf5\x0e\x3e\x95\xa5\x21\x6b\x1f\xb2\x24\xc4\xd6\xc4\xf0\x85\x54\x49\xe6\x0b\x6b\x83\x47\x7a\x46\xae\x76\x30\x5b\xc4\x7b\x17\xec\x6e\x06\xfd\x20\xe6\xd9\x2b\xdf\x87\x4c\xcf\x53\x99\x70\xf0\x2b\xe9\x06\xf9\xbc\x79\xa4\x75\x82\x19\xf2\xca\xcc\xa5\xf2\xfa\x75\xb2\xae\xa1\x20\xb0\xa4\x38\x8f\x63\x09\xf8\xa5\x21\x90\xaa\x94\x02\x99\xec\x70\x0e\xfe\x28\x10\x0c\x1d\xc8\x90\x12\xe4\x44\xc7\x58\xeb\x3e\x45\x78\x44\x21\xc8\xda\xd4\x52\x7d\xc8\xe0\x8c\xc6\x6d\x96\x8d\x24\xa7\xfa\x63\xb0\xd5\xfd\xcf\xaf\xb1\x65\x46\x19\x2e\x1f\x23\x52\x03\x85\x6b\x85\xb6\x5b\x67\xc7\xde\xdd\x1e\x2c\xe3\x37\x72\x60\xff\xc1\xb6\x66\xd0\xc5\x7f\x24\x18\xd0\x7a\x9b\x9b\x63\xeb\x3a\xc9\x77\xcd\xa1\x17\xd0\xce\x33\x49\x1e\xed\x14\xe0\xfe\x62\x87\x4c\xad\x95\xea\xe8\xbf\x59\xd6\x86\xca\x49\x8d\x1f\xf0\x1f\x8d\x9f\xb9\x16\x1c\x69\xa4\xae\x63\x76\xf0\x7b\x4c\xdd\x57\xc9\xe0\x35\xa4\x70\x7f\xf5\xcc\xd2\x9f\xe9\x00\x7b\x70\x8a\xe6\x01\x5d\x5e\x68\x63\x74\x97\x66\xef\x92\x55\x5c\x81\x35\xba\xb3\xda\xfb\x0e\xcd\x87\x65\xbc\x6f\x96\x3b\x8f\xd1\xdd\x7f\x70\xff\xb3\x78\xbb\xeb\xc6\x5b\xbd\x34\xe4\xef\x9b\xc9\xa3\xd4\x9d\x22\xf3\x90\x63\xdf\x58\x59\xc8\x74\x99\x92\x4a\x25\x39\x7d\xab\x35\x96\x22\x35\x86\x16\x6f\x06\x6a\x0b\x8d\xe0\xdd\x1e\x94\xac\x77\xb0\x79\xd4\x6d\x5d\x82\xd2\x0c\xfd\xb7\x69\x0a\x4f\x16\xf7\x63\xcd\x1d\xf7\x84\x69\x13\x15\xb9\x44\xab\xf8\x5c\x60\x3f\xd5\x4f\xe2\x80\x4b\x98\x74\xc2\x10\x32\xfd\x45\xdd\xd4\xcb\xfd\x2b\x67\x5f\x45\xdd\xac\xc7\x27\x58\x9d\xe4\xca\xc3\x6a\x8c\x3e\xcb\xd2\xfb\x70\x5e\x68\xf0\xa0\x9b\x15\xce\x72\xeb\x1a\x6f\x97\xdb\x59\x75\x36\x6d\x26\x81\xb9\x35\x0a\xee\x3f\xf4\x35\xe0\x6a\x85\xf0\x98\x8d\xe4\xd7\xa3\xac\x1f\xb1\xb3\xcc\x23\x78\x4b\xaa\x1c\xdc\xe6\x41\xd9\xf4\x5f\x18\xdc\x14\xe6\xf5\xdd\x30\xd5\x6e\xf7\xd3\xaa\x31\x50\x08\x67\x59\xd8\xc3\x91\xf8\x53\xbf\x48\x83\x4b\x57\xdb\x9b\x78\x42\xc3\x7e\x4c\x90\x1f\x89\xe7\x0a\xde\xba\xdc\xf2\xf0\xf4\x31\xbd\xb9\xe7\xe6\x3d\x90\xad\x9c\x3d\x19\xfa\xe1\x01\x1a\x54\x52\xa4\x6b\x47\x47\xb3\x7a\xa0\x30\xce\x3c\x32\x9b\x05\xcf\x05\xc7\xd5\x2c\xe8\x35\x8e\xa1\x5c\xf7\xb5\xef\x68\xeb\x4f\x74\x75\xf1\xdd\xf9\xd1\x79\xed\x4a\xbc\x1b\x7e\x72\x2c\x2f\xbe\xe8\xfc\x7c\x8b\xad\xee\x63\x3f\x13\xc7\x72\x8b\xcd\xb7\x2f\x64\x17\xb5\xb8\x91\x6f\x45\x4d\x68\x60\x3f\x83\xb9\x28\x35\x7a\xc2\xe2\x99\xd2\xd0\x13\x3d\xda\x34\x9b\x4d\xc5\x15\x81\xe1\x28\x2e\xc9\xe5\x7b\x00\x00\x00\xff\xff\x76\x9e\xa6\x51\x29\x0a\x00\x00"
+var _wrappertokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x4d\x6f\xdb\x38\x13\xbe\xeb\x57\xcc\xdb\x93\x8d\xc6\x72\x0e\x2f\xf6\x60\xc4\x68\xba\xd8\x04\xd8\x5b\xb0\x6d\xb6\x67\x5a\x1a\x5b\x44\x64\x52\x20\x29\x3b\x4e\x91\xff\xbe\x18\x92\xfa\x20\x29\xdb\x69\x81\x16\x81\xc5\xf9\x78\xe6\x99\x99\x87\x12\xdf\x37\x52\x19\x78\x6c\xc5\x8e\x6f\x6a\xfc\x2e\x5f\x50\xc0\x56\xc9\x3d\x7c\xca\x97\xc1\xd3\xbc\x28\x8b\x4f\x99\xb7\x7f\x78\x65\xfb\x26\x36\x1f\x3f\x74\xd6\xd9\x72\xb9\x84\x1f\x8a\x35\x0d\x2a\x6b\x4b\x0f\xe8\x3f\x7c\x15\x80\xce\x1c\xe4\x16\x18\x18\x3a\x05\x53\x31\x03\x47\xc5\x1a\x0d\x4c\x48\x53\xa1\x82\xad\xc7\xe0\x2c\x72\xeb\xfc\x2c\x4a\x54\xf5\x89\x8b\x1d\x8c\x73\x6a\x60\x0a\xa1\xc2\xba\x04\x2e\x80\x81\x42\x8d\xea\x80\x20\x8f\x02\x4b\xd8\x9c\xc0\x54\x08\x85\x14\x46\xb1\xc2\xdc\xd8\x48\x4c\x94\x20\x05\x06\x18\x01\x5f\xb9\x36\x1a\xb6\x52\x01\x1e\x50\x9d\xa0\x1d\xf2\x59\x14\x14\x9e\x62\xf9\x04\x16\x54\xd6\xb4\x9b\x3e\x78\x10\x6f\x15\xb1\xfb\x33\xcb\x00\x00\x28\xfd\x77\x69\x58\x0d\xba\x6d\x9a\xfa\x44\x3c\x8c\xdd\x34\x65\xb1\x50\x50\x14\x68\x5d\x28\xc5\x81\x29\x30\xe4\xf6\xcd\x7a\xad\xe0\xf9\x91\xbf\xfe\xf1\xff\x21\xe6\x37\x23\x15\xdb\xa1\x2d\xed\xa9\xdd\xd4\xbc\x80\x27\x66\x2a\xdd\x47\xa8\xd1\xc0\xbf\xac\xad\x8d\xb7\xa4\xd3\x55\xe7\x46\x3f\x02\xcb\x7f\xb0\x40\x7e\x40\xe5\x42\xd1\xf1\xca\x87\x4d\x4c\xff\x64\x35\x13\x05\x9e\xb1\x1c\x8a\xae\x30\x61\x54\xc3\x86\x15\x2f\x5c\xec\x6c\x8f\x68\x02\x1a\x2c\x3d\x31\xd6\x91\x15\x05\x6a\x3d\xeb\x08\x9e\xdb\x84\x9e\xff\x15\xdc\x07\xa3\x67\x8b\x1b\x93\xfc\x82\x42\xff\x2d\xb8\xe1\xac\xe6\x6f\x58\x76\x27\x83\x45\x85\xd4\x69\x61\xdc\x00\x72\x0d\xb8\xe7\xc6\x60\x09\xc7\x0a\x45\x30\x36\xc0\x35\x14\x0a\x99\xf1\x61\xa8\x25\xce\x35\x49\x33\xe3\x2e\x65\xd8\xa8\x79\x0c\xec\x07\x37\x55\xa9\xd8\x51\x74\xcf\x3f\x0c\x6b\x98\xf9\x63\x17\xc3\xad\x2e\x73\xfd\x9d\x04\xd8\xa7\x9b\xb1\xbd\x6c\x85\xe9\x70\xdd\x58\xd7\x15\x7c\x2d\x4b\x85\x5a\x7f\x49\x70\xfe\x85\x8d\xd4\xdc\xfc\x06\x7d\x03\xce\xb2\x8b\x01\x46\x5e\x44\xd9\x27\x4b\x50\x1a\x79\x01\xa3\xdb\x9f\x5f\x46\x98\x4e\x23\x53\xfd\x14\x4e\xb3\xe8\x12\x45\xe8\x12\x3c\xcf\xe2\xf8\x7b\x88\xbc\xdb\x18\x4e\x2b\x2e\x01\xea\x33\x9d\x87\x34\xb0\x3d\x06\xf2\xc0\x8a\x0a\x5a\x8d\x0a\xb4\x91\x0a\x49\x78\x81\x0b\x6d\x68\x93\x49\x92\xa4\xa8\x9d\x70\x5a\x77\xaf\x7c\xdc\x59\xb3\x1d\x06\xf1\x68\x19\x14\x6a\xd9\xaa\xc2\xdb\x47\xda\x97\x3f\x29\x79\xe0\x25\xaa\x9b\xe8\x79\xa7\x32\xf1\x73\x2f\x29\x9d\x66\x76\xa0\x89\x3d\x2b\x82\xb0\xf1\x06\x72\x0b\xa6\xe2\x1a\x0e\x7d\x91\x1d\x20\x12\x4c\x6f\xd5\x91\x32\x0e\x06\x7e\x4b\xf9\x1b\xda\x32\xbb\x80\xcc\x0c\xa5\xd8\x85\xe7\x52\x80\xe1\x7b\xec\x7d\xc9\x71\x16\x45\x9e\xc3\xcf\xfe\x9c\xfe\x69\xac\xb7\x79\x17\x72\xdd\xe1\xe8\x4d\xde\x07\x24\x04\x75\xdb\x8a\x7e\x95\xe3\x3e\xae\xe0\x3e\xa4\xc6\xf2\x7b\x39\x5d\xf0\x73\x01\x2e\x62\xe0\x40\x43\x77\x56\x18\x9c\x7d\x27\x0c\x36\x18\x5d\xa5\xea\x4b\xce\x9c\x48\xcc\x83\x58\x0a\x4d\xab\x04\xdc\x2d\x2c\x5d\x7e\x00\x06\x82\x5c\xb4\xf9\xa5\xda\xbd\x3c\xcc\x5c\xc2\xa9\x7a\x63\x7e\xe9\x16\xb0\x2d\x87\xbb\x85\xd7\x3e\xfd\x3f\xb8\x1f\xdf\xa3\xf9\x30\xf7\x1f\xa1\xe9\xb3\x1b\xa1\xee\xf7\x39\xb6\x52\x81\x0a\xdc\x6e\xc0\xc8\x0f\x50\x16\xf8\xc0\x1a\x6e\xf3\xdb\xe0\xbc\x44\x6d\x94\x3c\x45\x53\x3d\x62\xce\x1b\xcc\x62\x5e\x02\x02\x46\xaf\x0b\xb0\x3e\x7f\xb4\x08\x88\xe8\xc3\xbd\x67\xa3\x9c\xb4\x7c\xae\xbd\x0f\xfb\xc6\x9c\xa6\x25\xe5\xb1\x15\x85\x5b\x17\xba\xb6\x9c\xb9\x06\x06\x02\x8f\x5e\x45\x68\xc8\x81\x75\xeb\x40\x32\xf3\x86\x4a\xf6\x01\xe8\xd5\xc5\x4d\x93\x06\x6e\xc0\x48\xbb\x99\x05\xab\x6b\x2e\x76\xf6\x2a\xc6\x57\x93\x27\xd2\x43\xeb\x13\x83\x9b\xcd\x57\x70\x1f\xaf\xca\x95\x49\xbd\xcd\x6f\xe7\x71\xd1\xa4\xae\x57\x0a\xdd\xcb\x03\xea\x89\x37\x1b\x2e\x8c\x1c\xbf\x2e\x4e\x96\x49\x05\x6a\xb6\x47\xbf\x26\xc9\xcb\xe0\x74\xb5\x84\xaa\xdb\x96\xf4\x15\x88\x4a\x0f\xba\x1d\xf3\x40\xdb\xe3\xf3\xad\xed\xf6\x24\xcd\xb7\x13\xe1\x71\xe7\xe1\x7a\xde\x2d\xe8\xef\x30\xcf\xd6\x34\x1c\xb5\xe4\xd1\x67\x9f\x2e\x9b\xd8\xa8\xf8\x4a\x8d\xf5\xe2\x17\xe4\x65\xd4\x37\x77\x6b\x5e\xe9\x9c\xdf\x22\x1d\x52\x3e\xee\x4f\xef\x38\xd1\xa7\xb4\xe3\x44\x4c\xf8\x81\x30\xd5\xbc\x56\x8c\xdb\x97\x36\xea\xba\xe4\x5f\xef\x9f\xaf\xcc\x1e\x4e\x91\x9e\xbe\x36\x9c\xa5\x3d\x18\x85\xe4\x96\x9a\xa0\xdf\xde\x8f\x63\x61\x9a\x98\x90\xb1\xe0\xd9\xe3\xf8\xc3\x04\xd6\xb0\xf4\xaf\x1a\xcb\xe3\x88\xa2\x50\xd4\xad\x6b\xfa\xa5\x42\xce\x8d\xfd\x1a\x09\x7c\x3b\xc3\xd0\x3d\xf9\x7a\x39\xe3\xed\xed\xb2\xc9\x1d\xa1\x6b\x28\x58\xc4\x54\x8f\xb2\xa9\x3e\x5c\xf8\x6c\x88\x59\x9b\x67\x00\x00\xef\xd9\x7b\xf6\xdf\x00\x0a\x1e\x1e\x32\xbc\x0f\x00\x00"
+
+func wrappertokenCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_wrappertokenCdc,
+		"WrapperToken.cdc",
+	)
+}
+
+func wrappertokenCdc() (*asset, error) {
+	bytes, err := wrappertokenCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "WrapperToken.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd9, 0x2f, 0x57, 0xb0, 0x55, 0x87, 0xe4, 0x70, 0x6e, 0x2a, 0x7, 0x79, 0x86, 0x44, 0xbd, 0x69, 0xe2, 0x26, 0xcf, 0x6e, 0x41, 0x98, 0x32, 0xc, 0xb4, 0x95, 0x91, 0xb3, 0xc, 0x93, 0xea, 0x62}}
+	return a, nil
+}
+
+var _legacyExampletokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\xdf\x6f\xdc\xb8\x11\x7e\xdf\xbf\x62\x9a\x87\x76\x8d\xb3\xd7\x2e\x50\xf4\x61\x61\xdf\x25\xd7\x26\x40\x1e\xee\x10\xdc\xa5\xed\x6b\x28\x69\x76\xc5\x46\x22\x05\x92\xda\xf5\x26\xf0\xff\x7e\x18\xfe\x90\x48\x4a\x5a\x6f\x62\x1c\x9c\x87\xac\xc4\xf9\x38\x1c\x7e\xf3\xcd\x90\xe2\x6d\x27\x95\x81\x77\xbd\xd8\xf3\xa2\xc1\x8f\xf2\x33\x0a\xd8\x29\xd9\xc2\xab\xcd\x6d\xf2\x74\x53\x56\xe5\xab\xd5\xaa\xeb\x0b\x28\xa5\x30\x8a\x95\x06\xde\x3e\xb2\xb6\xf3\xef\xb7\x19\xc8\xd7\xd5\x0a\x00\xe0\xf6\xf6\x16\x3e\x4a\xc3\x1a\xd0\x7d\xd7\x35\x27\x90\xbb\xc4\x4c\x03\x17\x80\x8f\x5c\x1b\x14\x25\x5a\x13\x9a\xe2\xc0\x14\x18\x32\xfb\xdd\x5a\x6d\xe1\x3f\xef\xf8\xe3\x3f\xff\x31\x62\xfe\x6e\xa4\x62\x7b\x04\x26\x2a\xf8\xd0\x17\x0d\x2f\xe1\x03\x33\xb5\x1e\x10\x1a\x34\xf0\x5f\xd6\x37\xc6\x8f\xa4\xb7\xdb\x60\x46\x3f\x92\x91\xbf\x61\x89\xfc\x80\xca\x41\xd1\xeb\xad\x87\x9d\x0c\xfd\x99\x35\x4c\x94\x78\xc1\xc8\x37\x55\xcb\xc5\xe2\xf4\x51\x78\x28\x0e\xef\x05\x37\x9c\x35\xfc\x0b\x56\xe1\xcd\x38\xa2\x46\xc0\x03\x0a\x03\xa6\x66\x06\xb8\x06\x6c\xb9\x31\x58\xc1\xb1\x46\x01\xa6\xc6\x71\x4f\xb8\x86\x52\x21\x33\x1e\x86\x82\xe9\x4c\x27\xd3\xac\xb9\x9b\x32\x0d\xf1\x55\xbc\x6f\x64\xf1\x3f\x6e\xea\x4a\xb1\xa3\x08\xcf\x2f\x76\xcb\x4e\x08\x4c\x21\x1c\x03\x86\xe3\x16\x73\x3b\x33\xeb\xe0\x30\xdd\x9a\xb5\xb2\x17\x26\xf8\x75\x6d\x4d\xb7\xf0\xa6\xaa\x14\x6a\xfd\xd3\xc4\xcf\x7f\x63\x27\x35\x37\xdf\x11\xbe\xd1\xcf\x2a\x60\x80\x91\x67\xbd\x1c\x26\x9b\x78\x69\xe4\x19\x1f\x7f\xe1\xe2\x3b\x1c\x14\x78\x8c\x9d\x6c\x47\x90\xdc\x2d\x87\x9f\xf9\x34\xf1\xe2\xe7\x5e\x89\x17\x86\x49\x1b\x25\x4f\x0b\x4e\x38\xf8\x65\x27\xac\x93\xea\x5f\x11\x49\xbf\xc1\x0b\x66\xa3\x61\x43\xa0\x40\xa1\x96\xbd\x2a\x71\x99\xf4\xc9\x5c\x6b\xd6\x34\xf2\x88\xd5\x9b\x25\xcf\xac\xe7\x2f\xf3\xac\xb0\x10\x17\x78\x96\xcc\xb5\x8e\x9c\x18\x49\x17\x4f\xfe\x96\x95\x35\xf4\x1a\x15\x68\x23\x15\x6a\x60\x02\xb8\xd0\x86\xa4\x88\x34\x55\x8a\xe6\x64\x85\xc0\x9a\x93\xa8\x9a\x1a\xb9\x1b\xcd\xf6\x38\xe0\x50\x78\x77\xbd\x28\x0d\x97\x42\xfb\x61\x8e\xe7\x56\x4a\xf7\xf2\x80\xb4\x7b\x50\x38\xb4\x4e\x39\x89\xed\xa4\x36\xa4\x31\x15\xb7\x86\x03\x1c\x17\x99\xec\x07\x41\x3a\x59\xa2\x94\xac\x69\xb0\xda\x24\xb3\x97\x35\x96\x9f\x35\xd4\xac\xeb\x68\x3f\x0d\xa8\x5e\x18\xde\xa2\x8d\x22\x1e\x50\x01\x1b\x3c\xb4\x1b\x9b\x62\x0c\x58\xbf\xf9\x10\xd3\x08\xe1\xd6\x5f\x60\x08\x76\x58\x19\xc9\x22\x3e\x1a\x8a\x50\xa2\x92\x96\x5b\xa6\xc6\xd3\x00\x47\xee\x56\xb8\xe3\xb4\x78\x2e\xae\x41\x4b\x5a\x86\xb2\x3b\x28\x24\x1c\xd9\x09\x76\x92\x7c\x6b\x59\xc3\x4b\x2e\x7b\xed\xb6\xc3\x48\x3f\xa7\x8b\xa2\x1e\x00\x65\xef\xa7\xe5\x02\x18\x57\x1b\x78\x03\xba\xc3\x92\xb3\x06\x7e\xc9\xe8\x2b\x10\x2b\x4d\x92\x53\x8c\x3e\x18\x69\x59\x3e\xc0\x8d\x22\x90\x86\x82\xb8\x3e\x00\x59\x17\xb2\x4a\xbc\xf9\xa0\xe4\x81\x57\xa8\xae\xb3\xe7\xa1\xe6\xe5\xcf\x7d\x81\x0b\x15\x3c\x38\x40\xcc\xb1\x25\x19\x0a\x3f\xc0\xad\x4e\xc3\x61\x60\x6c\x70\x88\xca\xb7\x1f\x15\xd2\x2c\x06\x03\x5f\x79\xf8\x17\xa4\x20\x0f\x80\xcc\x8c\x4b\xb1\x41\x25\x0a\x10\x37\x06\x5b\x32\x5c\x67\xc8\x57\xf0\x75\x78\x4f\xff\x34\x36\xbb\x4d\x80\x7c\x08\xe0\xc3\x90\xa7\x74\x59\xa1\x34\xc5\x0f\x93\x01\xef\x02\x17\x1d\x67\xd8\x67\x97\x7c\x4e\xde\x80\xb9\x1f\x6a\xdf\xb7\x28\x4c\x62\x48\x79\x13\xd0\xb5\xd3\x0c\x6f\x44\x95\x6c\x4c\xbc\x4d\x6c\x95\x20\xbc\x37\x9e\x5b\xda\xab\x8b\x41\xea\xd7\x98\x3a\xf9\x94\x0d\x42\xd4\x6b\x57\xb2\x6a\xd9\x54\x09\x02\x4d\xd2\x4a\x81\xa7\x41\xb3\x0a\xe4\x62\x0f\x46\x31\xa1\x77\xa8\x14\x56\x1b\x78\x4f\x61\x37\xbd\x12\xe4\x25\xd2\x44\xcd\x29\x41\x09\x49\xe5\x27\x95\x49\x6a\x59\x60\x97\xe8\x94\x34\xdc\xd8\x7c\x2c\xa2\x62\x9a\x60\x61\xa3\xf1\x48\x89\x35\xbf\x6c\x62\xcf\xae\x17\x43\xe0\xf2\x32\xb2\x85\xd7\x29\x5b\x9d\x4f\x67\x19\x90\xfc\xbc\xf1\x9b\x90\x18\x50\x89\x59\xec\x3f\xdc\xf8\xd0\x7f\x58\x30\x79\x14\xa8\x7e\xda\x30\xd7\x8b\x5c\x25\x58\x2e\x94\x70\x7f\x13\xcb\xc2\xc8\x59\x87\x76\xb5\x44\x47\x1f\xb4\xf8\xd9\xf3\x6c\xf4\x1b\x23\x8b\xff\x63\x99\x53\xd2\xca\x37\xab\x2a\x9d\xc0\x70\xa3\x87\xac\x33\x32\x49\x42\x2f\x95\x76\x89\xfa\x02\x86\x72\x0d\xbe\xae\x12\x03\x7d\x6b\x60\x21\x34\x55\x60\x0b\x00\x05\x96\xac\xd7\x38\x92\x3e\x41\x39\x92\xcb\x11\xb9\x89\xc6\xa8\x82\x27\x5e\xf5\xe0\x63\xb0\xfd\xdb\xe8\x7b\xcd\xd2\x75\x15\x88\x82\xea\x94\xee\x5b\xac\xec\xd2\x0d\x71\x6d\x27\x15\x8e\xb4\xf4\xcd\xcb\x79\x02\xfa\x8d\x58\xbb\x5d\x9f\x23\x5d\xae\x3b\x74\xe2\xb0\x52\x08\xf7\x37\xbe\xcf\xd5\x7f\x81\xd7\xf1\x69\x67\x93\xae\xfd\x39\xae\xfe\xe0\xa4\x35\xfc\x5e\xa2\xec\xb4\x19\x4d\xcc\xae\xc1\xc8\x0b\x78\x9b\xd8\xc0\x03\xdc\x6d\xee\x92\xf7\x61\x67\x53\xb5\x8f\xe8\xeb\x07\xac\xf3\xb8\x24\x01\x88\x0e\x75\xf0\xb0\xfc\xea\x26\x09\xc4\x00\xf7\xb4\x8a\xe6\x1c\x95\xe9\x6d\xdb\x99\xd3\x18\xda\xb0\x9d\xd3\x7c\x49\xd5\xd4\x5a\x58\xa5\x01\x16\xd3\xff\x0b\x2a\x39\x00\x10\x87\x82\x3a\xf2\x51\xfc\x58\xd3\x70\xb1\x0f\x22\x48\xc5\xdd\x76\x03\x6d\x4f\x7d\x12\x6b\x1a\x57\x19\x43\x1f\x93\xa0\x51\x4b\xe6\x32\xc1\xe1\x62\xb5\xd0\xb4\xd1\x03\xa9\x2a\xd7\x64\xd8\x1c\x23\x2b\xae\x46\xb4\xb2\xa4\xfd\xf6\x9d\x03\x2b\x1a\x4a\x16\x50\xae\xae\x07\x06\x6b\xdf\x85\x50\x83\x41\xa7\x7b\x73\xea\x70\x93\xc4\x29\x50\x3e\x0f\xe6\xfa\x6a\x0b\xaf\x73\x7d\x7d\x46\xde\xee\x36\x77\x57\xf1\x26\x25\xed\x89\x3d\x10\x73\x6d\x14\x33\x52\xe5\xfd\x85\x9b\xfd\x57\x3c\xba\xee\x28\x7e\x77\x46\x01\x87\x1d\x8d\xb6\x69\xf6\x8c\x70\x36\xd9\xb3\xb9\x17\x0e\x0a\x5b\x78\xed\x3b\xb7\x94\xe0\xb6\x7a\x9c\x3d\x69\x24\x3f\xcf\x97\x8b\x79\x0f\x16\x00\x9e\x56\xf1\xb2\xc6\x10\xba\xc3\x45\xfc\xee\xbb\x42\x98\x1d\x66\x2e\x0b\xa1\x9b\xdb\x72\xc7\xfd\x37\x93\x03\x1b\xad\xfc\xf4\x73\x2e\x22\x01\x70\x59\x05\x22\xc6\xcc\x9d\x10\x42\x61\xb4\xab\x75\x49\xc0\x88\x89\x21\x7f\xdc\x09\x82\x8a\x4e\xe8\xba\x2f\xeb\xb6\x07\x32\x4c\xfa\x64\xdf\xe7\x51\xe2\x59\x10\x5f\xad\x6b\x7f\x78\x57\x59\xd1\x1c\x1a\xfd\x30\x05\xf5\xcf\xb3\x1c\x4c\xf7\x9b\xec\xac\x76\x26\x45\xf0\xcc\x56\x93\x81\x8e\x16\x77\x6d\x3b\x03\x52\x95\x36\x28\x9b\x89\x2e\xeb\xae\x13\xa8\x98\x1f\xb1\x45\xae\x85\xb1\xd1\x84\x26\xa3\xcb\x6b\x36\xc9\xae\xa4\x1c\xcc\x35\x76\x74\x1c\x4d\x9f\xd0\x9f\x8f\xf6\x8f\x54\xb2\xb6\xf0\xca\xe5\x8c\xbf\x26\x71\x8a\x5c\x20\xec\x2d\x99\x14\xed\x84\xb0\x0a\xff\x6a\x09\xe7\xde\x57\xe1\x6c\x03\x16\x70\x1b\xd4\x14\x0e\x46\x11\xc6\x61\x53\x9d\x4b\xe9\x14\x4f\xc9\xaf\xef\xa9\x8a\x3f\x78\x17\x13\xa0\xa9\xaf\xf0\x30\xf7\xf0\xb9\xc6\x37\xbb\x3b\x62\x17\x68\xd5\xe5\xad\xad\x3d\xb7\xcd\x32\x3a\xef\x16\x66\x97\x93\xfc\x5e\xd6\x81\x48\xf6\x5e\xae\x03\x24\x7e\xcf\x6b\xc0\x20\x71\x83\x57\x34\x23\xd9\x7e\x53\x62\xfa\xde\x69\xec\xe5\xc3\xdd\xce\x35\xe0\x6e\x87\xa5\xe1\x07\xa4\x0b\x8e\x5e\x09\x2e\xf6\x71\x5b\xbc\x38\xc1\xaf\xd2\xe0\xd6\x8e\x24\x6f\xb0\x8a\x2f\xf0\x58\x6f\x64\xcb\x0c\xa7\xd4\x3d\x81\xee\x0b\x7b\x77\x8c\xd5\x70\x32\x4d\x90\x62\x49\x08\x17\x2b\xce\x4b\xeb\x76\x5f\x1a\xa9\xce\x67\xfd\x18\x8f\x3f\xbd\x9f\xa6\x2e\xdc\x27\xf2\x43\xda\x07\x27\xc3\xe6\xbb\xd9\x2c\x25\xb2\x9b\xcc\x29\xbf\x23\xfe\x59\x86\xc7\x4b\xb0\x44\x4e\x13\xfb\xef\x77\x77\xd4\x55\xa7\x43\xf2\x8f\x14\xf0\x00\xb7\xbe\x01\xbc\xc5\x68\xad\xe9\x52\x2d\xfa\xf4\xab\x05\x19\x77\xf6\xcb\x44\x62\x1b\x06\xa6\xe6\x93\x2f\x19\x0b\xd6\x7e\x5c\x6a\x9c\x7f\xdc\x58\x72\xdb\x8e\x1b\x97\x7c\x7b\x0b\xae\xea\x47\x2c\xb2\x1d\x78\x5e\x7b\xa2\xe2\x49\x85\x47\xb3\x03\x02\xa7\xbc\x08\xdd\x71\x04\xb9\x9a\xa5\xcc\xbc\x48\xe5\xdb\x32\x6e\xa7\x7d\xe3\xa5\x60\x43\xf3\xad\xef\x6f\x2c\x3d\xa2\x03\x54\xbe\x59\x57\x73\x2b\x63\x24\x11\xf4\x45\xaa\x64\x1d\x2b\x78\xc3\xcd\x29\xd4\x4a\xf2\x7d\xbc\x48\xa1\xa6\xc0\x5e\x5c\xe2\x63\x27\x35\xc6\x62\x61\xc3\xf3\xc9\xb7\xf0\x9f\xa0\x45\x53\xcb\x0a\x4c\xad\x64\xbf\xaf\xdd\xcb\xb0\xa9\x9f\x80\x94\x5b\xed\x58\x39\x1b\x93\x64\x59\x0d\x17\x9f\xef\xff\xfa\x35\xcd\xbe\x00\xf4\xf4\xe3\x7a\xb0\x1a\x2c\xa7\x1c\x1b\xbb\x02\xfa\x33\x4c\xed\xd1\x2c\x84\x67\x18\xf9\x27\xc7\xc9\xef\xee\x27\xd8\x71\x6c\xb2\x30\x79\xf6\x7e\x7b\x94\xa6\x42\x93\xc5\xcd\x23\xcf\x86\x6d\x92\x5b\x2f\x8c\x9a\x95\x35\x4a\xa5\x88\xd9\xc9\x71\x6a\x7d\x35\xbf\x16\x4f\x64\xdb\xf0\x46\x44\xce\xd3\x37\xdd\xa0\xb7\xd4\x16\x30\x11\x7f\xee\xd0\xb5\x0c\xb7\x98\xc9\x4d\xfa\x91\xe9\xe8\x3a\x37\xbe\xeb\x5b\xcd\x28\xea\x99\xcf\x8f\xf3\x89\xf9\xb4\x7a\x5a\xfd\x31\x00\xc4\x0a\xc4\xd0\xa5\x1e\x00\x00"
+
+func legacyExampletokenCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_legacyExampletokenCdc,
+		"legacy/ExampleToken.cdc",
+	)
+}
+
+func legacyExampletokenCdc() (*asset, error) {
+	bytes, err := legacyExampletokenCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "legacy/ExampleToken.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x42, 0x18, 0x5e, 0xb6, 0x6f, 0x51, 0x80, 0xef, 0x9f, 0x39, 0xa7, 0x95, 0xbc, 0x9a, 0xfc, 0xcc, 0x39, 0xfa, 0xac, 0xb3, 0xaa, 0xce, 0xe2, 0x5f, 0x92, 0x2a, 0xa1, 0xc2, 0x14, 0x1c, 0x21, 0x58}}
+	return a, nil
+}
+
+var _utilitycontractsNonfungibletokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x4f\x8f\xdb\xb6\x13\xbd\xeb\x53\x4c\x12\xe0\xf7\xb3\x83\x8d\xdd\x43\xd1\x83\x81\xa0\x29\xe2\x18\xd0\xa1\x46\xb0\x75\xdb\x43\x10\x60\x69\x71\x6c\x11\xa1\x48\x2f\x39\xb2\xea\x2e\xfc\xdd\x8b\xa1\x44\x4a\xfe\xb3\x59\xf7\x54\xac\xb1\xb0\xc5\xe1\x9b\x37\x6f\xde\x90\x9a\xbe\x7d\x9b\x65\x6f\xde\xc0\xaa\x44\x58\x68\xdb\xc0\xd2\x9a\x77\x8b\xda\x6c\xd5\x5a\x23\xac\xec\x37\x34\xe0\x49\x18\x29\x9c\xcc\xb2\x55\xa9\x3c\x28\x0f\x54\x22\x07\xc6\xb8\x36\xac\xb0\x86\x9c\x28\x08\x94\x21\x74\x1b\x51\x20\x6c\x9c\xad\x38\x38\x7b\x09\x1a\x1c\xee\xac\x57\x64\xdd\x01\x46\xd6\x6c\xb4\x6d\xa6\xfc\xef\x9d\xd9\xd0\x78\x92\xe5\xc4\x59\x95\x29\x74\x2d\x51\x42\x89\x0e\x61\x8d\x85\xa8\x3d\xc2\xaf\x48\x42\x0a\x12\x7f\x28\x6c\x3c\xa8\x6a\x67\x1d\x79\x50\x34\xc9\xb2\xb7\xd3\x2c\xdb\xd5\xeb\x6b\xcc\x2e\xd8\x3f\x65\x19\x00\xc0\x74\x1a\xa4\x20\x4b\x42\x83\xa9\xab\x35\x3a\xb0\x1b\x20\x8e\xf1\xe1\x1b\x4b\x40\x87\x1d\x82\x32\x80\x7f\x29\x4f\x68\x0a\x0c\x7b\x39\xd5\x5e\xb8\x76\xf3\x6f\xf5\x6e\xa7\x0f\x33\xf8\x3d\x37\xf4\xd3\x8f\x09\xfc\xd3\x1e\x0d\x01\x95\x82\x00\x2b\x45\x84\x12\x9a\x12\x0d\x8b\x04\xcb\xc5\x6a\x40\x95\xeb\x55\xa4\x84\x56\x7f\xa3\xec\xb6\xa7\x34\x18\x60\x3e\x76\xc1\x79\x1f\x38\x1a\x5f\x4b\xa5\xfc\x69\x36\xd1\x16\xc4\xa2\x36\x8a\x4a\xe9\x44\x63\xee\xe2\x3e\x65\xa4\x2a\x04\x29\xb3\x0d\xac\x6c\x63\x3a\x0d\x4a\x84\xc2\x6a\x8d\x05\x29\x6b\x3a\x60\x82\x46\x0c\x40\x42\xc7\x27\x1d\x52\x04\xcc\x2f\xf6\x2a\x0f\xc6\x72\x3b\x40\x18\x10\x45\x61\x6b\x43\xff\xf7\xe0\xc9\x3a\xb1\xc5\x3b\x78\x60\x98\x07\x68\x94\xd6\xb0\x46\x78\x30\x4a\x3f\x4c\xae\x6b\xf0\x67\x97\x7a\xa4\x64\x14\xfb\x2e\xb0\x98\xc1\x2f\x52\x3a\xf4\xfe\xe7\xf1\x8b\xea\x0f\xf4\x90\xad\x11\x51\x02\x59\x10\x03\xd2\x17\x55\x51\x54\x0a\xfd\xcd\x42\x0d\xd1\x9f\x29\x68\xde\x86\x9c\xd4\x43\xf6\x5a\x35\x79\x72\x73\x48\xd1\x59\xc8\x43\x29\xf6\xc8\xec\x0b\x6b\x36\xd6\x55\x40\xf6\x3c\x93\x43\x6f\x6b\x57\xe0\x60\x20\xf2\xe5\x62\x05\x4f\x21\xb0\x83\xe7\x39\xa8\x8d\x7a\xac\x11\xf2\x79\x27\x9a\x28\xca\x60\xd3\x52\xf8\x14\xca\x80\x1a\x09\x7a\xc2\x61\xe9\x98\x78\xde\xe3\x63\xad\x1c\x56\x49\x7b\xa1\x75\x24\xa7\xcc\x36\x00\xfa\x4a\x38\x4a\xee\x6f\x6b\x88\xfb\xc9\x82\xc4\x8d\x32\x08\xa2\x67\x5e\x08\xad\x51\x86\xbd\x01\xb3\xc3\xf3\x5c\x38\xd7\x72\x59\xeb\x72\xb1\x9a\x9d\x97\xf9\x22\xf7\x81\xc6\x16\x2a\x94\x4a\x10\x26\xbb\xfb\x74\xc0\xc1\xc7\xd4\xef\x1b\xb4\xfe\xec\xec\x5e\x49\x74\xa7\x7a\x47\x54\x70\x58\xd9\x3d\x7a\x9e\x0d\x66\x9b\x72\x0c\x3c\x25\x8c\x84\x36\x48\x11\x57\xcc\x14\x82\x22\xee\xa4\xb4\x4d\x6d\x12\xec\x28\x7e\xc9\xe7\xb1\xd6\xf1\x0c\x3e\x9c\xea\xc1\x7f\x3b\xeb\xe9\xec\x11\x7f\x1c\xfa\x5a\xd3\x44\x49\x78\xff\x3e\x81\x32\xd6\x6b\x36\x4a\x3e\x8f\xce\x8f\x4b\xa6\x9b\xa9\xaa\xf6\xc4\x43\xcc\x6b\x5e\x54\x08\xa2\x1d\x17\x87\x8f\x35\x7a\x1e\x85\x7c\xfe\xfa\x24\xdb\x31\xfd\x3a\xde\xd2\x8d\x6e\xa6\x7c\xd4\xe1\x5f\xb5\xe2\x1e\x0b\x54\xfb\xd0\x8a\x94\x75\x3a\x8d\x73\x0a\x24\xbe\xf5\x8d\x10\xe1\x9b\x70\xdb\x3a\x58\x99\x7b\x20\xa4\x1c\xb6\xe0\x2c\xf5\x20\xfd\xb0\x23\x1d\xf8\x28\xe8\xd3\xb6\x60\xfc\x7c\xa1\x6c\xee\xfe\x94\x84\xc6\xd6\x5a\x42\x61\xab\xca\x1a\x7d\x88\xf1\xbb\x7a\xad\x95\x2f\x61\x63\x1d\x6b\xa0\xdc\xe0\x00\xfa\x5e\xf9\x3d\xe1\xcf\x8c\x50\xc0\xd3\xed\x6c\x87\x41\x5b\xa4\x7c\xee\x47\xe3\x19\x7c\x69\xad\xf5\xf5\x22\x64\x6d\x9d\xb3\xcd\x72\xb1\x1a\x9c\x6c\xe3\x19\xfc\x2f\xce\xea\xf5\xf3\xa2\x2b\xa8\xf3\xbf\x29\x1c\x12\xf6\x85\xf0\x3d\x1c\x77\x91\x65\x97\x49\x2c\xb4\x70\x28\xf9\x6e\xe1\x3d\xaa\xda\xe9\x80\xc4\x07\x4d\x3c\x5e\x9e\xf5\x45\x2f\xc7\x2c\x0d\xe9\x5d\xf2\xc8\xdd\x35\xb9\x52\x9d\xd3\x29\xcc\x55\x58\x13\xee\xc0\x86\x28\xad\x96\xf1\x5e\xf7\x91\x4f\x8f\x70\x22\x10\xbf\x36\xf0\xfd\x21\x97\x8b\x95\x9f\xc1\x87\xa7\x56\xc5\x19\xef\x3d\x9e\xe4\xf8\xcf\x4e\x89\x13\x16\x67\xf3\xc1\x34\xaf\xcd\x43\xcf\xc5\x83\x4c\xe2\x0c\x81\xd2\x26\xe6\xc6\xd7\x4c\xcb\x52\x49\x10\xce\x89\xc3\x05\xcf\xab\x6e\x1c\x02\xb6\x4e\x04\x87\x54\x3b\xd3\x0d\xac\x13\x87\x78\x3a\xe5\x73\xdf\x8d\x94\xc3\xd8\x93\x9e\xe5\x2d\xbe\x1e\x26\xbb\x8f\x59\x3a\x77\xa3\x04\x87\x1b\x74\xfc\x4a\xc8\xa5\x74\xbd\x79\x3e\xcf\x74\x0a\xde\xf6\xd7\x77\xdb\x1c\x28\x84\x01\x87\x42\x02\xbf\xd7\x06\x5d\x79\x01\x2a\xa4\xd2\xca\xee\xd2\x51\x74\x41\xf6\x3b\x13\x76\x76\x9e\xef\x1c\x9e\x3d\xe1\x8f\x47\xbd\x99\x24\x17\x7e\x51\xf2\x2b\xbc\x7a\x0f\x46\xe9\x19\xbc\x66\x0c\x69\xb1\x7d\x6f\x0b\xaf\xbd\x97\xea\xbd\xba\xf5\x18\x2f\x1c\x0a\xc2\x4f\xd5\x8e\x0e\xfd\x3c\x74\x4f\x43\xcb\x90\x97\x06\xd3\x16\x37\xb2\x14\xb1\xb3\xe7\x96\x1e\x0a\x79\x08\x12\xda\x26\xc8\xef\xd3\x9c\xb3\x81\xae\xe6\xe6\x83\xeb\x43\xff\x73\xa0\xcd\x95\xcb\xb0\xbb\x08\xa3\x35\x26\x1a\xcd\x96\x4a\xbe\x15\x7f\xe8\x2e\xc3\x36\x87\x1c\x48\x93\x6e\xc1\x50\xd9\x40\xa8\x63\x06\x00\x70\xcc\x8e\xd9\x3f\x03\x00\x22\xa5\x4a\x71\x8a\x0d\x00\x00"
+
+func utilitycontractsNonfungibletokenCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_utilitycontractsNonfungibletokenCdc,
+		"utilityContracts/NonFungibleToken.cdc",
+	)
+}
+
+func utilitycontractsNonfungibletokenCdc() (*asset, error) {
+	bytes, err := utilitycontractsNonfungibletokenCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "utilityContracts/NonFungibleToken.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb7, 0xe1, 0x1e, 0xaf, 0x1, 0x4d, 0xf1, 0x9d, 0x34, 0xbc, 0xc8, 0xa4, 0x77, 0x11, 0x64, 0xb8, 0x73, 0x79, 0x67, 0x30, 0xed, 0xdf, 0x2, 0xdb, 0xcd, 0x77, 0x3f, 0xcc, 0x80, 0x33, 0x64, 0x51}}
+	return a, nil
+}
+
+var _utilitycontractsPrivatereceiverforwarderCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x4f\x8f\xdb\xb6\x13\xbd\xf3\x53\xbc\xf8\x07\xfc\x2a\x07\x1b\xe9\x52\xf4\x60\xac\xb3\x0d\xb6\xdd\x63\xb1\x48\xd2\x5e\x0b\x9a\x1a\xdb\x6c\x64\x52\x20\x47\x76\x17\x0b\x7f\xf7\x82\x94\x44\xfd\x5b\x07\xc8\xc2\xc0\x92\xd4\x70\x66\xde\x9b\x37\xc3\xe2\xbd\x10\xff\xc3\x53\x63\x0e\x7a\x57\x11\xbe\xda\x6f\x64\xf0\xec\xf4\x59\x32\xe1\x33\x29\xd2\x67\x72\x78\xb4\x86\x9d\x54\x2c\xc4\xd7\xa3\xf6\x50\xdd\x16\xfa\x54\x57\x74\x22\xc3\x1e\x12\xbe\x26\xa5\x65\x05\x47\xde\x36\x4e\x11\xa4\x29\xe1\x7a\x17\xda\x30\xb9\xbd\x54\x04\x71\x39\x5a\x4f\x28\xa9\xb6\x5e\x33\xf6\x8d\x51\xac\xad\x81\xf6\xb0\xa6\x7a\x81\x92\x55\x25\x43\x32\xbb\x17\x48\x03\x59\x9e\xb4\x01\x1f\x9d\x6d\x0e\x47\x48\xd4\xcd\xae\xd2\x0a\x4a\xd6\x72\xa7\x2b\xcd\x2f\xb9\x10\xef\x0b\x21\xf4\xa9\xb6\x8e\x13\x94\x16\xc9\xde\xd9\x13\x56\x79\x91\xe7\xc5\xe4\x43\xae\x4a\xb5\x12\xa2\x6e\x76\x03\x98\x0e\x75\x0f\xfa\xc9\xba\x8b\x74\x25\x39\xbc\x0a\x01\x00\x45\x81\xdf\xcf\x64\x18\x7c\x94\x1c\xb2\xa5\x93\x66\xa6\x12\x97\x23\x19\x70\x88\xe7\x21\x5d\x42\x46\x25\xd8\x82\x8f\x04\x96\xee\x40\x9c\xb8\x88\xde\x42\x68\x8a\xee\xba\xb8\xbf\xb5\x7c\x64\xf2\x64\x1b\xc3\x1b\xfc\xf9\xa4\xff\xfd\xe5\xe7\x3b\xb0\xdd\xe0\x53\x59\x3a\xf2\xfe\x61\x2d\xd2\xdd\x8a\x18\x5f\xc8\x94\xe4\xbe\xb0\x75\xf2\x40\xcf\x92\x8f\x1b\x8c\x36\x53\xdb\x19\xba\x9b\x97\xbe\x73\xe7\x39\x32\x1f\xac\x36\x18\xd6\x43\x98\x54\xf8\x05\x75\x1d\x7d\x51\x3c\xda\x07\xc2\x1c\x45\x66\xc6\x54\x45\xfe\x2e\xba\xaa\xb0\x23\x78\x32\x9c\x4f\xef\x12\xf8\xa5\x26\x68\x53\x6a\x25\x99\x7c\x57\x87\x58\x0a\x09\x47\x7b\x72\x64\x14\x05\xd2\xe5\x94\xeb\xf0\x2b\x8a\xb4\x94\x4a\x91\xf7\x99\xa7\x6a\xbf\xc6\x59\xba\x60\xac\x6b\x4d\x81\xf5\xc7\x24\xab\xfb\xff\xbf\x4e\x25\xd3\xd3\x70\xfd\x38\x01\xd5\x41\x18\x1d\x8d\xbf\x3e\xf5\xea\x8e\xc9\xb2\xfc\x46\xa1\x55\xfe\x92\x4d\xc5\xb0\xbb\x7f\x48\x31\xa4\x8f\x32\x77\x87\x26\x74\x52\xec\x9a\x7d\x4b\xa0\x1f\x7b\xd2\xdc\xcb\x29\xa5\xfb\x93\xef\x3c\x35\x5e\x9b\x43\x24\xd4\xb3\x75\x54\x0e\x6c\x8c\x3c\xcc\xf1\xf7\xc2\x5f\x87\x16\xec\x45\x9b\x85\x8e\xd9\xe0\xd7\x29\xf4\x18\x65\x8d\xd7\xe4\x22\xfc\xaa\x91\xa4\x3f\xd3\x1e\x5b\x04\x46\xf3\x94\x5d\xbe\xb3\xce\xd9\x4b\xb6\x7e\x27\x16\xf7\x76\xb2\x92\xa1\x56\x5b\x84\x78\x79\xb7\x9d\xda\x8d\x7c\xe7\xd3\xec\xee\x3f\x84\xff\xeb\xa9\x39\x9d\xf4\xcd\x5e\xea\xfc\xb7\xcd\x14\xb3\xb4\x17\x43\xee\x21\x97\x6d\x63\xad\x93\xa7\xeb\xe0\x54\x1b\xcd\xd9\x8f\x4a\x63\x4e\x52\xed\x68\x76\xd2\x41\x9b\x71\x84\x77\x5b\x18\x5d\x6d\xb0\x7a\xb4\x4d\x55\xc2\x58\x46\xcb\xdf\x30\x85\x07\x89\x07\xf8\xb1\xdc\x43\x4e\xab\x49\x90\xeb\x64\x37\xad\x0b\xb6\x43\xfc\x64\x76\x15\x23\xf4\x45\x01\xe5\x48\x32\xfd\x41\x97\xa1\x97\xdb\xa3\x20\x5f\x43\x17\x0c\xe7\x43\x5a\x17\xcd\xc7\x98\x56\xed\xec\x59\x97\x54\xce\x02\x75\x1a\x0c\xb3\x22\x48\x6e\x19\xe3\xc7\xe9\x0e\x52\x4d\x99\x0c\x44\x3b\xe2\xc6\x19\xdc\x7f\x68\x63\xe0\xcd\x08\x69\xb9\xee\xc1\x2f\x47\x59\x3b\x62\x47\x25\xec\x93\xf7\x64\xca\x4e\x6d\x51\x03\x3e\xfb\x1b\x9d\x9a\xd2\xbc\xbe\xeb\xa6\xda\xed\x7e\x5a\x34\x86\x54\x2a\x8c\x7f\x6c\x71\x20\xfe\xd4\x6e\xb2\xa4\xd2\x85\x79\x3d\x9d\xd0\xd8\xf6\x0e\xf2\x03\xf1\x98\xc1\xe7\xa9\x61\xe2\x23\x4f\xab\x8f\xd9\x4d\x9b\x9b\xef\xc0\xd0\x37\xfd\xdf\x20\xe8\x87\x07\xd4\xd2\x68\x95\x2d\x15\x3d\x28\x86\x6d\x0f\xa1\x9f\x79\xe4\x56\x33\x9c\x33\x8c\x8b\x59\xd0\x72\x3c\x4d\xe5\x6d\x5d\xc7\x8e\x0e\x85\x23\xb7\x78\xf8\xee\xe0\x6f\x3c\x89\x77\xa8\xdf\x7c\xf8\x26\xf5\x8b\x2d\xb6\x78\x8f\xe3\x4c\xec\xc3\xcd\x8c\x67\xa4\xce\x6e\x0d\xbb\xef\xde\x1a\xb2\xc1\x76\x94\xe6\x2c\x54\xaf\x09\x2f\xcf\x94\xa5\x9e\x68\xb3\xcd\xd6\xa3\xa9\xb8\x00\xb0\x16\x02\x00\xae\xe2\xfa\xdf\x00\x76\x9e\xa6\x51\x29\x0a\x00\x00"
 
 func utilitycontractsPrivatereceiverforwarderCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -133,7 +239,7 @@ func utilitycontractsPrivatereceiverforwarderCdc() (*asset, error) {
 	return a, nil
 }
 
-var _utilitycontractsTokenforwardingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\xc1\x6e\xe3\x36\x14\xbc\xf3\x2b\x66\x53\xa0\x6b\x07\x59\xf9\x52\xf4\x10\x24\xdd\x16\x69\x73\xec\x21\xd8\xb6\xc7\x82\x26\x9f\x2c\x6e\x64\x52\x20\x9f\xac\x06\x81\xff\xbd\x20\x45\xd1\x52\xe2\x14\xe9\x65\x7d\xb1\x25\xf3\xcd\x9b\x79\x33\x24\x37\x97\x97\x42\x7c\x87\xfb\xde\xee\xcc\xb6\x25\x7c\x71\x8f\x64\x71\xef\xfc\x20\xbd\x36\x76\x87\x3b\x67\xd9\x4b\xc5\x42\x7c\x69\x4c\x80\xca\x8f\x08\x8d\x1b\x02\x1a\x37\x40\x5a\x48\xa5\x5c\x6f\x19\xca\xf5\xad\x46\x20\x46\xdf\x41\x42\xf5\x81\xdd\xbe\x80\x8f\xd8\x0f\xa4\xc8\x1c\xc8\x0b\x76\x90\x6d\xeb\x06\x70\x43\x7b\xb0\x43\x3d\x76\x05\xc7\x75\x21\xbe\x91\xd0\xa6\xae\xc9\x93\xe5\xd2\x63\x68\xc8\xd2\x81\x7c\x2c\x7b\x82\x1f\xd1\x72\x4d\x15\x59\xd2\x13\x94\xb4\xe8\xfa\x6d\x6b\x42\x03\x8e\xb4\xb3\x20\xf2\xf0\x14\x5c\xef\x15\x41\x06\xc8\x42\x06\x4a\x76\x72\x6b\x5a\xc3\x4f\xf8\xda\x07\x46\x6b\x1e\x09\x12\x7f\xca\xbe\xe5\x2b\x21\xad\x8e\xed\x10\xc8\x46\x0c\xed\x28\xd8\x8f\x0c\x3a\x90\x85\x25\x8a\x94\xf1\x68\xdd\x00\xc3\x30\xe1\x44\xba\x12\xe2\xaf\x86\xec\x7c\x44\x83\xb4\x9c\xb4\x29\x4f\x92\x63\x8f\xc2\xed\x6a\x94\xa4\x64\xdb\xa6\x6e\xe3\x8a\xdf\x69\x28\x2b\x44\xdd\x5b\xc5\xc6\x45\x44\x8d\xce\xbb\x83\xd1\x14\x9b\x0e\x86\x9b\x54\x53\x04\x79\x4a\x14\x14\x81\x1b\xc9\x23\x72\xec\x3d\x1b\xb4\xe0\x86\x8c\x3f\x8d\xbb\x12\xe2\x72\x23\x84\xd9\x77\xce\xf3\x0b\xd7\x6a\xef\xf6\xb8\xa8\x36\x55\xb5\x59\xfc\x51\x29\xad\x2e\x84\xe8\xfa\xed\x29\x1a\xe9\x8f\x59\x84\x9e\x85\x00\x80\xcd\x06\xbf\x1d\xa2\x93\x89\x90\x09\xa0\xbd\x61\x26\x9d\x1c\x9d\x58\x48\x4f\xd0\xd4\xb9\x60\x78\x1c\x6b\x14\xc5\xd2\xef\x88\x27\xaf\x7d\x42\x8b\x1d\x29\xc1\x4d\xd3\xd1\xbf\x8e\x75\x2b\xb9\x8f\x93\xbe\xc6\x1f\xf7\xe6\x9f\x1f\x7f\xb8\x4a\xdc\xaf\xf1\x8b\xd6\x9e\x42\xf8\xbc\x16\xa5\xbe\x64\xa1\x0c\xf8\x7a\x29\xbb\x2a\xe3\xcc\x1a\xb2\x8e\xb4\x15\x4c\x88\xcc\x3d\x25\x8a\x73\xce\x49\xc8\x60\xda\x16\xdb\x14\x19\xae\x96\xb5\x04\x7e\xea\x08\xc6\x6a\xa3\x24\x53\xc8\x03\x49\x33\x91\x73\xe3\x5c\x7a\x9c\x89\x1e\x21\xca\x4f\xa9\x14\x85\xb0\x0a\xd4\xd6\x6b\x1c\x64\x34\x5d\x99\xce\x50\x14\x7f\x57\x02\xbd\x60\x9e\x79\x9e\x43\xdb\x6c\xa2\xf8\x31\x5e\x63\x66\xe4\x23\x85\x69\x13\xc0\x6d\xbf\x92\xe2\xb4\x6d\x2c\xa4\xdf\xf5\xfb\xb4\x2b\xad\x9e\xe2\x14\xe6\x48\x86\x27\xf3\x0a\xa7\x8f\x21\x23\xf5\x21\xa6\x22\xed\x27\x76\x9e\xf4\x49\xf2\x39\x5a\xd1\xa8\xba\xb7\x13\xf3\xd5\xe8\xe6\xcf\x4b\x9f\x12\xf0\x1a\xcf\xa5\x2a\x7e\xda\x59\x66\x1e\xa8\xc6\x2d\xe2\xa4\xaa\x42\xa8\xda\x3a\xef\xdd\x70\xf3\xfd\xf3\x79\xd3\x8f\x3f\xad\xd6\x1f\xc4\x2b\xc8\xad\x6c\x65\xb4\xe7\x36\x05\xab\xca\x8f\xcb\x75\xb3\xb6\xd5\x92\xf8\xcd\xa7\xf8\xbd\x5e\x2e\x8f\x3b\xe1\xed\x1c\xe7\x0e\x53\x90\x93\x08\x37\x58\xf2\x9f\x2b\x39\x86\x7a\x5d\xd0\x8e\x0b\xb7\x55\x23\xed\x8e\x1e\x26\xc1\xf9\x39\x2c\x7d\x81\xab\xd3\x8b\xba\x9c\x91\xd9\xb9\x7c\xbe\xe8\xd3\xd2\xff\xf2\xe7\x45\xaf\xd5\xdf\xb0\x34\x3c\x9c\x0b\xe4\x4b\x9f\x3a\x4f\x2f\xde\xc4\xcf\xbc\xfa\x3d\x4e\xe1\xc3\x2d\xac\x69\xaf\x71\x71\x97\x6e\x21\xeb\x18\x63\xd9\xb9\x43\x31\x9d\x67\x51\xe4\x89\xd6\xc5\x82\xc2\x71\xf1\xb4\x0c\x0e\x6e\x17\xec\xce\x0d\xdf\x58\xc3\xab\xb3\xdb\xf1\x7d\xea\xff\x57\x48\xbf\xad\xf4\xd7\x69\x18\x0b\x8e\xe5\x98\x7f\x7d\x71\xe5\x57\xf1\x34\xb1\x34\x2c\xae\xe3\x89\x56\xb9\xc2\xde\x88\x5d\x8e\x5c\x89\xdb\xab\x1e\x6f\x8c\x3b\x9e\x15\xa5\xdd\x69\xd0\x9e\xb8\xf7\x16\x37\x9f\xf2\x3d\x7c\x16\xa6\xfc\x5c\x67\x85\x47\xf1\x6f\x00\x00\x00\xff\xff\x91\x6b\x10\x08\x31\x09\x00\x00"
+var _utilitycontractsTokenforwardingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\xcd\x6e\xe3\x36\x10\xbe\xf3\x29\xbe\x4d\x81\xae\x1d\x64\xa5\x4b\xd1\x43\x90\x74\x5b\xa4\xcd\xb1\x87\x60\xdb\x1e\x0b\x9a\x1a\x5b\xdc\xc8\xa4\x40\x8e\xac\x1a\x81\xdf\xbd\x18\x9a\xa6\xa5\xc4\x29\x8a\x9e\x0a\x18\x30\xc5\x9f\x99\xef\x4f\x54\x7d\x7d\xad\xd4\x37\x78\x1c\xdc\xc6\xae\x3a\xc2\x17\xff\x4c\x0e\x8f\x3e\x8c\x3a\x34\xd6\x6d\xf0\xe0\x1d\x07\x6d\x58\xa9\x2f\xad\x8d\x30\xf9\x11\xb1\xf5\x63\x44\xeb\x47\x68\x07\x6d\x8c\x1f\x1c\xc3\xf8\xa1\x6b\x10\x89\x31\xf4\xd0\x30\x43\x64\xbf\x2d\xc5\x8f\xb5\x9f\xc8\x90\xdd\x51\x50\xec\xa1\xbb\xce\x8f\xe0\x96\xb6\x60\x8f\xf5\xb1\x2b\x58\xf6\x45\x99\xd1\x68\xec\x7a\x4d\x81\x1c\x97\x1e\x63\x4b\x8e\x76\x14\xe4\xd8\x1e\xe1\x58\x2d\x9f\xa9\x04\x25\xed\x61\xb4\x43\x3f\xac\x3a\x1b\x5b\xb0\xc0\xce\x84\x28\x20\x50\xf4\x43\x30\x04\x1d\xa1\x0b\x18\x18\xdd\xeb\x95\xed\x2c\xef\xf1\x75\x88\x8c\xce\x3e\x13\x34\x7e\xd7\x43\xc7\x37\x4a\xbb\x46\xda\x21\x92\x6b\x28\xa0\xf1\x14\xdd\x47\x06\xed\xc8\xc1\x11\x35\x82\xf5\xd9\xf9\x11\x96\x61\xe3\x19\x74\xa5\xd4\x1f\x2d\xb9\xa9\x44\xa3\x76\x9c\xb8\x99\x40\x9a\xa5\x47\xc1\x76\x23\x3d\x04\x7c\xd7\xc9\x28\xef\xf8\x95\xc6\xb2\x43\xad\x07\x67\xd8\x7a\xa9\xd8\xa0\x0f\x7e\x67\x1b\x92\xa6\xa3\x65\x61\x4a\x67\x42\x81\x12\x04\x43\xe0\x56\xb3\xac\xed\x53\xef\x89\xd0\x8a\x5b\xb2\xe1\x2c\x77\xa5\xd4\x75\xad\x94\xdd\xf6\x3e\xf0\x2b\xd7\xd6\xc1\x6f\x71\x55\xd5\x55\x55\xcf\x16\x2a\xd3\x98\x2b\xa5\xfa\x61\x75\x8e\x46\x5a\xc8\x98\xad\xdb\xe0\x45\x29\x00\xa8\x6b\xfc\xb2\x13\x27\x13\x20\x1b\x41\x5b\xcb\x4c\x4d\x72\xf4\x84\x42\x07\x42\x43\xbd\x8f\x56\x56\xd8\x0b\x70\xb0\x0e\x1b\xe2\x93\xd7\x21\x55\x93\x8e\xa2\x3f\x17\xfd\x9a\x9f\x8f\xe7\x16\x7a\x2b\x41\xb9\xc5\x6f\x8f\xf6\xaf\xef\xbf\xbb\x49\xd8\x6f\xf1\x53\xd3\x04\x8a\xf1\xf3\x52\x95\xf3\x25\x0b\xa7\x12\xe1\x76\x4e\xbb\x2a\x72\x66\x0e\x99\x47\x7a\x15\x6c\x14\xe4\x41\x04\x9e\x63\x4e\x44\x46\xdb\x75\x58\xa5\xc8\x70\x35\x3f\x4b\xe0\x7d\x4f\xb0\xae\xb1\x46\x33\xc5\x2c\x48\x8a\x8e\x9e\x1a\x27\x2f\xc0\x8c\xb4\xfc\xea\xba\x0c\xb5\x31\x14\xe3\x22\x52\xb7\x5e\x62\xa7\xc5\x74\x63\x7b\x4b\x42\xfe\xa1\x04\x7a\x86\x3c\xe3\x9c\x4c\x4d\x57\x1f\x4f\xf1\x4a\x88\x58\x3f\x53\x3c\xbd\x04\xf0\xab\xaf\x64\x38\xbd\x36\x0e\x3a\x6c\x86\xad\x88\x2f\x39\xcc\x71\x8a\xd3\x4a\x96\x4f\xe6\x15\x4c\x1f\x63\xae\x34\x44\xeb\x36\x49\xb5\xc8\x3e\x50\x73\xa6\x3c\xa9\x50\x86\x62\xd4\x7a\x70\x27\x85\x17\x47\x37\x7f\x9c\xfb\x94\x0a\x2f\xf1\x52\x4e\xc9\xaf\x9b\x64\xe6\x89\xd6\xb8\x87\x28\x55\x15\x40\xd5\xca\x87\xe0\xc7\xbb\x6f\x5f\x2e\x9b\x7e\xf8\x61\xb1\xfc\xa0\xde\x94\x5c\xe9\x4e\x3b\x43\xb8\x4f\xc1\xaa\xf2\xe3\x7c\xdf\xa4\x6d\x35\x07\x7e\xf7\x49\xfe\x97\xf3\xed\xb4\xb5\xff\x90\xe3\xdc\xe1\x14\xe4\x44\xc2\x8f\x8e\xc2\xe7\x4a\x1f\x43\xbd\x2c\xd5\x0e\xe7\xc2\x75\x0d\xd3\x6a\xb7\xa1\xa7\x13\xe1\xfc\x1c\xe7\xbe\xc0\xaf\xd3\x44\xb6\x51\x6e\xd6\xa3\x73\xf9\x7e\x69\xce\x5b\x27\xb5\xdf\xf8\xf3\xaa\xd7\xe2\x4f\x38\x1a\x9f\x2e\x05\xf2\xb5\x4f\x7d\xa0\x57\x33\xf2\x9b\x9e\xfe\x37\x4e\xe1\xc3\x3d\x9c\xed\x6e\x71\xf5\x90\xbe\x42\xce\x33\x8e\xc7\x2e\x5d\x8a\x22\x65\x22\x79\x86\x75\x35\x83\x70\x98\x3d\xcd\x83\x83\xfb\x19\xba\x4b\xe2\x5b\x67\x79\x11\xfe\x3b\xfb\xf0\xff\xa5\x5e\xc6\x65\xdb\x41\x4d\xd8\xd7\xf5\x85\x0f\x57\x9e\x92\xdb\xc4\xd1\x58\xa2\x3e\x85\x55\x3e\x61\xef\xc4\x2e\x47\xae\xc4\xed\x4d\x8f\x77\xe4\x96\xbb\xa2\xb4\x3b\x0b\x1d\x88\x87\xe0\x70\xf7\x29\x7f\x87\x2f\x96\x29\xc3\xa5\x02\x80\x83\x3a\xa8\xbf\x07\x00\x91\x6b\x10\x08\x31\x09\x00\x00"
 
 func utilitycontractsTokenforwardingCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -153,6 +259,26 @@ func utilitycontractsTokenforwardingCdc() (*asset, error) {
 	return a, nil
 }
 
+var _utilitycontractsTokenmemoCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x4d\x8f\xd3\x3c\x10\xbe\xfb\x57\x3c\xda\x57\x7a\xd9\xae\x4a\x7a\x41\x1c\x2a\xd0\x82\x80\xbd\x71\x81\x02\x67\xd7\x99\x24\x16\xc9\x4c\x64\x4f\x9b\xad\x56\xfb\xdf\x91\x9d\x8f\x6d\x10\x52\xa4\x24\x1a\xcf\xf3\xe9\xdd\xdd\x9d\x31\xff\xe1\xe1\xc4\xb5\x3f\xb6\x84\x83\xfc\x26\xc6\x57\xea\x04\x9f\x84\x35\x58\xa7\xc6\x1c\x1a\x42\x54\xcb\xa5\x0d\x25\x4a\xea\x25\x7a\x45\x63\x23\x58\x30\xd8\x0b\x54\x10\xed\x05\x43\x63\x15\x16\xbd\xbd\x74\xc4\x0a\x1f\x51\x49\x28\xcc\xa1\xf1\x11\x6e\x02\x43\x4f\xa1\x92\xd0\x45\xd8\x05\x49\x18\x47\x6a\x6c\x5b\x41\x2a\x68\xe2\x22\x2e\x29\xc0\x72\x09\xea\xbc\x46\x58\x06\x9d\x89\xd5\x68\xa2\x70\x36\x04\x4f\x09\xa1\x4b\x3a\x6d\x2b\x5c\x47\x5f\x12\xbc\x6e\x11\x05\xf9\x50\x5a\x79\x74\x8d\xe5\x9a\x20\x01\xa2\x0d\x05\x04\x72\xbe\xf7\x09\xc8\x59\x4e\x7f\xc2\xce\xb7\x94\x49\x67\x35\x83\xd7\x06\x16\x81\x2a\x0a\xc4\x8e\x26\x51\x3e\xc0\x35\x22\xd1\x73\x5d\x8c\x89\xd8\x4e\x4e\xac\x59\xe5\x82\x0b\xcf\x19\x2c\xab\x85\x93\x8e\x50\x05\xe9\x56\x04\x5e\x23\xb5\xd5\xd6\x44\x99\x1d\x38\xcb\xaf\x14\x47\xca\x6e\x95\xca\x94\x1b\x34\x35\x11\x47\x33\x03\x05\x02\xd3\x99\x42\xca\x46\x0b\x63\xee\x76\xc6\xf8\xae\x97\xa0\x4b\x77\x63\x75\x99\xee\xa6\xd8\x15\xc5\x6e\x35\x28\x5c\xe9\x6e\x8c\xe9\x4f\xc7\x97\x2e\xf2\x20\x77\xfd\x64\x0c\x00\xec\x76\xf8\x92\x95\x67\x56\x1f\x17\x41\x43\x43\x3c\x2b\xb2\x61\xf1\x92\x26\x63\x5c\xa9\x89\x0c\x91\x08\x46\xf7\x09\xf8\xf3\xe8\xf9\x36\x8d\xf7\xf8\xae\xc1\x73\xbd\x9d\x92\xdb\xe3\xc7\x83\x7f\x7c\xfb\x66\x0b\x95\x3d\x3e\x96\x65\xa0\x18\xef\xb7\x38\xdb\x53\xab\x87\x4b\x4f\xf3\xc2\x66\x11\x37\xd1\xfe\xf2\xda\x24\xf4\x59\x46\x4a\x89\xc6\xbd\xb9\x80\x40\x8e\xfc\x99\xc2\xbc\x79\x75\x97\xae\x85\x4d\x52\x73\xe7\x69\xad\xf6\x67\xe2\xb5\x99\xea\xc4\x7f\xf3\xde\xa6\x90\xf7\xf8\xb0\x0e\xf8\x67\xe2\x1f\xcd\xfc\xff\xb4\x1e\x7d\x9b\xd4\x3c\x6f\x71\x9d\xc4\x06\x4f\x59\x5f\x7a\x5a\xd2\xf9\x46\xbd\xcf\x77\xa6\x38\xda\xd6\xb2\xa3\xd5\x89\x25\x9b\xf9\x50\x4d\xf9\xf7\x76\x53\xf8\x92\x58\x7d\xe5\x29\x98\x65\x45\xa5\x98\xa4\x4f\x92\xdf\xbd\x4e\xef\x29\xd0\xf4\xa4\x4c\xfe\xd1\x54\x52\xf9\xd2\xd3\xf8\x1e\xad\xa9\x14\x32\x30\x85\xfb\xc2\x8e\x8d\xad\x0a\x5b\x3e\x37\x06\x00\x9e\xcd\xb3\xf9\x33\x00\x70\x22\x6d\x90\x62\x04\x00\x00"
+
+func utilitycontractsTokenmemoCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_utilitycontractsTokenmemoCdc,
+		"utilityContracts/TokenMemo.cdc",
+	)
+}
+
+func utilitycontractsTokenmemoCdc() (*asset, error) {
+	bytes, err := utilitycontractsTokenmemoCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "utilityContracts/TokenMemo.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc8, 0xa, 0x43, 0xe0, 0x5, 0xdf, 0xa0, 0x35, 0x44, 0xdf, 0x67, 0xfe, 0xe5, 0xc2, 0xdf, 0x30, 0x2f, 0xba, 0x42, 0x69, 0x74, 0xf7, 0x1f, 0xd4, 0xf3, 0x3d, 0xca, 0xc1, 0xc8, 0x68, 0x6, 0x68}}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -244,10 +370,16 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"ExampleToken.cdc":  exampletokenCdc,
-	"FungibleToken.cdc": fungibletokenCdc,
+	"ExampleToken.cdc":                              exampletokenCdc,
+	"FungibleToken.cdc":                             fungibletokenCdc,
+	"MetadataViews.cdc":                             metadataviewsCdc,
+	"MockToken.cdc":                                 mocktokenCdc,
+	"WrapperToken.cdc":                              wrappertokenCdc,
+	"legacy/ExampleToken.cdc":                       legacyExampletokenCdc,
+	"utilityContracts/NonFungibleToken.cdc":         utilitycontractsNonfungibletokenCdc,
 	"utilityContracts/PrivateReceiverForwarder.cdc": utilitycontractsPrivatereceiverforwarderCdc,
 	"utilityContracts/TokenForwarding.cdc":          utilitycontractsTokenforwardingCdc,
+	"utilityContracts/TokenMemo.cdc":                utilitycontractsTokenmemoCdc,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -296,9 +428,17 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"ExampleToken.cdc": {exampletokenCdc, map[string]*bintree{}},
 	"FungibleToken.cdc": {fungibletokenCdc, map[string]*bintree{}},
+	"MetadataViews.cdc": {metadataviewsCdc, map[string]*bintree{}},
+	"MockToken.cdc": {mocktokenCdc, map[string]*bintree{}},
+	"WrapperToken.cdc": {wrappertokenCdc, map[string]*bintree{}},
+	"legacy": {nil, map[string]*bintree{
+		"ExampleToken.cdc": {legacyExampletokenCdc, map[string]*bintree{}},
+	}},
 	"utilityContracts": {nil, map[string]*bintree{
+		"NonFungibleToken.cdc": {utilitycontractsNonfungibletokenCdc, map[string]*bintree{}},
 		"PrivateReceiverForwarder.cdc": {utilitycontractsPrivatereceiverforwarderCdc, map[string]*bintree{}},
 		"TokenForwarding.cdc": {utilitycontractsTokenforwardingCdc, map[string]*bintree{}},
+		"TokenMemo.cdc": {utilitycontractsTokenmemoCdc, map[string]*bintree{}},
 	}},
 }}
 
@@ -0,0 +1,28 @@
+package contracts_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+// legacyExampleTokenChecksum is the SHA-256 checksum of ExampleTokenLegacy(addrA).
+// The snapshot is frozen, so this must never change.
+const legacyExampleTokenChecksum = "558a1829f4a66d296790a1978b2092adad9da8824f2d5ca47dea436b192d5a93"
+
+func TestExampleTokenLegacyContract(t *testing.T) {
+	contract := contracts.ExampleTokenLegacy(addrA)
+
+	checksum := sha256.Sum256(contract)
+	assert.Equal(t, legacyExampleTokenChecksum, hex.EncodeToString(checksum[:]))
+
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+	assert.NotContains(t, string(contract), "MetadataViews")
+
+	unresolved, _ := contracts.HasUnresolvedImports(contract)
+	assert.False(t, unresolved)
+}
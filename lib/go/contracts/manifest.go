@@ -0,0 +1,68 @@
+package contracts
+
+import (
+	"encoding/json"
+
+	"github.com/onflow/flow-ft/lib/go/contracts/internal/assets"
+)
+
+// ContractInfo describes one of the standard contracts this package embeds.
+type ContractInfo struct {
+	// Name is the name of the contract
+	Name string `json:"name"`
+	// Filename is the path of the contract's source in the contracts directory
+	Filename string `json:"filename"`
+	// Imports are the names of the contracts it imports, in the order they are imported
+	Imports []string `json:"imports"`
+	// Utility reports whether it is one of the utility contracts built on the standard,
+	// e.g. TokenForwarding. Dependencies such as NonFungibleToken are not utility contracts
+	Utility bool `json:"utility"`
+}
+
+// standardContracts are the contracts ListContracts describes, in deployment order.
+// Test fixtures such as MockToken and WrapperToken are not included.
+//
+// NonFungibleToken is stored with the utility contracts but is a dependency
+// of MetadataViews, so utility contracts are marked explicitly.
+var standardContracts = []struct {
+	name     string
+	filename string
+	utility  bool
+}{
+	{"FungibleToken", filenameFungibleToken, false},
+	{"NonFungibleToken", filenameNonFungibleToken, false},
+	{"MetadataViews", filenameMetadataViews, false},
+	{"ExampleToken", filenameExampleToken, false},
+	{"TokenForwarding", filenameTokenForwarding, true},
+	{"PrivateReceiverForwarder", filenamePrivateForwarder, true},
+	{"TokenMemo", filenameTokenMemo, true},
+}
+
+// ListContracts describes the standard contracts this package embeds,
+// in an order they can be deployed in.
+func ListContracts() []ContractInfo {
+	contracts := make([]ContractInfo, 0, len(standardContracts))
+
+	for _, contract := range standardContracts {
+		imports := []string{}
+		for _, match := range declaredImport.FindAllSubmatch(assets.MustAsset(contract.filename), -1) {
+			imports = append(imports, string(match[1]))
+		}
+
+		contracts = append(contracts, ContractInfo{
+			Name:     contract.name,
+			Filename: contract.filename,
+			Imports:  imports,
+			Utility:  contract.utility,
+		})
+	}
+
+	return contracts
+}
+
+// Manifest returns ListContracts as JSON.
+//
+// The output only changes when the embedded contracts do.
+func Manifest() ([]byte, error) {
+	return json.MarshalIndent(ListContracts(), "", "  ")
+}
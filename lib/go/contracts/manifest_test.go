@@ -0,0 +1,69 @@
+package contracts_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+func TestManifest(t *testing.T) {
+	manifest, err := contracts.Manifest()
+	require.NoError(t, err)
+
+	var entries []struct {
+		Name     string   `json:"name"`
+		Filename string   `json:"filename"`
+		Imports  []string `json:"imports"`
+		Utility  bool     `json:"utility"`
+	}
+	require.NoError(t, json.Unmarshal(manifest, &entries))
+
+	byName := make(map[string]int, len(entries))
+	for i, entry := range entries {
+		byName[entry.Name] = i
+	}
+
+	require.Contains(t, byName, "FungibleToken")
+	require.Contains(t, byName, "ExampleToken")
+	require.Contains(t, byName, "TokenForwarding")
+	require.Contains(t, byName, "PrivateReceiverForwarder")
+	require.Contains(t, byName, "NonFungibleToken")
+
+	fungibleToken := entries[byName["FungibleToken"]]
+	assert.Equal(t, "FungibleToken.cdc", fungibleToken.Filename)
+	assert.Empty(t, fungibleToken.Imports)
+	assert.False(t, fungibleToken.Utility)
+
+	exampleToken := entries[byName["ExampleToken"]]
+	assert.Equal(t, []string{"FungibleToken", "MetadataViews"}, exampleToken.Imports)
+	assert.False(t, exampleToken.Utility)
+
+	nonFungibleToken := entries[byName["NonFungibleToken"]]
+	assert.Equal(t, "utilityContracts/NonFungibleToken.cdc", nonFungibleToken.Filename)
+	assert.False(t, nonFungibleToken.Utility)
+
+	for _, name := range []string{"TokenForwarding", "PrivateReceiverForwarder"} {
+		utility := entries[byName[name]]
+		assert.True(t, utility.Utility, name)
+		assert.Equal(t, []string{"FungibleToken"}, utility.Imports, name)
+	}
+
+	t.Run("Should be stable", func(t *testing.T) {
+		again, err := contracts.Manifest()
+		require.NoError(t, err)
+		assert.Equal(t, manifest, again)
+	})
+
+	t.Run("Should list each contract after its imports", func(t *testing.T) {
+		for i, entry := range entries {
+			for _, imported := range entry.Imports {
+				require.Contains(t, byName, imported)
+				assert.Less(t, byName[imported], i, "%s imports %s", entry.Name, imported)
+			}
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package contracts
+
+import "strings"
+
+// fixtureContracts are the contracts this package has loaders for
+// that are not listed by ListContracts
+var fixtureContracts = []string{
+	"WrapperToken",
+	"MockToken",
+}
+
+// ResolveContractName returns the canonical name of the contract
+// whose name matches input regardless of case, e.g. "ExampleToken" for "exampletoken".
+//
+// It only knows the contracts this package has a loader for,
+// each of which is named after the contract it returns,
+// and reports false for any other input.
+func ResolveContractName(input string) (string, bool) {
+	for _, contract := range standardContracts {
+		if strings.EqualFold(contract.name, input) {
+			return contract.name, true
+		}
+	}
+
+	for _, name := range fixtureContracts {
+		if strings.EqualFold(name, input) {
+			return name, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,36 @@
+package contracts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+func TestResolveContractName(t *testing.T) {
+	for _, input := range []string{"fungibletoken", "FUNGIBLETOKEN", "FungibleToken"} {
+		name, ok := contracts.ResolveContractName(input)
+		assert.True(t, ok, input)
+		assert.Equal(t, "FungibleToken", name, input)
+	}
+
+	t.Run("Should resolve every listed contract", func(t *testing.T) {
+		for _, contract := range contracts.ListContracts() {
+			name, ok := contracts.ResolveContractName(contract.Name)
+			assert.True(t, ok, contract.Name)
+			assert.Equal(t, contract.Name, name)
+		}
+
+		name, ok := contracts.ResolveContractName("mocktoken")
+		assert.True(t, ok)
+		assert.Equal(t, "MockToken", name)
+	})
+
+	t.Run("Should not resolve unknown names", func(t *testing.T) {
+		for _, input := range []string{"", "Fungible", "FungibleToken ", "FlowToken"} {
+			_, ok := contracts.ResolveContractName(input)
+			assert.False(t, ok, input)
+		}
+	})
+}
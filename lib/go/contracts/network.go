@@ -0,0 +1,85 @@
+package contracts
+
+import "fmt"
+
+// Network is a Flow network the standard contracts are deployed to.
+type Network string
+
+const (
+	Emulator Network = "emulator"
+	Testnet  Network = "testnet"
+	Mainnet  Network = "mainnet"
+)
+
+// coreAddresses are the addresses of the contracts the standard contracts import
+// on each network, without a 0x prefix like the addresses the loaders take
+var coreAddresses = map[Network]map[string]string{
+	Emulator: {
+		"FungibleToken":    "ee82856bf20e2aa6",
+		"NonFungibleToken": "f8d6e0586b0a20c7",
+		"MetadataViews":    "f8d6e0586b0a20c7",
+	},
+	Testnet: {
+		"FungibleToken":    "9a0766d93b6608b7",
+		"NonFungibleToken": "631e88ae7f1d7c20",
+		"MetadataViews":    "631e88ae7f1d7c20",
+	},
+	Mainnet: {
+		"FungibleToken":    "f233dcee88fe0abe",
+		"NonFungibleToken": "1d7e57aa55817448",
+		"MetadataViews":    "1d7e57aa55817448",
+	},
+}
+
+// suiteLoaders load each contract ListContracts describes,
+// with its imports resolved to the given core addresses
+var suiteLoaders = map[string]func(addrs map[string]string) []byte{
+	"FungibleToken": func(map[string]string) []byte {
+		return FungibleToken()
+	},
+	"NonFungibleToken": func(map[string]string) []byte {
+		return NonFungibleToken()
+	},
+	"MetadataViews": func(addrs map[string]string) []byte {
+		return MetadataViews(addrs["FungibleToken"], addrs["NonFungibleToken"])
+	},
+	"ExampleToken": func(addrs map[string]string) []byte {
+		return ExampleTokenWithMetadataViews(addrs["FungibleToken"], addrs["MetadataViews"])
+	},
+	"TokenForwarding": func(addrs map[string]string) []byte {
+		return TokenForwarding(addrs["FungibleToken"])
+	},
+	"PrivateReceiverForwarder": func(addrs map[string]string) []byte {
+		return PrivateReceiverForwarder(addrs["FungibleToken"])
+	},
+	"TokenMemo": func(addrs map[string]string) []byte {
+		return TokenMemo(addrs["FungibleToken"])
+	},
+}
+
+// ResolveStandardSuite returns every contract ListContracts describes, keyed by contract name,
+// with its imports resolved to the addresses the FungibleToken, NonFungibleToken
+// and MetadataViews contracts are deployed to on the network.
+//
+// The contracts can be deployed in the order ListContracts returns them in.
+// Only the imports are resolved, so e.g. ExampleToken can be deployed to any account.
+func ResolveStandardSuite(network Network) (map[string][]byte, error) {
+	addrs, ok := coreAddresses[network]
+	if !ok {
+		return nil, fmt.Errorf("unknown network %q", network)
+	}
+
+	contracts := ListContracts()
+
+	suite := make(map[string][]byte, len(contracts))
+	for _, contract := range contracts {
+		load, ok := suiteLoaders[contract.Name]
+		if !ok {
+			return nil, fmt.Errorf("no loader for contract %s", contract.Name)
+		}
+
+		suite[contract.Name] = load(addrs)
+	}
+
+	return suite, nil
+}
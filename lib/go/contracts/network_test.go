@@ -0,0 +1,45 @@
+package contracts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+func TestResolveStandardSuite(t *testing.T) {
+	suite, err := contracts.ResolveStandardSuite(contracts.Emulator)
+	require.NoError(t, err)
+
+	for _, contract := range contracts.ListContracts() {
+		require.Contains(t, suite, contract.Name)
+	}
+	assert.Len(t, suite, len(contracts.ListContracts()))
+
+	for name, code := range suite {
+		unresolved, paths := contracts.HasUnresolvedImports(code)
+		assert.False(t, unresolved, "%s imports %v", name, paths)
+		assert.NotContains(t, string(code), "ADDRESS", name)
+	}
+
+	assert.Contains(t, string(suite["ExampleToken"]), "import FungibleToken from 0xee82856bf20e2aa6")
+	assert.Contains(t, string(suite["ExampleToken"]), "import MetadataViews from 0xf8d6e0586b0a20c7")
+	assert.Contains(t, string(suite["MetadataViews"]), "import NonFungibleToken from 0xf8d6e0586b0a20c7")
+
+	t.Run("Should resolve the suite of each network", func(t *testing.T) {
+		testnet, err := contracts.ResolveStandardSuite(contracts.Testnet)
+		require.NoError(t, err)
+		assert.Contains(t, string(testnet["TokenForwarding"]), "import FungibleToken from 0x9a0766d93b6608b7")
+
+		mainnet, err := contracts.ResolveStandardSuite(contracts.Mainnet)
+		require.NoError(t, err)
+		assert.Contains(t, string(mainnet["TokenForwarding"]), "import FungibleToken from 0xf233dcee88fe0abe")
+	})
+
+	t.Run("Should error for an unknown network", func(t *testing.T) {
+		_, err := contracts.ResolveStandardSuite("localnet")
+		assert.EqualError(t, err, `unknown network "localnet"`)
+	})
+}
@@ -0,0 +1,617 @@
+package contracts
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CustomTokenOption enables an optional feature of the contract returned by CustomTokenWithMetadataViews.
+//
+// Options change the contract's public interface, so none are applied by default.
+type CustomTokenOption func(*customTokenConfig)
+
+type customTokenConfig struct {
+	depositEventField *string
+	fixedSupply       bool
+	displayDecimals   *uint8
+	transferEvent     bool
+	mutableDisplay    bool
+	minterAllowance   bool
+	burnReason        bool
+	annotatedImports  bool
+	importPathPrefix  string
+	header            string
+}
+
+// WithDepositEventField adds an event that reports deposits together with
+// a String field of the given name, e.g. a memo or a category.
+//
+// The FungibleToken interface fixes the signatures of TokensDeposited and deposit,
+// so the field is carried by a separate TokensDepositedWith<Field> event instead.
+// It is emitted, alongside TokensDeposited, by the Vault function
+// depositWith<Field>(from:<field>:), e.g. depositWithMemo(from:memo:).
+//
+// CustomTokenWithMetadataViews returns an error if the name isn't a Cadence identifier, is a keyword,
+// or is the name of another parameter of the event or function: amount, to or from.
+func WithDepositEventField(name string) CustomTokenOption {
+	return func(config *customTokenConfig) {
+		config.depositEventField = &name
+	}
+}
+
+// FixedSupply removes the Administrator, Minter and Burner resources,
+// together with their events and the admin storage path,
+// so the initial balance is the only supply the token ever has.
+//
+// Vaults still subtract their balance from the total supply when they are destroyed.
+func FixedSupply() CustomTokenOption {
+	return func(config *customTokenConfig) {
+		config.fixedSupply = true
+	}
+}
+
+// WithDisplayDecimals adds a DisplayDecimals view to the Vault,
+// with the number of decimal places apps should display amounts with.
+//
+// UFix64 values always have 8 decimal places, so this only affects how amounts are presented.
+func WithDisplayDecimals(decimals uint8) CustomTokenOption {
+	return func(config *customTokenConfig) {
+		config.displayDecimals = &decimals
+	}
+}
+
+// WithTransferEvent adds a Transfer(from: Address?, to: Address?, amount: UFix64) event,
+// for indexers that expect the same transfer event from every token.
+//
+// It is emitted by deposit to a Vault stored in an account, alongside TokensDeposited,
+// with the address of the account the deposited tokens were withdrawn from.
+// from is nil for tokens that were minted.
+//
+// Deposits to a Vault that isn't stored in an account, i.e. merging withdrawn Vaults,
+// don't emit it: the merged Vault remembers how much was withdrawn from each account,
+// and a deposit of it emits one Transfer per account with that account's amount.
+func WithTransferEvent() CustomTokenOption {
+	return func(config *customTokenConfig) {
+		config.transferEvent = true
+	}
+}
+
+// WithMutableDisplay adds a Display struct with the token's name, symbol,
+// description and logo URL, which the Administrator can set after the token is deployed
+// with setDisplay, e.g. with the transaction created by GenerateSetDisplayTransaction.
+//
+// Once set, the Display is resolved as a view of the Vault, and its name, description
+// and logo replace those of the FTVaultDisplay view.
+// With FixedSupply there is no Administrator, so the Display can't be set.
+func WithMutableDisplay() CustomTokenOption {
+	return func(config *customTokenConfig) {
+		config.mutableDisplay = true
+	}
+}
+
+// WithAdjustableMinterAllowance adds setMinterAllowance to the Administrator,
+// which replaces the remaining allowance of an existing Minter,
+// e.g. with the transaction created by GenerateSetMinterAllowanceTransaction.
+//
+// With FixedSupply there is no Administrator or Minter, so this has no effect.
+func WithAdjustableMinterAllowance() CustomTokenOption {
+	return func(config *customTokenConfig) {
+		config.minterAllowance = true
+	}
+}
+
+// WithBurnReason adds a TokensBurnedWithReason(amount: UFix64, reason: String) event
+// and the Burner function burnTokensWithReason(from:reason:) that emits it,
+// alongside TokensBurned, so every burn can be audited with the reason for it,
+// e.g. with the transaction created by GenerateBurnWithReasonTransaction.
+//
+// With FixedSupply there is no Burner, so this has no effect.
+func WithBurnReason() CustomTokenOption {
+	return func(config *customTokenConfig) {
+		config.burnReason = true
+	}
+}
+
+// WithHeader adds a comment with the given text, e.g. a license, at the top of the contract.
+//
+// Every line of the text is commented, and the header is added after the token's
+// name, storage name and initial balance are substituted, so they aren't substituted in the text.
+func WithHeader(text string) CustomTokenOption {
+	return func(config *customTokenConfig) {
+		config.header = text
+	}
+}
+
+// WithAnnotatedImports keeps the path each import of the contract was imported from
+// in a trailing comment, like the WithImportAnnotations LoadOption does for the context-aware loaders:
+//
+//	import FungibleToken from 0xf8d6e0586b0a20c7 // was "./FungibleToken.cdc"
+func WithAnnotatedImports() CustomTokenOption {
+	return func(config *customTokenConfig) {
+		config.annotatedImports = true
+	}
+}
+
+// WithImportsFromPaths imports every contract from a file under the given path prefix
+// instead of an address, like the WithImportPathPrefix LoadOption does for the context-aware loaders:
+//
+//	import FungibleToken from "./FungibleToken.cdc"
+//
+// The addresses passed to CustomTokenWithMetadataViews are then ignored, so they can be empty.
+func WithImportsFromPaths(prefix string) CustomTokenOption {
+	return func(config *customTokenConfig) {
+		config.importPathPrefix = prefix
+	}
+}
+
+const (
+	depositEventDeclaration = "    pub event TokensDeposited(amount: UFix64, to: Address?)\n"
+	vaultDestructor         = "        destroy() {\n"
+
+	vaultBalanceField = "        pub var balance: UFix64\n"
+	vaultBalanceInit  = "            self.balance = balance\n"
+	withdrawReturn    = "            return <-create Vault(balance: amount)\n"
+	depositEmit       = "            emit TokensDeposited(amount: vault.balance, to: self.owner?.address)\n"
+
+	totalSupplyField = "    pub var totalSupply: UFix64\n"
+	totalSupplyInit  = "        self.totalSupply = 1000.0\n"
+
+	vaultDisplayName        = `name: "ExampleToken",`
+	vaultDisplayDescription = `description: "This is an ExampleToken",`
+	vaultDisplayImage       = `MetadataViews.HTTPFile(url: "https://s2.coinmarketcap.com/static/img/coins/200x200/4558.png")`
+
+	vaultDocComment        = "    /// Vault\n"
+	vaultDisplayViewType   = "                Type<MetadataViews.FTVaultDisplay>() , \n"
+	resolveViewDefaultCase = "                default : \n"
+
+	adminResourceDeclaration = "    pub resource Administrator {\n"
+	minterAllowedAmountField = "        pub var allowedAmount: UFix64\n"
+	burnEventDeclaration     = "    pub event TokensBurned(amount: UFix64)\n"
+	burnTokensEnd            = "            emit TokensBurned(amount: amount)\n        }\n"
+	contractInitializer      = "    init() {\n"
+	adminStoragePathField    = "    pub let AdminStoragePath: StoragePath\n"
+	adminStoragePathInit     = "        self.AdminStoragePath = /storage/exampleTokenAdmin\n"
+	adminStoragePathView     = "{Type<&ExampleToken.Administrator>() : ExampleToken.AdminStoragePath}"
+	adminSave                = "        let admin <- create Administrator()\n        self.account.save(<-admin, to: self.AdminStoragePath)\n\n"
+)
+
+// cadenceIdentifier matches the names Cadence allows for declarations and parameters
+var cadenceIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// cadenceKeywords are the keywords of the Cadence version the contracts are written for
+var cadenceKeywords = map[string]bool{
+	"if": true, "else": true, "while": true, "break": true, "continue": true, "return": true,
+	"true": true, "false": true, "nil": true, "let": true, "var": true, "fun": true,
+	"as": true, "create": true, "destroy": true, "for": true, "in": true, "emit": true,
+	"auth": true, "priv": true, "pub": true, "access": true, "set": true, "all": true,
+	"self": true, "init": true, "contract": true, "account": true, "import": true, "from": true,
+	"pre": true, "post": true, "event": true, "struct": true, "resource": true, "interface": true,
+	"transaction": true, "prepare": true, "execute": true, "case": true, "switch": true,
+	"default": true, "enum": true,
+}
+
+// depositEventParameters are the other parameters of the event and function
+// WithDepositEventField adds, which the field can't be named after
+var depositEventParameters = map[string]bool{
+	"amount": true,
+	"to":     true,
+	"from":   true,
+}
+
+// validateDepositEventField returns an error if the field can't be declared
+// as a parameter of the event and function WithDepositEventField adds
+func validateDepositEventField(name string) error {
+	switch {
+	case !cadenceIdentifier.MatchString(name):
+		return fmt.Errorf("deposit event field %q is not a Cadence identifier", name)
+	case cadenceKeywords[name]:
+		return fmt.Errorf("deposit event field %q is a Cadence keyword", name)
+	case depositEventParameters[name]:
+		return fmt.Errorf("deposit event field %q is already a parameter of the deposit event", name)
+	}
+
+	return nil
+}
+
+// supplyEvents matches the declarations, including their doc comments,
+// of the events only the admin resources emit
+var supplyEvents = regexp.MustCompile(`\n(?:    ///.*\n)*    pub event (?:TokensMinted|TokensBurned|TokensBurnedWithReason|MinterCreated|BurnerCreated)\(.*\)\n`)
+
+func newCustomTokenConfig(opts []CustomTokenOption) *customTokenConfig {
+	config := &customTokenConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	return config
+}
+
+// applyCustomTokenOptions edits code for the options enabled in config,
+// or returns an error if an option is invalid or an anchor it edits isn't in code
+func applyCustomTokenOptions(code string, config *customTokenConfig) (string, error) {
+	if config.depositEventField != nil {
+		if err := validateDepositEventField(*config.depositEventField); err != nil {
+			return "", err
+		}
+	}
+
+	editor := &customTokenEditor{code: code}
+
+	if config.depositEventField != nil {
+		addDepositEventField(editor, *config.depositEventField)
+	}
+
+	if config.transferEvent {
+		addTransferEvent(editor)
+	}
+
+	if config.mutableDisplay {
+		addMutableDisplay(editor)
+	}
+
+	if config.minterAllowance {
+		addMinterAllowanceUpdates(editor)
+	}
+
+	if config.burnReason {
+		addBurnReason(editor)
+	}
+
+	if config.displayDecimals != nil {
+		addDisplayDecimals(editor, *config.displayDecimals)
+	}
+
+	if config.fixedSupply {
+		removeAdminResources(editor)
+	}
+
+	if len(editor.missingAnchors) > 0 {
+		return "", fmt.Errorf("anchors not found in the ExampleToken contract:\n\t%s", strings.Join(editor.missingAnchors, "\n\t"))
+	}
+
+	return editor.code, nil
+}
+
+// customTokenEditor edits the ExampleToken contract at anchors, fixed snippets of its code,
+// and records the anchors it couldn't find, e.g. because the contract changed
+type customTokenEditor struct {
+	code           string
+	missingAnchors []string
+}
+
+// replace replaces the first occurrence of anchor with replacement
+func (editor *customTokenEditor) replace(anchor, replacement string) {
+	if !strings.Contains(editor.code, anchor) {
+		editor.missingAnchors = append(editor.missingAnchors, fmt.Sprintf("%q", anchor))
+		return
+	}
+
+	editor.code = strings.Replace(editor.code, anchor, replacement, 1)
+}
+
+// remove removes the code from the first occurrence of start up to the following occurrence of end,
+// keeping end
+func (editor *customTokenEditor) remove(start, end string) {
+	startIndex := strings.Index(editor.code, start)
+	if startIndex < 0 {
+		editor.missingAnchors = append(editor.missingAnchors, fmt.Sprintf("%q", start))
+		return
+	}
+
+	endIndex := strings.Index(editor.code[startIndex:], end)
+	if endIndex < 0 {
+		editor.missingAnchors = append(editor.missingAnchors, fmt.Sprintf("%q", end))
+		return
+	}
+
+	editor.code = editor.code[:startIndex] + editor.code[startIndex+endIndex:]
+}
+
+// addDepositEventField declares the event carrying the field and
+// adds the Vault function that emits it
+func addDepositEventField(editor *customTokenEditor, field string) {
+	suffix := makeFirstUpperCase(field)
+
+	editor.replace(
+		depositEventDeclaration,
+		depositEventDeclaration+fmt.Sprintf(`
+    /// TokensDepositedWith%[1]s
+    ///
+    /// The event that is emitted when tokens are deposited to a Vault with a %[2]s
+    pub event TokensDepositedWith%[1]s(amount: UFix64, to: Address?, %[2]s: String)
+`, suffix, field),
+	)
+
+	editor.replace(
+		vaultDestructor,
+		fmt.Sprintf(`        /// depositWith%[1]s
+        ///
+        /// Function that deposits a Vault like deposit does
+        /// and emits the %[2]s in an additional event.
+        ///
+        pub fun depositWith%[1]s(from: @FungibleToken.Vault, %[2]s: String) {
+            let amount = from.balance
+            self.deposit(from: <-from)
+            emit TokensDepositedWith%[1]s(amount: amount, to: self.owner?.address, %[2]s: %[2]s)
+        }
+
+`, suffix, field)+vaultDestructor,
+	)
+}
+
+// addTransferEvent declares the Transfer event and makes withdrawn Vaults
+// remember how much was withdrawn from each account, so deposit can emit it
+func addTransferEvent(editor *customTokenEditor) {
+	editor.replace(
+		depositEventDeclaration,
+		depositEventDeclaration+`
+    /// Transfer
+    ///
+    /// The event that is emitted when tokens are deposited to a Vault stored in an account,
+    /// once for each account they were withdrawn from
+    pub event Transfer(from: Address?, to: Address?, amount: UFix64)
+`,
+	)
+
+	editor.replace(
+		vaultBalanceField,
+		vaultBalanceField+`
+        /// The amounts of this Vault's balance that were withdrawn from each account.
+        /// The rest of the balance was minted
+        access(contract) var sources: {Address: UFix64}
+`,
+	)
+
+	editor.replace(vaultBalanceInit, vaultBalanceInit+"            self.sources = {}\n")
+
+	editor.replace(
+		withdrawReturn,
+		`            let vault <- create Vault(balance: amount)
+            if let address = self.owner?.address {
+                vault.sources[address] = amount
+            } else {
+                // Hand the withdrawn Vault its share of where this Vault's tokens came from
+                var remaining = amount
+                for address in self.sources.keys {
+                    if remaining == 0.0 {
+                        break
+                    }
+                    let available = self.sources[address]!
+                    let taken = available < remaining ? available : remaining
+                    if taken == available {
+                        self.sources.remove(key: address)
+                    } else {
+                        self.sources[address] = available - taken
+                    }
+                    vault.sources[address] = taken
+                    remaining = remaining - taken
+                }
+            }
+            return <-vault
+`,
+	)
+
+	editor.replace(
+		depositEmit,
+		depositEmit+`            if let to = self.owner?.address {
+                var attributed = 0.0
+                for address in vault.sources.keys {
+                    let amount = vault.sources[address]!
+                    emit Transfer(from: address, to: to, amount: amount)
+                    attributed = attributed + amount
+                }
+                if vault.balance > attributed {
+                    emit Transfer(from: nil, to: to, amount: vault.balance - attributed)
+                }
+            } else {
+                for address in vault.sources.keys {
+                    self.sources[address] = (self.sources[address] ?? 0.0) + vault.sources[address]!
+                }
+            }
+`,
+	)
+}
+
+// addMutableDisplay declares the Display struct and the contract field that stores it,
+// lets the Administrator set it, and resolves it in the Vault
+func addMutableDisplay(editor *customTokenEditor) {
+	editor.replace(
+		vaultDocComment,
+		`    /// Display
+    ///
+    /// View with the display metadata the admin set after the token was deployed
+    pub struct Display {
+        pub let name: String
+        pub let symbol: String
+        pub let description: String
+        pub let logoURL: String
+
+        init(name: String, symbol: String, description: String, logoURL: String) {
+            self.name = name
+            self.symbol = symbol
+            self.description = description
+            self.logoURL = logoURL
+        }
+    }
+
+`+vaultDocComment,
+	)
+
+	editor.replace(
+		totalSupplyField,
+		totalSupplyField+`
+    /// The display metadata the admin set, if any
+    pub var display: Display?
+`,
+	)
+
+	editor.replace(totalSupplyInit, totalSupplyInit+"        self.display = nil\n")
+
+	editor.replace(
+		adminResourceDeclaration,
+		adminResourceDeclaration+`
+        /// setDisplay
+        ///
+        /// Function that sets the display metadata of the token
+        ///
+        pub fun setDisplay(_ display: Display) {
+            ExampleToken.display = display
+        }
+`,
+	)
+
+	editor.replace(
+		vaultDisplayViewType,
+		vaultDisplayViewType+"                Type<ExampleToken.Display>() , \n",
+	)
+
+	editor.replace(
+		resolveViewDefaultCase,
+		`                case Type<ExampleToken.Display>() :
+                    return ExampleToken.display
+
+`+resolveViewDefaultCase,
+	)
+
+	editor.replace(vaultDisplayName, `name: ExampleToken.display?.name ?? "ExampleToken",`)
+	editor.replace(vaultDisplayDescription, `description: ExampleToken.display?.description ?? "This is an ExampleToken",`)
+	editor.replace(
+		vaultDisplayImage,
+		`MetadataViews.HTTPFile(url: ExampleToken.display?.logoURL ?? "https://s2.coinmarketcap.com/static/img/coins/200x200/4558.png")`,
+	)
+}
+
+// addMinterAllowanceUpdates lets the Administrator replace the allowance of a Minter
+func addMinterAllowanceUpdates(editor *customTokenEditor) {
+	editor.replace(
+		adminResourceDeclaration,
+		adminResourceDeclaration+`
+        /// setMinterAllowance
+        ///
+        /// Function that replaces the remaining allowance of an existing minter
+        ///
+        pub fun setMinterAllowance(minter: &Minter, allowedAmount: UFix64) {
+            minter.setAllowedAmount(allowedAmount)
+        }
+`,
+	)
+
+	editor.replace(
+		minterAllowedAmountField,
+		minterAllowedAmountField+`
+        /// setAllowedAmount
+        ///
+        /// Function that replaces the amount of tokens the minter is allowed to mint.
+        /// Only the Administrator can call it.
+        ///
+        access(contract) fun setAllowedAmount(_ allowedAmount: UFix64) {
+            self.allowedAmount = allowedAmount
+        }
+`,
+	)
+}
+
+// addBurnReason declares the TokensBurnedWithReason event
+// and adds the Burner function that emits it
+func addBurnReason(editor *customTokenEditor) {
+	editor.replace(
+		burnEventDeclaration,
+		burnEventDeclaration+`
+    /// TokensBurnedWithReason
+    ///
+    /// The event that is emitted, alongside TokensBurned,
+    /// when tokens are destroyed with a reason for the burn
+    pub event TokensBurnedWithReason(amount: UFix64, reason: String)
+`,
+	)
+
+	editor.replace(
+		burnTokensEnd,
+		burnTokensEnd+`
+        /// burnTokensWithReason
+        ///
+        /// Function that burns a Vault like burnTokens does
+        /// and emits the reason for the burn in an additional event.
+        ///
+        pub fun burnTokensWithReason(from: @FungibleToken.Vault, reason: String) {
+            let amount = from.balance
+            self.burnTokens(from: <-from)
+            emit TokensBurnedWithReason(amount: amount, reason: reason)
+        }
+`,
+	)
+}
+
+// addDisplayDecimals declares the DisplayDecimals view and resolves it in the Vault
+func addDisplayDecimals(editor *customTokenEditor, decimals uint8) {
+	editor.replace(
+		vaultDocComment,
+		`    /// DisplayDecimals
+    ///
+    /// View with the number of decimal places to display amounts with
+    pub struct DisplayDecimals {
+        pub let decimals: UInt8
+
+        init(decimals: UInt8) {
+            self.decimals = decimals
+        }
+    }
+
+`+vaultDocComment,
+	)
+
+	editor.replace(
+		vaultDisplayViewType,
+		vaultDisplayViewType+"                Type<ExampleToken.DisplayDecimals>() , \n",
+	)
+
+	editor.replace(
+		resolveViewDefaultCase,
+		fmt.Sprintf(`                case Type<ExampleToken.DisplayDecimals>() :
+                    return ExampleToken.DisplayDecimals(decimals: %d)
+
+`, decimals)+resolveViewDefaultCase,
+	)
+}
+
+// removeAdminResources removes the resources that can change the supply
+// and everything that refers to them
+func removeAdminResources(editor *customTokenEditor) {
+	// The Administrator, Minter and Burner are declared together, right before the initializer
+	editor.remove(adminResourceDeclaration, contractInitializer)
+
+	editor.code = supplyEvents.ReplaceAllString(editor.code, "")
+
+	editor.replace(adminStoragePathField, "")
+	editor.replace(adminStoragePathInit, "")
+	editor.replace(adminStoragePathView, "{}")
+	editor.replace(adminSave, "")
+}
+
+// addHeader comments every line of the header and adds it before the code
+func addHeader(code, header string) string {
+	if header == "" {
+		return code
+	}
+
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(header, "\r\n", "\n"), "\n"), "\n")
+
+	var comment strings.Builder
+	for _, line := range lines {
+		comment.WriteString(strings.TrimRight("// "+line, " "))
+		comment.WriteString("\n")
+	}
+	comment.WriteString("\n")
+
+	return comment.String() + code
+}
+
+// makeFirstUpperCase makes the first letter in a string uppercase
+func makeFirstUpperCase(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
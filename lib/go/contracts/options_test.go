@@ -0,0 +1,53 @@
+package contracts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-ft/lib/go/contracts/internal/assets"
+)
+
+func TestApplyCustomTokenOptions(t *testing.T) {
+	code := assets.MustAssetString(filenameExampleToken)
+
+	t.Run("Should find the anchors of every option", func(t *testing.T) {
+		config := newCustomTokenConfig([]CustomTokenOption{
+			WithDepositEventField("memo"),
+			WithTransferEvent(),
+			WithMutableDisplay(),
+			WithAdjustableMinterAllowance(),
+			WithBurnReason(),
+			WithDisplayDecimals(2),
+			FixedSupply(),
+		})
+
+		_, err := applyCustomTokenOptions(code, config)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should report the anchors that are missing from the contract", func(t *testing.T) {
+		drifted := strings.Replace(code, depositEmit, "", 1)
+		drifted = strings.Replace(drifted, vaultDestructor, "", 1)
+
+		config := newCustomTokenConfig([]CustomTokenOption{
+			WithDepositEventField("memo"),
+			WithTransferEvent(),
+		})
+
+		_, err := applyCustomTokenOptions(drifted, config)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"        destroy() {\n"`)
+		assert.Contains(t, err.Error(), `"            emit TokensDeposited(amount: vault.balance, to: self.owner?.address)\n"`)
+	})
+
+	t.Run("Should report a missing end of a removed block", func(t *testing.T) {
+		drifted := strings.Replace(code, contractInitializer, "    init () {\n", 1)
+
+		_, err := applyCustomTokenOptions(drifted, newCustomTokenConfig([]CustomTokenOption{FixedSupply()}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"    init() {\n"`)
+	})
+}
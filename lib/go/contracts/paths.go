@@ -0,0 +1,24 @@
+package contracts
+
+// Paths are the storage, public and private paths a token contract uses
+type Paths struct {
+	VaultStorage    string
+	ReceiverPublic  string
+	BalancePublic   string
+	ProviderPrivate string
+	AdminStorage    string
+}
+
+// StockExampleTokenPaths returns the paths the ExampleToken contract uses,
+// as returned by ExampleToken.
+//
+// A CustomToken uses the same paths with exampleToken replaced by its storage name.
+func StockExampleTokenPaths() Paths {
+	return Paths{
+		VaultStorage:    "/storage/exampleTokenVault",
+		ReceiverPublic:  "/public/exampleTokenReceiver",
+		BalancePublic:   "/public/exampleTokenBalance",
+		ProviderPrivate: "/private/exampleTokenVault",
+		AdminStorage:    "/storage/exampleTokenAdmin",
+	}
+}
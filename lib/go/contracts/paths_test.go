@@ -0,0 +1,29 @@
+package contracts_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+func TestStockExampleTokenPaths(t *testing.T) {
+	pathAssignment := regexp.MustCompile(`self\.(\w+Path) = (/\w+/\w+)`)
+
+	parsed := make(map[string]string)
+	for _, match := range pathAssignment.FindAllStringSubmatch(string(contracts.ExampleTokenWithMetadataViews(addrA, addrB)), -1) {
+		parsed[match[1]] = match[2]
+	}
+
+	paths := contracts.StockExampleTokenPaths()
+
+	assert.Equal(t, map[string]string{
+		"VaultStoragePath":    paths.VaultStorage,
+		"ReceiverPublicPath":  paths.ReceiverPublic,
+		"BalancePublicPath":   paths.BalancePublic,
+		"ProviderPrivatePath": paths.ProviderPrivate,
+		"AdminStoragePath":    paths.AdminStorage,
+	}, parsed)
+}
@@ -0,0 +1,87 @@
+package contracts
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"google.golang.org/grpc"
+)
+
+// RegistryClient executes scripts against the network a ContractRegistry is deployed to.
+//
+// It is satisfied by the access client of the Flow Go SDK, *client.Client, or by a mock in tests.
+type RegistryClient interface {
+	ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, arguments []cadence.Value, opts ...grpc.CallOption) (cadence.Value, error)
+}
+
+// registryLookupScript returns the address the ContractRegistry deployed at the given address
+// has for a contract on a network, or nil if it has none
+const registryLookupScript = `
+import ContractRegistry from 0x%s
+
+pub fun main(name: String, network: String): Address? {
+    return ContractRegistry.getContractAddress(name: name, network: network)
+}
+`
+
+// ResolveImportsWithClient replaces the import path of every contract the code imports
+// from a file path with the address the registry has for the contract on the network,
+// as ReplaceImports does with known addresses.
+//
+// The registry is the ContractRegistry deployed at registryAddr, which must declare
+// getContractAddress(name: String, network: String): Address?.
+// It is queried once for each contract, using the name of the imported file,
+// e.g. FungibleToken for "./FungibleToken.cdc".
+// If the registry has no address for some of the contracts, an error listing all of them is returned.
+func ResolveImportsWithClient(ctx context.Context, client RegistryClient, registryAddr string, code []byte, network string) ([]byte, error) {
+	script := []byte(fmt.Sprintf(registryLookupScript, registryAddr))
+
+	_, importPaths := HasUnresolvedImports(code)
+
+	addresses := make(map[string]string, len(importPaths))
+	var missing []string
+
+	for _, importPath := range importPaths {
+		name := strings.TrimSuffix(path.Base(importPath), ".cdc")
+		if _, ok := addresses[name]; ok {
+			continue
+		}
+
+		result, err := client.ExecuteScriptAtLatestBlock(ctx, script, []cadence.Value{
+			cadence.String(name),
+			cadence.String(network),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("looking up %s: %w", name, err)
+		}
+
+		address, ok := registryAddress(result)
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+
+		addresses[name] = address.Hex()
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("no registry entry on %s for:\n\t%s", network, strings.Join(missing, "\n\t"))
+	}
+
+	return ReplaceImports(code, addresses), nil
+}
+
+// registryAddress returns the address in the result of the lookup script,
+// reporting false if the registry returned nil
+func registryAddress(result cadence.Value) (cadence.Address, bool) {
+	if optional, ok := result.(cadence.Optional); ok {
+		result = optional.Value
+	}
+
+	address, ok := result.(cadence.Address)
+
+	return address, ok
+}
@@ -0,0 +1,92 @@
+package contracts_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+// mockRegistryClient answers registry lookups from a fixed set of addresses
+type mockRegistryClient struct {
+	addresses map[string]cadence.Address
+	scripts   []string
+	lookups   []string
+	err       error
+}
+
+func (c *mockRegistryClient) ExecuteScriptAtLatestBlock(_ context.Context, script []byte, arguments []cadence.Value, _ ...grpc.CallOption) (cadence.Value, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	name := string(arguments[0].(cadence.String))
+	network := string(arguments[1].(cadence.String))
+	c.scripts = append(c.scripts, string(script))
+	c.lookups = append(c.lookups, network+"/"+name)
+
+	address, ok := c.addresses[name]
+	if !ok {
+		return cadence.NewOptional(nil), nil
+	}
+
+	return cadence.NewOptional(address), nil
+}
+
+func TestResolveImportsWithClient(t *testing.T) {
+	code := []byte(`
+		import FungibleToken from "./FungibleToken.cdc"
+		import MetadataViews from "./MetadataViews.cdc"
+
+		pub contract Test {}
+	`)
+
+	t.Run("Should replace imports with the registry's addresses", func(t *testing.T) {
+		client := &mockRegistryClient{
+			addresses: map[string]cadence.Address{
+				"FungibleToken": cadence.BytesToAddress([]byte{0x0A}),
+				"MetadataViews": cadence.BytesToAddress([]byte{0x0B}),
+			},
+		}
+
+		resolved, err := contracts.ResolveImportsWithClient(context.Background(), client, "01", code, "testnet")
+		require.NoError(t, err)
+
+		assert.Contains(t, string(resolved), "import FungibleToken from 0x000000000000000a")
+		assert.Contains(t, string(resolved), "import MetadataViews from 0x000000000000000b")
+
+		unresolved, _ := contracts.HasUnresolvedImports(resolved)
+		assert.False(t, unresolved)
+
+		assert.Equal(t, []string{"testnet/FungibleToken", "testnet/MetadataViews"}, client.lookups)
+		assert.Contains(t, client.scripts[0], "import ContractRegistry from 0x01")
+	})
+
+	t.Run("Should report every contract the registry has no entry for", func(t *testing.T) {
+		client := &mockRegistryClient{
+			addresses: map[string]cadence.Address{
+				"FungibleToken": cadence.BytesToAddress([]byte{0x0A}),
+			},
+		}
+
+		_, err := contracts.ResolveImportsWithClient(context.Background(), client, "01", code, "testnet")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no registry entry on testnet")
+		assert.Contains(t, err.Error(), "MetadataViews")
+		assert.NotContains(t, err.Error(), "FungibleToken")
+	})
+
+	t.Run("Should return the client's error", func(t *testing.T) {
+		clientErr := errors.New("connection refused")
+		client := &mockRegistryClient{err: clientErr}
+
+		_, err := contracts.ResolveImportsWithClient(context.Background(), client, "01", code, "testnet")
+		assert.ErrorIs(t, err, clientErr)
+	})
+}
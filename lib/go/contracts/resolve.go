@@ -0,0 +1,61 @@
+package contracts
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// ResolveDir replaces the import paths in every .cdc file of fsys
+// with the addresses in addrs, as ReplaceImports does for a single file.
+//
+// The resolved code is returned keyed by the path of each file in fsys.
+// If a file still imports one of this package's contracts, or a contract named in addrs,
+// from a file path after resolving, an error listing every such file and import is returned.
+// Imports of other contracts are left as they are.
+func ResolveDir(fsys fs.FS, addrs map[string]string) (map[string][]byte, error) {
+	resolved := make(map[string][]byte)
+	var unresolved []string
+
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() || path.Ext(name) != ".cdc" {
+			return nil
+		}
+
+		code, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+
+		code = ReplaceImports(code, addrs)
+
+		_, importPaths := HasUnresolvedImports(code)
+		for _, importPath := range importPaths {
+			contractName := strings.TrimSuffix(path.Base(importPath), ".cdc")
+
+			_, isPlaceholder := importPlaceholders[contractName]
+			_, isAddressed := addrs[contractName]
+			if isPlaceholder || isAddressed {
+				unresolved = append(unresolved, fmt.Sprintf("%s: %s", name, importPath))
+			}
+		}
+
+		resolved[name] = code
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(unresolved) > 0 {
+		return nil, fmt.Errorf("unresolved imports:\n\t%s", strings.Join(unresolved, "\n\t"))
+	}
+
+	return resolved, nil
+}
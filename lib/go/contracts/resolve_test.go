@@ -0,0 +1,58 @@
+package contracts_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+func TestResolveDir(t *testing.T) {
+	fsys := fstest.MapFS{
+		"transactions/setup.cdc": {
+			Data: []byte(`
+				import FungibleToken from "../contracts/FungibleToken.cdc"
+				import ExampleToken from "../contracts/ExampleToken.cdc"
+			`),
+		},
+		"scripts/get_balance.cdc": {
+			Data: []byte(`
+				import FungibleToken from "../../contracts/FungibleToken.cdc"
+				import MyContract from "./MyContract.cdc"
+			`),
+		},
+		"README.md": {
+			Data: []byte(`import FungibleToken from "./FungibleToken.cdc"`),
+		},
+	}
+
+	t.Run("Should resolve every Cadence file", func(t *testing.T) {
+		resolved, err := contracts.ResolveDir(fsys, map[string]string{
+			"FungibleToken": addrA,
+			"ExampleToken":  addrB,
+		})
+		require.NoError(t, err)
+		require.Len(t, resolved, 2)
+
+		assert.Contains(t, string(resolved["transactions/setup.cdc"]), "import FungibleToken from 0x"+addrA)
+		assert.Contains(t, string(resolved["transactions/setup.cdc"]), "import ExampleToken from 0x"+addrB)
+		assert.Contains(t, string(resolved["scripts/get_balance.cdc"]), "import FungibleToken from 0x"+addrA)
+
+		// Imports of contracts this package doesn't know about are left as they are
+		assert.Contains(t, string(resolved["scripts/get_balance.cdc"]), `import MyContract from "./MyContract.cdc"`)
+	})
+
+	t.Run("Should report every unresolved known import", func(t *testing.T) {
+		_, err := contracts.ResolveDir(fsys, map[string]string{
+			"ExampleToken": addrB,
+		})
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "scripts/get_balance.cdc: ../../contracts/FungibleToken.cdc")
+		assert.Contains(t, err.Error(), "transactions/setup.cdc: ../contracts/FungibleToken.cdc")
+		assert.NotContains(t, err.Error(), "MyContract")
+	})
+}
@@ -0,0 +1,183 @@
+package contracts
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	declaredContract = regexp.MustCompile(`(?m)^\s*(?:pub|access\(all\))\s+contract\s+(?:interface\s+)?(\w+)`)
+	declaredResource = regexp.MustCompile(`(?m)^\s*(?:pub|access\(\w+\))\s+resource\s+(interface\s+)?(\w+)`)
+	declaredEvent    = regexp.MustCompile(`(?m)^\s*(?:pub|access\(\w+\))\s+event\s+(\w+)\s*\(`)
+	declaredImport   = regexp.MustCompile(`(?m)^\s*import\s+(\w+)\s+from\s+("[^"]*"|0x\w+)`)
+)
+
+// ContractSummary describes the main declarations of a contract.
+type ContractSummary struct {
+	// Name is the name of the contract
+	Name string
+	// Resources are the names of the resources the contract declares as its members,
+	// not including resource interfaces
+	Resources []string
+	// Events are the names of the events the contract declares as its members
+	Events []string
+	// Imports maps the name of each imported contract to its address,
+	// which may be a placeholder such as 0xFUNGIBLETOKENADDRESS,
+	// or to its file path if the import is unresolved
+	Imports map[string]string
+}
+
+// Summarize reads the name, resources, events, and imports of a contract from its code.
+//
+// The code is scanned for top-level declarations, i.e. the members of the contract,
+// rather than fully parsed, so it is not checked for being valid Cadence.
+// Declarations nested in other declarations, or in comments and strings, are ignored.
+func Summarize(code []byte) (ContractSummary, error) {
+	blanked := blankCommentsAndStrings(code)
+	depths := braceDepths(blanked)
+
+	contract := declaredContract.FindSubmatch(blanked)
+	if contract == nil {
+		return ContractSummary{}, errors.New("code does not declare a contract")
+	}
+
+	summary := ContractSummary{
+		Name:    string(contract[1]),
+		Imports: make(map[string]string),
+	}
+
+	for _, match := range declaredResource.FindAllSubmatchIndex(blanked, -1) {
+		// skip resource interfaces and nested declarations
+		if match[3] > match[2] || depths[match[0]] != 1 {
+			continue
+		}
+		summary.Resources = append(summary.Resources, string(blanked[match[4]:match[5]]))
+	}
+
+	for _, match := range declaredEvent.FindAllSubmatchIndex(blanked, -1) {
+		if depths[match[0]] != 1 {
+			continue
+		}
+		summary.Events = append(summary.Events, string(blanked[match[2]:match[3]]))
+	}
+
+	// Import paths are string literals, which are blanked, so imports are matched in code
+	// and skipped if their import keyword is blanked, i.e. in a comment or a string
+	for _, match := range declaredImport.FindAllSubmatchIndex(code, -1) {
+		keyword := match[0] + bytes.Index(code[match[0]:match[1]], []byte("import"))
+		if blanked[keyword] != code[keyword] {
+			continue
+		}
+		summary.Imports[string(code[match[2]:match[3]])] = strings.Trim(string(code[match[4]:match[5]]), `"`)
+	}
+
+	return summary, nil
+}
+
+// String returns the summary in a human-readable form.
+func (s ContractSummary) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "contract %s\n", s.Name)
+	fmt.Fprintf(&b, "  resources: %s\n", strings.Join(s.Resources, ", "))
+	fmt.Fprintf(&b, "  events: %s\n", strings.Join(s.Events, ", "))
+
+	for _, name := range sortedKeys(s.Imports) {
+		fmt.Fprintf(&b, "  import %s from %s\n", name, s.Imports[name])
+	}
+
+	return b.String()
+}
+
+// blankCommentsAndStrings returns a copy of code with its comments and string literals
+// replaced by spaces, so the braces and keywords in them are ignored
+// and the offsets of everything else are unchanged
+func blankCommentsAndStrings(code []byte) []byte {
+	blanked := make([]byte, len(code))
+	copy(blanked, code)
+
+	blank := func(start, end int) {
+		for i := start; i < end; i++ {
+			if blanked[i] != '\n' {
+				blanked[i] = ' '
+			}
+		}
+	}
+
+	for i := 0; i < len(code); i++ {
+		switch {
+		case bytes.HasPrefix(code[i:], []byte("//")):
+			end := bytes.IndexByte(code[i:], '\n')
+			if end < 0 {
+				end = len(code) - i
+			}
+			blank(i, i+end)
+			i += end
+
+		case bytes.HasPrefix(code[i:], []byte("/*")):
+			// Block comments can be nested
+			start, depth := i, 0
+			for ; i < len(code); i++ {
+				if bytes.HasPrefix(code[i:], []byte("/*")) {
+					depth++
+					i++
+				} else if bytes.HasPrefix(code[i:], []byte("*/")) {
+					depth--
+					i++
+					if depth == 0 {
+						break
+					}
+				}
+			}
+			if i == len(code) {
+				// Unterminated, so it ends with the code
+				i--
+			}
+			blank(start, i+1)
+
+		case code[i] == '"':
+			start := i
+			for i++; i < len(code) && code[i] != '"' && code[i] != '\n'; i++ {
+				if code[i] == '\\' {
+					i++
+				}
+			}
+			blank(start+1, i)
+		}
+	}
+
+	return blanked
+}
+
+// braceDepths returns the number of braces open before each offset of code
+func braceDepths(code []byte) []int {
+	depths := make([]int, len(code)+1)
+
+	depth := 0
+	for i, c := range code {
+		depths[i] = depth
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		}
+	}
+	depths[len(code)] = depth
+
+	return depths
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
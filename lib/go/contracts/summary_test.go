@@ -0,0 +1,93 @@
+package contracts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Run("Should summarize a custom token", func(t *testing.T) {
+		contract := customToken(t, "100.0")
+
+		summary, err := contracts.Summarize(contract)
+		require.NoError(t, err)
+
+		assert.Equal(t, "UtilityCoin", summary.Name)
+		assert.Equal(t, []string{"Vault", "Administrator", "Minter", "Burner"}, summary.Resources)
+		assert.Contains(t, summary.Events, "TokensDeposited")
+		assert.Contains(t, summary.Events, "TokensMinted")
+		assert.Equal(t, "0x"+addrA, summary.Imports["FungibleToken"])
+		assert.Equal(t, "0x"+addrB, summary.Imports["MetadataViews"])
+
+		assert.Contains(t, summary.String(), "contract UtilityCoin\n")
+		assert.Contains(t, summary.String(), "import FungibleToken from 0x"+addrA+"\n")
+	})
+
+	t.Run("Should skip resource interfaces", func(t *testing.T) {
+		summary, err := contracts.Summarize(contracts.FungibleToken())
+		require.NoError(t, err)
+
+		assert.Equal(t, "FungibleToken", summary.Name)
+		assert.Equal(t, []string{"Vault"}, summary.Resources)
+		assert.Empty(t, summary.Imports)
+	})
+
+	t.Run("Should only list the contract's own declarations", func(t *testing.T) {
+		summary, err := contracts.Summarize([]byte(`
+			import FungibleToken from 0xFUNGIBLETOKENADDRESS
+			import MetadataViews from "./MetadataViews.cdc"
+
+			pub contract Nested {
+				/// pub resource Commented {}
+				pub event Deposited(note: String)
+
+				pub resource Outer {
+					pub resource Inner {}
+					pub event InnerEvent()
+
+					pub fun note(): String {
+						return "pub resource InString { }"
+					}
+				}
+
+				/* pub event Commented() { /* nested */ } */
+				pub resource Last {}
+			}
+		`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "Nested", summary.Name)
+		assert.Equal(t, []string{"Outer", "Last"}, summary.Resources)
+		assert.Equal(t, []string{"Deposited"}, summary.Events)
+		assert.Equal(t, map[string]string{
+			"FungibleToken": "0xFUNGIBLETOKENADDRESS",
+			"MetadataViews": "./MetadataViews.cdc",
+		}, summary.Imports)
+	})
+
+	t.Run("Should ignore imports in comments", func(t *testing.T) {
+		summary, err := contracts.Summarize([]byte(`
+			import FungibleToken from 0xFUNGIBLETOKENADDRESS
+			/*
+			import MetadataViews from "./MetadataViews.cdc"
+			*/
+			// import NonFungibleToken from 0xNONFUNGIBLETOKENADDRESS
+
+			pub contract Commented {}
+		`))
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]string{
+			"FungibleToken": "0xFUNGIBLETOKENADDRESS",
+		}, summary.Imports)
+	})
+
+	t.Run("Should fail for code without a contract", func(t *testing.T) {
+		_, err := contracts.Summarize([]byte(`transaction {}`))
+		assert.Error(t, err)
+	})
+}
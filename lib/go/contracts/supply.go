@@ -0,0 +1,64 @@
+package contracts
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser2"
+)
+
+// ParseInitialSupply returns the literal the contract's initializer assigns to totalSupply,
+// e.g. the initialBalance passed to CustomToken.
+//
+// An error is returned if the code does not declare a contract whose initializer
+// assigns a fixed-point literal to self.totalSupply, e.g. because it computes the supply.
+func ParseInitialSupply(code []byte) (string, error) {
+	program, err := parser2.ParseProgram(string(code), nil)
+	if err != nil {
+		return "", err
+	}
+
+	contract := program.SoleContractDeclaration()
+	if contract == nil {
+		return "", fmt.Errorf("code does not declare a contract")
+	}
+
+	for _, initializer := range contract.Members.Initializers() {
+		block := initializer.FunctionDeclaration.FunctionBlock
+		if block == nil || block.Block == nil {
+			continue
+		}
+
+		for _, statement := range block.Block.Statements {
+			assignment, ok := statement.(*ast.AssignmentStatement)
+			if !ok || !isSelfMember(assignment.Target, "totalSupply") {
+				continue
+			}
+
+			literal, ok := assignment.Value.(*ast.FixedPointExpression)
+			if !ok {
+				return "", fmt.Errorf("contract %s does not initialize totalSupply with a literal", contract.Identifier.Identifier)
+			}
+
+			if literal.Negative {
+				return "-" + literal.PositiveLiteral, nil
+			}
+
+			return literal.PositiveLiteral, nil
+		}
+	}
+
+	return "", fmt.Errorf("contract %s does not initialize totalSupply", contract.Identifier.Identifier)
+}
+
+// isSelfMember reports whether the expression is self.<name>
+func isSelfMember(expression ast.Expression, name string) bool {
+	member, ok := expression.(*ast.MemberExpression)
+	if !ok || member.Identifier.Identifier != name {
+		return false
+	}
+
+	self, ok := member.Expression.(*ast.IdentifierExpression)
+
+	return ok && self.Identifier.Identifier == "self"
+}
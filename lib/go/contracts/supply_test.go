@@ -0,0 +1,49 @@
+package contracts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+func TestParseInitialSupply(t *testing.T) {
+	supply, err := contracts.ParseInitialSupply(customToken(t, "2500.5"))
+	require.NoError(t, err)
+	assert.Equal(t, "2500.5", supply)
+
+	supply, err = contracts.ParseInitialSupply(contracts.ExampleTokenWithMetadataViews(addrA, addrB))
+	require.NoError(t, err)
+	assert.Equal(t, "1000.0", supply)
+
+	t.Run("Should read the supply of a fixed supply token", func(t *testing.T) {
+		supply, err := contracts.ParseInitialSupply(customToken(t, "100.0", contracts.FixedSupply()))
+		require.NoError(t, err)
+		assert.Equal(t, "100.0", supply)
+	})
+
+	t.Run("Should error if the supply isn't a literal", func(t *testing.T) {
+		code := []byte(`
+			pub contract FixedCoin {
+				pub var totalSupply: UFix64
+
+				init(initialSupply: UFix64) {
+					self.totalSupply = initialSupply
+				}
+			}
+		`)
+
+		_, err := contracts.ParseInitialSupply(code)
+		assert.EqualError(t, err, "contract FixedCoin does not initialize totalSupply with a literal")
+	})
+
+	t.Run("Should error if the supply isn't initialized", func(t *testing.T) {
+		_, err := contracts.ParseInitialSupply([]byte(`pub contract NoSupply { init() {} }`))
+		assert.EqualError(t, err, "contract NoSupply does not initialize totalSupply")
+
+		_, err = contracts.ParseInitialSupply([]byte(`pub struct NotAContract {}`))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,91 @@
+package contracts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/parser2"
+)
+
+// CheckUpgradeCompatibility reports whether newCode can replace oldCode
+// as the code of a deployed token contract without changing the fields
+// of its Vault resource, which Cadence rejects when the contract is updated.
+//
+// The returned error lists every field of the Vault that was removed, retyped or added.
+// Other changes, e.g. new events or functions, are not checked.
+func CheckUpgradeCompatibility(oldCode, newCode []byte) error {
+	oldVault, err := parseVault(oldCode)
+	if err != nil {
+		return fmt.Errorf("old code: %w", err)
+	}
+
+	newVault, err := parseVault(newCode)
+	if err != nil {
+		return fmt.Errorf("new code: %w", err)
+	}
+
+	oldFields := oldVault.Members.FieldsByIdentifier()
+	newFields := newVault.Members.FieldsByIdentifier()
+
+	var changes []string
+
+	for _, name := range sortedFieldNames(oldFields) {
+		newField, ok := newFields[name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("field %s was removed", name))
+			continue
+		}
+
+		oldType := oldFields[name].TypeAnnotation.Type.String()
+		newType := newField.TypeAnnotation.Type.String()
+		if oldType != newType {
+			changes = append(changes, fmt.Sprintf("field %s changed type from %s to %s", name, oldType, newType))
+		}
+	}
+
+	for _, name := range sortedFieldNames(newFields) {
+		if _, ok := oldFields[name]; !ok {
+			changes = append(changes, fmt.Sprintf("field %s was added", name))
+		}
+	}
+
+	if len(changes) > 0 {
+		return fmt.Errorf("incompatible Vault changes:\n\t%s", strings.Join(changes, "\n\t"))
+	}
+
+	return nil
+}
+
+// parseVault returns the declaration of the Vault resource
+// nested in the contract declared by the given code
+func parseVault(code []byte) (*ast.CompositeDeclaration, error) {
+	program, err := parser2.ParseProgram(string(code), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	contract := program.SoleContractDeclaration()
+	if contract == nil {
+		return nil, fmt.Errorf("code does not declare a contract")
+	}
+
+	vault, ok := contract.Members.CompositesByIdentifier()["Vault"]
+	if !ok || vault.CompositeKind != common.CompositeKindResource {
+		return nil, fmt.Errorf("contract %s does not declare a Vault resource", contract.Identifier.Identifier)
+	}
+
+	return vault, nil
+}
+
+func sortedFieldNames(fields map[string]*ast.FieldDeclaration) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,51 @@
+package contracts_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-ft/lib/go/contracts"
+)
+
+func TestCheckUpgradeCompatibility(t *testing.T) {
+	oldCode := contracts.ExampleTokenWithMetadataViews(addrA, addrB)
+
+	t.Run("Should allow an additive change", func(t *testing.T) {
+		newCode := strings.Replace(
+			string(oldCode),
+			"    pub event TokensInitialized(initialSupply: UFix64)\n",
+			"    pub event TokensInitialized(initialSupply: UFix64)\n\n    pub event TokensFrozen(amount: UFix64)\n",
+			1,
+		)
+		require.NotEqual(t, string(oldCode), newCode)
+
+		assert.NoError(t, contracts.CheckUpgradeCompatibility(oldCode, []byte(newCode)))
+	})
+
+	t.Run("Should reject a renamed field", func(t *testing.T) {
+		newCode := strings.ReplaceAll(string(oldCode), "self.balance", "self.amount")
+		newCode = strings.Replace(newCode, "pub var balance: UFix64", "pub var amount: UFix64", 1)
+
+		err := contracts.CheckUpgradeCompatibility(oldCode, []byte(newCode))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "field balance was removed")
+		assert.Contains(t, err.Error(), "field amount was added")
+	})
+
+	t.Run("Should reject a retyped field", func(t *testing.T) {
+		newCode := strings.Replace(string(oldCode), "pub var balance: UFix64", "pub var balance: UInt64", 1)
+
+		err := contracts.CheckUpgradeCompatibility(oldCode, []byte(newCode))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "field balance changed type from UFix64 to UInt64")
+	})
+
+	t.Run("Should report code that doesn't parse", func(t *testing.T) {
+		err := contracts.CheckUpgradeCompatibility(oldCode, []byte("pub contract {"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "new code")
+	})
+}
@@ -0,0 +1,61 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// Recipient is an account receiving an amount of tokens in a batch transfer
+type Recipient struct {
+	Address flow.Address
+	Amount  cadence.UFix64
+}
+
+// ChunkRecipients splits recipients into consecutive chunks of at most maxPerTx recipients,
+// so a large batch transfer can be submitted as several transactions
+// created by GenerateTransferManyAccountsScript.
+//
+// The order of the recipients is preserved.
+// An error is returned if maxPerTx isn't positive.
+func ChunkRecipients(recipients []Recipient, maxPerTx int) ([][]Recipient, error) {
+	if maxPerTx <= 0 {
+		return nil, fmt.Errorf("maxPerTx must be positive, got %d", maxPerTx)
+	}
+
+	chunks := make([][]Recipient, 0, (len(recipients)+maxPerTx-1)/maxPerTx)
+	for start := 0; start < len(recipients); start += maxPerTx {
+		end := start + maxPerTx
+		if end > len(recipients) {
+			end = len(recipients)
+		}
+
+		chunks = append(chunks, recipients[start:end])
+	}
+
+	return chunks, nil
+}
+
+// TransferManyAccountsArgument returns the address to amount dictionary
+// the transaction created by GenerateTransferManyAccountsScript takes as its argument.
+//
+// An address can only be a key once, so an error is returned if recipients contains an address twice.
+func TransferManyAccountsArgument(recipients []Recipient) (cadence.Dictionary, error) {
+	seen := make(map[flow.Address]bool, len(recipients))
+	pairs := make([]cadence.KeyValuePair, 0, len(recipients))
+
+	for _, recipient := range recipients {
+		if seen[recipient.Address] {
+			return cadence.Dictionary{}, fmt.Errorf("duplicate recipient %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		pairs = append(pairs, cadence.KeyValuePair{
+			Key:   cadence.NewAddress(recipient.Address),
+			Value: recipient.Amount,
+		})
+	}
+
+	return cadence.NewDictionary(pairs), nil
+}
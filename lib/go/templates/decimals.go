@@ -0,0 +1,44 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+)
+
+// DefaultDisplayDecimals is the number of decimal places of a UFix64 value,
+// which amounts are displayed with unless a token declares otherwise
+const DefaultDisplayDecimals uint8 = 8
+
+// DisplayDecimals returns the number of decimal places to display amounts of a token with,
+// given the result of the script created by GenerateGetDisplayDecimalsScript.
+//
+// DefaultDisplayDecimals is returned if the token doesn't declare a DisplayDecimals view.
+func DisplayDecimals(result cadence.Value) (uint8, error) {
+	if optional, ok := result.(cadence.Optional); ok {
+		if optional.Value == nil {
+			return DefaultDisplayDecimals, nil
+		}
+		result = optional.Value
+	}
+
+	view, ok := result.(cadence.Struct)
+	if !ok {
+		return 0, fmt.Errorf("expected a DisplayDecimals view, got %T", result)
+	}
+
+	for i, field := range view.StructType.Fields {
+		if field.Identifier != "decimals" {
+			continue
+		}
+
+		decimals, ok := view.Fields[i].(cadence.UInt8)
+		if !ok {
+			return 0, fmt.Errorf("expected the decimals field to be a UInt8, got %T", view.Fields[i])
+		}
+
+		return uint8(decimals), nil
+	}
+
+	return 0, fmt.Errorf("%s has no decimals field", view.StructType.QualifiedIdentifier)
+}
@@ -0,0 +1,70 @@
+package templates
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/onflow/cadence"
+)
+
+// FungibleTokenFeatures are the features a deployed FungibleToken interface declares.
+type FungibleTokenFeatures struct {
+	// Provider, Receiver and Balance report whether it declares the resource interface of that name
+	Provider bool
+	Receiver bool
+	Balance  bool
+	// Transfer reports whether it declares a transfer function
+	Transfer bool
+	// SupportedVaultTypes reports whether it declares getSupportedVaultTypes,
+	// which receivers use to list the Vault types they accept
+	SupportedVaultTypes bool
+	// Entitlements reports whether it declares entitlements,
+	// i.e. whether it is written for Cadence 1.0
+	Entitlements bool
+}
+
+var (
+	providerInterface      = regexp.MustCompile(`resource\s+interface\s+Provider\b`)
+	receiverInterface      = regexp.MustCompile(`resource\s+interface\s+Receiver\b`)
+	balanceInterface       = regexp.MustCompile(`resource\s+interface\s+Balance\b`)
+	transferFunction       = regexp.MustCompile(`fun\s+transfer\s*\(`)
+	supportedVaultTypes    = regexp.MustCompile(`fun\s+getSupportedVaultTypes\s*\(`)
+	entitlementDeclaration = regexp.MustCompile(`(?m)^\s*(?:access\(all\)\s+)?entitlement\s+\w+`)
+)
+
+// ParseFungibleTokenFeatures returns the features of the FungibleToken interface
+// an account hosts, given the result of the script created by GenerateGetFungibleTokenCodeScript.
+//
+// The features are read from the declarations in the deployed code,
+// and an error is returned if the account doesn't host a FungibleToken contract.
+func ParseFungibleTokenFeatures(result cadence.Value) (FungibleTokenFeatures, error) {
+	if optional, ok := result.(cadence.Optional); ok {
+		if optional.Value == nil {
+			return FungibleTokenFeatures{}, fmt.Errorf("account does not host a FungibleToken contract")
+		}
+		result = optional.Value
+	}
+
+	array, ok := result.(cadence.Array)
+	if !ok {
+		return FungibleTokenFeatures{}, fmt.Errorf("expected the code as a [UInt8], got %T", result)
+	}
+
+	code := make([]byte, len(array.Values))
+	for i, value := range array.Values {
+		b, ok := value.(cadence.UInt8)
+		if !ok {
+			return FungibleTokenFeatures{}, fmt.Errorf("expected the code as a [UInt8], got an element of type %T", value)
+		}
+		code[i] = byte(b)
+	}
+
+	return FungibleTokenFeatures{
+		Provider:            providerInterface.Match(code),
+		Receiver:            receiverInterface.Match(code),
+		Balance:             balanceInterface.Match(code),
+		Transfer:            transferFunction.Match(code),
+		SupportedVaultTypes: supportedVaultTypes.Match(code),
+		Entitlements:        entitlementDeclaration.Match(code),
+	}, nil
+}
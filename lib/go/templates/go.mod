@@ -4,5 +4,7 @@ go 1.16
 
 require (
 	github.com/kevinburke/go-bindata v3.22.0+incompatible
+	github.com/onflow/cadence v0.15.0
 	github.com/onflow/flow-go-sdk v0.20.0
+	github.com/stretchr/testify v1.7.0
 )
@@ -0,0 +1,84 @@
+package templates
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// VaultTypeIdentifier returns the Cadence type identifier of the Vault resource
+// of the token deployed at the specified address,
+// e.g. A.0ae53cb6e3f42a79.ExampleToken.Vault
+//
+// The address may have a 0x prefix and is normalized the same
+// way Cadence formats addresses in type identifiers.
+// An error is returned if it isn't a valid, non-empty address.
+func VaultTypeIdentifier(tokenAddr, tokenName string) (string, error) {
+	address, err := parseAddress(tokenAddr)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("A.%s.%s.Vault", address, tokenName), nil
+}
+
+// parseAddress parses a hex address with or without a 0x prefix.
+//
+// Unlike flow.HexToAddress, which returns the empty address for invalid input,
+// it returns an error for invalid input and for the empty address.
+func parseAddress(hexAddr string) (flow.Address, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(hexAddr, "0x"), "0X")
+	if len(trimmed)%2 == 1 {
+		trimmed = "0" + trimmed
+	}
+
+	b, err := hex.DecodeString(trimmed)
+	if err != nil {
+		return flow.EmptyAddress, fmt.Errorf("invalid address %q: %w", hexAddr, err)
+	}
+
+	if len(b) > flow.AddressLength {
+		return flow.EmptyAddress, fmt.Errorf("invalid address %q: longer than %d bytes", hexAddr, flow.AddressLength)
+	}
+
+	address := flow.BytesToAddress(b)
+	if address == flow.EmptyAddress {
+		return flow.EmptyAddress, fmt.Errorf("invalid address %q: empty address", hexAddr)
+	}
+
+	return address, nil
+}
+
+// tokenEventNames are the events the ExampleToken contract declares
+var tokenEventNames = []string{
+	"TokensInitialized",
+	"TokensWithdrawn",
+	"TokensDeposited",
+	"TokensMinted",
+	"TokensBurned",
+	"MinterCreated",
+	"BurnerCreated",
+}
+
+// EventTypeIdentifiers returns the Cadence type identifier of each event
+// the token deployed at the specified address emits, keyed by event name,
+// e.g. TokensDeposited: A.0ae53cb6e3f42a79.ExampleToken.TokensDeposited
+//
+// customEvents are added to the events ExampleToken declares,
+// e.g. the event a CustomToken declares for a deposit event field.
+// The address is normalized and validated as it is by VaultTypeIdentifier.
+func EventTypeIdentifiers(tokenAddr, tokenName string, customEvents ...string) (map[string]string, error) {
+	address, err := parseAddress(tokenAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers := make(map[string]string, len(tokenEventNames)+len(customEvents))
+	for _, name := range append(append([]string{}, tokenEventNames...), customEvents...) {
+		identifiers[name] = fmt.Sprintf("A.%s.%s.%s", address, tokenName, name)
+	}
+
+	return identifiers, nil
+}
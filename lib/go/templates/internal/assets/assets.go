@@ -1,18 +1,43 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
 // ../../../transactions/burn_tokens.cdc (1.446kB)
+// ../../../transactions/burn_tokens_with_reason.cdc (1.78kB)
+// ../../../transactions/consolidate_vaults.cdc (1.534kB)
 // ../../../transactions/create_forwarder.cdc (2.176kB)
+// ../../../transactions/create_secondary_vault.cdc (801B)
+// ../../../transactions/delegated_mint.cdc (1.805kB)
+// ../../../transactions/deploy_contract.cdc (345B)
+// ../../../transactions/destroy_vault_at_path.cdc (835B)
+// ../../../transactions/distribute_initial_supply.cdc (2.073kB)
+// ../../../transactions/issue_minter_capability.cdc (1.428kB)
 // ../../../transactions/mint_tokens.cdc (1.741kB)
 // ../../../transactions/privateForwarder/create_account_private_forwarder.cdc (1.488kB)
 // ../../../transactions/privateForwarder/create_private_forwarder.cdc (1.021kB)
 // ../../../transactions/privateForwarder/deploy_forwarder_contract.cdc (403B)
 // ../../../transactions/privateForwarder/setup_and_create_forwarder.cdc (1.882kB)
 // ../../../transactions/privateForwarder/transfer_private_many_accounts.cdc (1.204kB)
+// ../../../transactions/publish_receiver.cdc (930B)
+// ../../../transactions/revoke_minter_capability.cdc (623B)
+// ../../../transactions/scripts/get_FT.cdc (4.282kB)
+// ../../../transactions/scripts/get_account_is_setup.cdc (536B)
+// ../../../transactions/scripts/get_aggregate_balance.cdc (1.411kB)
 // ../../../transactions/scripts/get_balance.cdc (504B)
+// ../../../transactions/scripts/get_contract_exists.cdc (311B)
+// ../../../transactions/scripts/get_display_decimals.cdc (1.075kB)
+// ../../../transactions/scripts/get_display_metadata.cdc (1.496kB)
+// ../../../transactions/scripts/get_dust_vaults.cdc (1.314kB)
+// ../../../transactions/scripts/get_fungible_token_code.cdc (434B)
 // ../../../transactions/scripts/get_supply.cdc (249B)
-// ../../../transactions/setup_account.cdc (1.307kB)
+// ../../../transactions/scripts/get_supply_invariant.cdc (1.062kB)
+// ../../../transactions/self_transfer.cdc (1.219kB)
+// ../../../transactions/set_display.cdc (951B)
+// ../../../transactions/set_minter_allowance.cdc (1.287kB)
+// ../../../transactions/setup_account.cdc (1.477kB)
+// ../../../transactions/transfer_admin.cdc (1.062kB)
 // ../../../transactions/transfer_many_accounts.cdc (1.384kB)
 // ../../../transactions/transfer_tokens.cdc (1.424kB)
+// ../../../transactions/transfer_tokens_with_memo.cdc (1.544kB)
+// ../../../transactions/update_contract.cdc (412B)
 
 package assets
 
@@ -82,7 +107,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _burn_tokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\xc1\x6e\xd4\x40\x0c\xbd\xe7\x2b\x4c\x0e\x68\x7b\x68\x02\x12\xe2\xb0\xea\x52\xda\x8a\x1e\x11\xa2\x0b\x9c\x27\x13\x67\x33\x90\xcc\x44\x1e\x87\xdd\x55\xd5\x7f\x47\xe3\x49\xb2\x09\xed\x36\x87\x46\x5d\xdb\xcf\xef\x3d\xdb\xc9\x73\xd8\xd6\xc6\x03\x93\xb2\x5e\x69\x36\xce\x82\xf1\xa0\x80\xb1\xed\x1a\xc5\x08\x95\xa3\xf0\xef\x2c\xce\xb5\xe2\x24\xcf\x41\xbb\xbe\x29\xa1\x40\xe8\x3d\x96\x50\x1c\x81\x6b\x04\x55\xb6\xc6\x82\xd2\xda\xf5\x96\x81\x1d\x14\x3d\x59\x60\xf7\x07\xad\x0f\x45\x15\xb9\x36\x24\x1a\x02\xcf\x8e\xb0\x84\x9f\xaa\x6f\x02\x5e\x22\x5c\x50\x0a\x8c\xdd\x81\x6a\x05\x62\x3f\x76\x51\xd0\x29\x52\x2d\x32\x52\xc0\x0d\xcd\x66\xac\x92\xc4\xb4\x9d\x23\x86\xfb\xde\xee\x4c\xd1\xe0\x36\xb4\x8c\xed\xd2\x2c\xcb\xb5\xb3\x4c\x4a\xb3\xcf\x17\x09\x99\x2e\x75\x3a\x96\x7e\x39\xa8\xb6\x7b\xa5\x72\x1e\x8f\x85\xc9\x8c\xc1\x2a\x12\x5e\xc3\x8f\x7b\x73\xf8\xf8\xe1\x02\x1e\x93\x04\x00\x20\xcf\xf3\xa8\x11\x08\xbd\xeb\x49\xa3\x38\x08\xb5\x6b\x4a\x1f\x65\x88\x3b\xf1\x57\x45\x08\x05\x06\xfd\xc1\x07\x2c\x05\xa2\x41\x86\xbf\x01\x62\x0d\x9f\x97\xf4\xa3\x79\x53\x9f\xef\x58\x21\xa1\x0d\x2d\xa2\x43\x0b\x49\x37\x32\x1b\x57\xfc\x46\xcd\x13\xae\x0c\x6c\x0d\x6f\x17\xe2\x24\xd3\x78\x26\xc5\x8e\x4e\xf0\x5b\x21\xcb\xaa\x01\xdf\x77\x5d\x73\x04\x57\x8d\xe4\x0b\xac\x1c\xa1\xf4\x0c\xc4\x27\xf8\x98\x78\x2b\xd1\xd1\x9a\x08\xd8\x11\x76\x8a\x70\xe5\xcd\xce\x22\xad\xe1\xa6\xe7\xfa\x26\xee\xcd\xe4\x5d\x78\x3c\x36\x55\x36\x87\x81\xcd\x42\x56\x26\x8c\x1e\x24\xe1\x54\x95\xe7\xf0\xcb\x70\x5d\x92\xda\xc3\xfb\x77\x23\xcb\x71\xfb\x86\x35\x15\x4f\xc1\x58\x59\x45\xb5\xc3\x65\xcf\x18\xbd\xba\x84\xc8\x30\x2b\x1c\x91\xdb\x5f\x2d\x9d\x92\x01\x7c\x5a\x05\xe0\x35\x3c\x8f\x3c\x44\xe0\x6f\x8a\xeb\x8b\x37\x13\x7c\x78\xb2\xfd\x40\x6f\xda\x9b\xf8\xbe\x58\x68\xb8\x23\x0c\x47\xa8\x80\xfe\x9f\xec\x70\x68\xf2\x77\x5a\xac\x73\x52\x62\xda\xe6\x55\x25\x8b\x99\xbf\xa8\x48\x32\xe6\x8a\x16\x82\xae\xaf\xa1\x53\xd6\xe8\x55\x7a\x27\x27\x6b\x1d\x43\x6c\x74\x9e\xfe\x48\x3c\x8d\x50\x4f\x51\x3b\x1e\x50\xf7\x8c\xf0\x38\xe1\x87\x4d\x92\x7b\x20\x99\xc7\x24\x29\xd3\xe2\xcf\x57\xdc\xdf\x4a\x74\x35\x73\x2f\xe6\x67\xe1\x25\xf4\xfd\x20\xe9\xea\xf2\x34\xdd\x59\x7a\x89\x9e\xc9\x1d\x87\xb2\x39\x9d\xce\x79\x9e\x71\x39\xb7\x7b\xb0\xd9\xbc\xb0\xab\x97\x30\x8e\x37\x7d\x76\x3d\x6d\xef\x39\x7c\xd9\x4a\x0c\x32\xe6\x1f\x51\x29\x49\x07\x12\x4f\xc9\xbf\x00\x00\x00\xff\xff\x80\x74\x15\xd4\xa6\x05\x00\x00"
+var _burn_tokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\xc1\x6e\xdb\x38\x10\xbd\xeb\x2b\xde\xfa\xb0\x70\x0e\x96\x76\x81\xc5\x1e\x0c\xbb\x69\x12\x34\xc7\xa2\x68\xd2\xf6\x4c\x49\x63\x8b\xad\x44\x0a\xc3\x51\x9d\x20\xc8\xbf\x17\x24\x25\x99\x6a\x93\x00\x84\x05\x78\x66\xde\xbc\xf7\x66\xc8\xa2\xc0\x7d\xa3\x1d\x84\x95\x71\xaa\x12\x6d\x0d\xb4\x83\x82\x50\xd7\xb7\x4a\x08\x07\xcb\x50\x8b\xb8\x34\x4a\xb2\xa2\x40\x65\x87\xb6\x46\x49\x18\x1c\xd5\x28\x1f\x21\x0d\x41\xd5\x9d\x36\x50\x55\x65\x07\x23\x10\x8b\x72\x60\x03\xb1\x3f\xc8\x38\x5f\x74\x60\xdb\xf9\x44\xcd\x70\x62\x99\x6a\x7c\x55\x43\xeb\xf1\x7c\xf4\xbe\xa1\x50\xa0\xcd\x11\xaa\x0b\x10\xa7\xa9\x8b\x42\xaf\x58\x75\x24\xc4\x1e\xd7\x37\x4b\x58\x65\x99\xee\x7a\xcb\x82\xdb\xc1\x1c\x75\xd9\xd2\xbd\x6f\x19\xdb\xad\xf2\xbc\xa8\xac\x11\x56\x95\xb8\x62\x91\x90\x57\x75\xb5\x9a\x4a\x3f\x3c\xa8\xae\x7f\xa3\x32\x8d\xc7\xc2\x2c\x61\xb0\x8e\x84\xb7\xf8\x72\xab\x1f\xfe\xff\xef\x02\x4f\x59\x06\x00\x45\x51\x44\x8d\x60\x72\x76\xe0\x8a\x82\x83\x68\x6c\x5b\x3b\x6f\xc5\xe8\x4e\xfc\x57\x31\xa1\x24\xaf\xdf\xfb\x40\x75\x80\x68\x49\xf0\xd3\x43\x6c\xf1\x7e\x49\x3f\x00\x9f\xfb\x7c\xa6\x03\x31\x19\xdf\x22\x3a\x94\x52\xc6\x55\x98\x8d\x2d\xbf\x53\x25\x33\x6e\x18\xd8\x16\x7f\xa7\x99\x79\xc8\xd4\x4e\x58\x89\xe5\x33\xbc\x1f\x8f\x58\x51\x2d\xdc\xd0\xf7\xed\x23\xec\x61\x22\x5f\xd2\xc1\xb2\x57\x16\x07\x38\xc3\xc7\xc4\xeb\x10\x9d\xac\x89\x80\x3d\x53\xaf\x98\xd6\x4e\x1f\x0d\xf1\x16\x57\x83\x34\x57\x71\x6f\x66\xef\xfc\x71\xd4\x1e\xf2\x14\x06\xfb\x85\xac\x3c\x30\xba\x0b\x09\xe7\xaa\xa2\xc0\x37\x2d\x4d\xcd\xea\x84\x7f\xff\x99\x58\x4e\xdb\x37\xae\x69\xf0\x14\xda\x84\x55\x54\x47\x5a\xf6\x8c\xd1\xdd\x06\x91\x61\x5e\x5a\x66\x7b\xda\x2d\x9d\x0a\x03\x78\xb7\xf6\xc0\xdb\x25\xad\x10\xb9\x8b\xc0\x9f\x94\x34\x17\x7f\xcd\xf0\xfe\xe4\xa7\x91\xde\xbc\x37\xf1\x7b\xb1\xd0\x70\xc3\xe4\x2f\xa1\x02\xff\x3e\xd9\xf1\xa2\x85\xdf\x79\xb1\x5e\x93\x12\x93\xf7\x6f\x2a\x59\xcc\xfc\x45\x45\x21\x23\x55\x34\x37\xf1\xe7\xf2\x12\xbd\x32\xba\x5a\xaf\x6e\xc2\x95\x35\x56\x10\x1b\xbd\x4e\x7f\x22\xbe\x8a\x50\xcf\x51\x3b\x3d\x50\x35\x08\xe1\x69\xc6\xf7\x17\xc0\xaf\x15\x31\x76\x9b\x44\x52\x5e\x05\x7f\x3e\xd2\xe9\x3a\x44\xd7\x89\x7b\x31\x3f\xf7\x9f\x30\x10\x37\x4a\xda\x6d\xce\xd3\x4d\xd2\x6b\x72\xc2\xf6\x71\x6c\x93\xd2\xe9\xad\x93\x84\xcb\x6b\xbb\x87\xfd\xfe\x85\x5d\xdd\x8c\xef\xd8\x16\xab\x3f\x6e\x4f\x37\x38\xf1\x2f\x5b\x4d\x5e\x46\xfa\x88\x86\x92\x55\x06\x00\xcf\xd9\x73\xf6\x6b\x00\x80\x74\x15\xd4\xa6\x05\x00\x00"
 
 func burn_tokensCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -102,7 +127,47 @@ func burn_tokensCdc() (*asset, error) {
 	return a, nil
 }
 
-var _create_forwarderCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x5d\x6b\x2b\x37\x10\x7d\xd7\xaf\x18\xf2\xd0\x3a\xc1\x59\xd3\xaf\x17\x93\x16\x42\xda\x94\x42\x29\x97\x36\xed\x6b\xef\x58\x1a\x5b\x6a\x76\xa5\x45\x9a\xf5\x5e\x13\xf2\xdf\x8b\xa4\x5d\x59\x1b\x42\xec\x27\xaf\x34\x1f\xe7\xcc\x39\x9a\xcd\xcd\x8d\x10\x4f\xda\x04\x60\x8f\x36\xa0\x64\xe3\x2c\x98\x00\x08\x4c\x5d\xdf\x22\x13\xec\x9d\x8f\x9f\xd5\x3d\x6b\x64\x90\x6e\x68\x15\xec\x08\x86\x40\x4a\xb0\x83\x40\x0c\x43\x0f\x68\x01\xa5\x74\x83\x65\x60\x17\x93\x47\xf4\x0a\x14\xf5\x2e\x18\x26\x05\xec\x9e\xc9\x86\x78\x87\xd6\xb1\x26\x0f\x9e\x24\x99\x23\xf9\x46\x88\xdf\xf6\x80\xf6\xe4\x2c\x41\x20\xab\x42\x1d\x1c\xfb\xf8\xaf\x03\x3c\xe6\x8a\xe4\xe1\xcf\x29\x6f\x2d\x58\x53\xf9\x82\xd1\xb4\x2d\xfc\x37\x04\x2e\xcd\x59\xbb\x40\x55\xad\x18\xfe\x0f\x0e\x2d\x67\x26\x1a\x03\xec\x88\xac\x88\x0c\x30\xa4\x6b\x4f\xd2\xf4\x86\x2c\x03\x5a\x05\xd4\x99\xf8\x07\xe8\x18\x4f\x52\x92\xb1\xca\x48\x64\x0a\x62\xd4\x46\xea\x84\x6e\x6e\x18\x59\xea\xb9\x61\x33\x0d\x78\xc4\xd3\x1a\x4c\xe4\x07\x6e\xbf\xbf\x95\x1a\x8d\x85\x40\xfe\x68\x24\xc1\x88\x96\x13\xb4\xce\x59\xc3\xce\xc3\xa8\x5d\x94\x61\x2a\x68\xec\x41\x9c\xe1\x1b\x5e\x83\x61\x90\x68\x61\x44\x96\x3a\xc3\x4a\x57\x81\x08\x46\x4d\x9e\x2a\x00\x20\xb1\x23\xd8\x7b\xd7\x35\x42\xfc\xc5\xd4\x4f\x91\x59\xad\x2c\x55\x80\xd1\xb0\xce\x09\x85\x85\xdf\x0a\xf1\x4d\x03\x4f\x9a\xe0\x71\xb0\x07\xb3\x6b\x09\x9e\x52\x84\x74\x96\x3d\xca\x38\x05\x26\xbf\x47\x49\x10\x74\xf2\x03\xb6\x9e\x50\x9d\xa2\x2f\x14\xf5\xad\x3b\x91\x82\xe0\x3a\x4a\xa0\xc4\xb7\xb9\x1a\xf6\x7d\x6b\x24\xc6\x7a\xbc\xac\x37\x55\xa9\xb2\x1b\xf1\x5d\x4e\xaa\x14\x99\xec\x35\x05\x6b\x3c\x12\xe0\x24\x68\x34\x2b\x27\x3f\xe7\xc2\x9e\x90\x49\x09\x00\x48\x42\x06\x76\x9e\x14\x18\x0b\x86\x43\xfa\xc2\x03\x65\xee\x08\xfd\xb0\x6b\x4d\xd0\xa4\x8a\x97\xc4\xf7\x0d\xfc\x9c\x80\xa4\x79\x7e\x4e\xec\x1f\x8b\x26\x8d\x54\xf2\xf3\x19\x7c\x72\xa9\x32\xfb\x3d\xf9\x0a\xa6\xf8\xa1\x89\x9e\x05\x04\x4b\x23\xdc\xe7\xc3\x2d\x3c\x24\x64\xa9\xec\xcc\xc7\x3a\xdf\x61\xdb\x9e\xd6\x09\x2e\x6b\xb2\xe0\x07\x9b\x3b\x67\x22\xff\x16\x69\x72\xeb\xea\x51\xe6\xa4\x03\x31\x1b\x7b\x80\xc5\x83\x88\xd2\x2f\x1a\x65\x03\xbf\x31\x7a\x23\x6e\x36\x42\x98\xae\x77\x9e\x8b\xde\x59\xee\x54\xe0\xaa\x69\x36\x33\xd5\xb0\x59\x04\x44\x30\x57\x73\xea\x2f\x5f\xb0\xeb\x3f\xc8\xac\xef\x17\x89\x6f\x86\xfb\x5e\xee\xc0\xa6\x35\x7c\x7a\x28\x07\xef\x08\x72\x25\x44\x35\x96\xd5\xbc\x5c\xb6\x70\xaf\x94\xa7\x10\xae\xe1\x45\xa4\x59\xf5\x9e\x7a\xf4\xb4\x42\x29\x79\x0b\xf7\x03\xeb\x49\x9c\x12\x11\x7f\x9b\x0d\xfc\x4a\x3c\x8f\x2a\x0f\x54\x62\x8f\xbb\x84\x64\xf2\xdb\x79\xb4\x3b\x4a\xd0\xcf\x7b\xc0\x95\x4a\x2d\x71\x65\xe2\x1f\xa3\x56\x53\xc3\x02\xf2\xba\x04\xc7\x5f\x73\x20\x7e\x28\xad\xee\xbe\x7a\x59\x0e\x7d\xd6\xf7\xf5\xa7\xd5\x62\xa6\xf3\xf9\xa7\x68\x67\xf9\x09\x59\x5f\x2f\xe8\x54\xce\x2b\x76\xca\x8f\x23\x3e\x24\xc3\xf3\x86\x7c\xeb\x16\xe5\x66\x67\x55\x5b\xa9\x26\x77\x4c\x2f\xf0\xee\xf6\xad\x92\x4d\x36\xef\x1f\x34\x96\xdd\xbd\x2a\x83\xd8\x9e\x67\x72\x66\x1f\x25\x69\x22\x9c\xd5\xdd\x6d\xaa\xba\x06\x76\x5b\xd8\x4c\x0f\x76\x43\x15\xdf\x52\x73\xc9\xf2\x6f\xdb\x1a\xfb\x9c\xe0\xd2\x17\x13\xd2\xab\x78\x47\xc0\x92\x12\x37\x73\xec\xba\x98\xf9\xc5\xc1\x36\x52\x93\x7c\xfe\x48\x9a\x68\xa6\x5a\xd4\xd4\x64\x48\xe0\x2e\xcb\x36\x27\xbd\x2e\xa8\xfd\x3e\x13\x8b\x0b\xe5\xac\xc5\x87\xf4\x52\xdb\xd8\xf4\x23\xac\x0b\xa0\x17\xc0\xad\x17\xc1\x8c\xfe\x40\x7c\x49\xa1\x92\x92\x99\xbd\x8a\xd7\xff\x03\x00\x00\xff\xff\xdf\x8c\x99\x8e\x80\x08\x00\x00"
+var _burn_tokens_with_reasonCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\x4d\x6f\xe3\x46\x0c\xbd\xeb\x57\x10\x3a\x14\x36\xe0\x48\x97\xa2\x07\x23\x4e\x90\x04\xcd\xb1\x28\x12\xb7\x7b\x1e\x49\xb4\x34\xbb\xd2\x8c\xc0\xa1\xec\x04\x41\xfe\xfb\x82\x1c\x5b\x96\x12\x6f\x2e\x0b\x08\x1e\x5b\xc3\x47\xf2\x3d\x7e\x38\xcf\x61\xdb\xd8\x00\x4c\xc6\x05\x53\xb2\xf5\x0e\x6c\x00\x03\x8c\x5d\xdf\x1a\x46\xd8\x79\x02\x33\xbb\xe7\xc6\x70\x92\xe7\x50\xfa\xa1\xad\xa0\x40\x18\x02\x56\x50\xbc\x02\x37\x08\xa6\xea\xac\x03\x53\x96\x7e\x70\x0c\xec\xa1\x18\xc8\x01\xfb\x1f\xe8\x82\x80\x76\xe4\x3b\x35\xfc\xdf\x0c\x2d\x43\x60\x4f\x58\x81\x61\x7d\x57\xdb\x3d\x3a\xe8\x0d\x37\x2b\x30\xae\x12\x38\x61\xe9\xa9\x12\xa4\x18\x10\x9a\xe0\x9d\xe6\x24\x3f\xd5\xb7\x84\x83\xad\x06\xb8\x1f\xc8\x61\xf5\xcd\x72\xf3\x14\x0d\x71\x8f\x8e\x57\x82\x56\x88\x1a\x41\x49\x68\x18\x2b\x38\x58\x6e\x34\xac\x00\x04\x7a\x04\xf9\x5e\x68\x26\x79\x2e\xb8\xed\x31\x8c\x75\x35\x98\x4e\x48\xad\x14\x23\x49\x82\xdf\x4d\xa8\x68\xc2\x63\x8e\x82\x3d\x9c\x04\xea\x0d\x99\x0e\x19\x29\x08\x25\x81\x4c\xf4\x4c\x12\xdb\xf5\x9e\x18\x1e\x07\x57\xdb\xa2\x45\xe5\x12\x85\x4a\xb3\x2c\x2f\xbd\x63\x32\x25\x87\x7c\x66\x90\x95\x55\x99\x9e\xa0\x7f\xbf\x98\xae\xff\x02\x39\xbd\x8f\xc0\x64\x92\xc1\x22\x12\x5b\xc3\x7f\x8f\xf6\xe5\xaf\x3f\x57\x5a\x81\x35\x3c\xb3\x27\x53\xe3\xbf\x5a\x8e\xa8\xbc\xbc\x24\xeb\xea\x25\xbc\x25\x09\x00\x40\x9e\xe7\xf0\x84\x3b\x24\x74\x25\x9e\xc8\xc5\xd2\xca\xb7\x58\x77\x30\x14\x45\xc4\x4a\x69\x29\xb2\x45\x86\xbd\x18\x3e\xe1\x6e\x0d\x7f\xcc\x32\x54\x07\x5f\x04\x98\x1a\xc3\x9d\x76\x9c\x2f\xbe\x63\xc9\xa3\x67\x6d\xc3\x8f\x6e\xd5\xd2\x06\x26\xc3\x9e\xce\xee\xa5\xc4\xec\xd9\xb4\x10\x86\xbe\x6f\x5f\xb5\xac\xe2\x3a\x40\x81\x3b\x4f\x38\xf6\xda\xe8\x3e\x1a\xde\xeb\xed\x49\xb6\xe8\xb0\x27\xec\x0d\xe1\x22\xd8\xda\x21\xad\xe1\x6e\xe0\xe6\x2e\x4e\xc3\x28\x9a\x3c\x01\xdb\x5d\x36\x75\x03\x9b\x59\x15\x33\xcd\xe8\x59\x0d\x3e\xa0\x4e\xaa\xc1\x06\x62\x94\xac\xf0\x44\xfe\x70\x7d\x41\xc4\x9b\x85\x08\xbe\xd6\x8a\x2e\x47\x37\xf2\xdc\xde\x42\x6f\x9c\x2d\x17\xe9\x83\xb6\xa9\xf3\x0c\xd1\x0f\x18\xa0\xcb\x25\xfd\x34\xa6\xe9\xf2\x9c\x5b\x9e\xc3\x83\x4e\xd6\x25\xfc\x71\x2d\xe8\x27\x61\xf0\x03\x95\x08\xd6\xe9\xfc\x9b\x1a\x47\x27\x4a\x30\x1a\x7f\xcd\x6e\x56\xcb\x13\xcb\xcf\x16\x93\x1e\xfe\x1d\xfa\xf3\xc4\xd3\xe8\xea\x7d\xac\x38\xbc\x5d\xae\x50\x56\x98\xd6\x48\xdf\xde\x6c\x8e\xcb\x63\x0d\xe9\x76\x94\xb3\xf2\x18\x34\x70\x63\xf6\x08\xe8\xfc\x50\x37\xa7\xce\x3b\x6e\xce\x74\x1a\x09\x5f\xb0\x1c\x78\x1a\x4d\x86\x48\x07\x8b\xe0\xfa\x6a\x22\x5e\x16\x77\xdc\x3f\x78\x90\xbd\x86\xb4\x98\xd4\x29\xda\x67\x72\xa8\x94\xe1\xbc\x2f\x8f\x32\x5e\x5f\xcd\x59\xc8\xa2\xac\xc8\x1c\xc6\x3d\x11\xcf\xe5\x79\x2b\xc4\x73\x12\xa4\xc2\xc0\xe4\x5f\x8f\xc9\xcd\xe4\xf2\x81\x27\x0c\x7e\xd5\xf3\xb0\xd9\x5c\x98\x91\xab\xb9\x8c\xb3\xa9\xed\x86\xc0\xb2\x6c\x2b\x14\xf2\xd3\xbf\x24\x85\xa4\x09\x00\xc0\x7b\xf2\x9e\xfc\x1c\x00\x37\x86\x37\x5c\xf4\x06\x00\x00"
+
+func burn_tokens_with_reasonCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_burn_tokens_with_reasonCdc,
+		"burn_tokens_with_reason.cdc",
+	)
+}
+
+func burn_tokens_with_reasonCdc() (*asset, error) {
+	bytes, err := burn_tokens_with_reasonCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "burn_tokens_with_reason.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x82, 0xc5, 0xc7, 0x92, 0x31, 0xd8, 0xb, 0x2e, 0x8d, 0xc3, 0x6f, 0xf9, 0x9b, 0x89, 0xea, 0x38, 0xe5, 0x81, 0x46, 0x89, 0x68, 0xd0, 0x45, 0xc5, 0x2, 0x3c, 0xfd, 0x6f, 0x42, 0xa, 0xab, 0x29}}
+	return a, nil
+}
+
+var _consolidate_vaultsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\xcd\x6e\xdb\x3c\x10\xbc\xeb\x29\xc6\x3e\xe4\xb3\x01\x7f\xd2\xdd\x88\x93\x06\xfd\x01\x0a\xf4\x10\x24\x46\x2f\x45\x0f\x34\xb9\x32\x89\xc8\x24\x41\xae\xe2\x1a\x89\xdf\xbd\x20\x25\x27\x52\x62\xb4\x87\x42\xba\x90\xdc\x9d\xdd\x99\xfd\xa9\x2a\xac\xb5\x89\xe0\x20\x6c\x14\x92\x8d\xb3\xd8\xb9\x47\x8a\x60\x4d\xd8\x88\x46\x58\x49\x11\xae\xce\xe7\xcf\xbf\xc4\xce\x37\xb4\x76\x0f\x64\xf1\x5d\xb4\x0d\xc7\xa2\xaa\x10\xd9\x05\x52\x10\x9c\x8d\xa2\x27\x69\x6a\x43\x2a\xdf\x8b\x2d\xc1\x0b\xd6\x11\xc6\xb2\xeb\x0c\xcc\xd6\x52\xf8\x2f\x22\xb2\xb0\x4a\x04\xd5\x41\x25\x24\x61\x15\x14\x45\x0e\xee\xd0\x65\x40\x3b\xcf\x09\xea\x25\x58\xb2\xba\xcd\x78\xac\x05\xe7\x10\x04\xeb\x58\x1b\xbb\x5d\xc0\x05\x44\xb7\xa3\x7c\x82\x63\x4d\x01\xac\x85\x85\x38\x93\xfa\x02\x22\x10\xe2\x83\xf1\x9e\xd4\xe2\x14\x3d\x3a\x24\x39\x34\xbd\xc9\x2e\x93\x80\xe1\x48\x4d\x5d\x26\xe3\xb5\x26\xb0\x63\xd1\x20\xb6\xde\x37\x87\xe4\xd6\x5a\xa9\x85\xdd\x92\x5a\x20\x1a\x2b\x09\xf4\x48\xe1\x00\xce\x41\x4d\x84\x22\xef\xa2\x61\x52\xd8\x50\xed\x02\x25\xbc\x1e\x3e\xbf\x66\xe2\xa4\xca\xa2\x30\x3b\xef\x02\xe3\x4b\x6b\xb7\x66\x73\x4a\xbb\x0e\x6e\x87\x69\x59\x56\xd2\x59\x0e\x42\x72\xac\x46\x06\xa5\x54\x72\x7a\x72\x1d\x11\x3e\xe3\x39\x7c\xef\x1c\x8b\x41\x13\xcc\x12\xdd\xb8\xc4\x8f\xfb\xae\x86\xb7\x82\xf5\xcf\x39\x9e\x8a\x02\x00\xaa\xaa\xc2\x1d\xd5\x14\x28\x91\xfc\x4b\x59\x93\x43\x43\x8c\xc7\x74\xba\xa3\x7a\x89\x8b\x51\xec\xcc\xbf\xc3\xf5\x81\xbc\x08\x34\xeb\xa0\x96\xb8\x69\x59\xdf\x48\xe9\x5a\xcb\x2f\xb1\xd3\x9f\x8b\x70\xc2\xc3\xaa\x0f\x5d\x6e\x5c\x08\x6e\x7f\x79\x06\xfe\x6a\x96\x14\x58\x8e\x44\xe9\x5e\x06\xfc\xe6\x2f\xf8\xe9\xbf\xbe\x86\x17\xd6\xc8\xd9\xf4\xa3\x6b\x1b\x95\x7a\x0c\x5d\x00\x84\xb7\xd4\xdd\xbe\x63\x9e\x11\x27\xd3\xf9\x6b\xa6\xb5\x0b\x7d\xe7\xd8\x7e\x0c\x9e\x46\x51\x4c\x9d\xaf\x4b\x76\xf7\x1c\x8c\xdd\xce\xe6\x58\xad\xfe\x9c\xe6\xd0\x76\x0c\x96\xbe\xd4\x1a\xc6\xb6\x34\x7a\x38\xbe\x26\x94\xbe\xaa\xc2\x37\x27\x54\x9a\x91\xbd\x61\x9d\xab\x97\xa3\x80\x0f\x9e\xb0\xcf\x7c\xc5\x26\x75\x51\xca\x5f\xe4\x14\x07\xf3\x16\xdf\xa2\x09\x28\x53\x67\x4d\x3a\x88\x05\xa2\x83\xd4\x24\x1f\xfa\x49\xca\xfb\x21\xbd\xa0\x36\x21\xf2\xc8\x3f\xf5\x46\xa0\xe8\xda\x20\xe9\x5c\x39\x45\xcb\x1a\x17\x37\xf6\x70\xd7\x1b\x9d\x8a\xe9\xdf\xd5\xcc\xd4\x63\xa4\x15\xac\x69\xf0\xfc\x8c\xc9\xe0\x7a\x52\x9a\xf8\xd5\xa6\x26\x95\x34\x5b\x1f\x3c\x5d\x7e\x78\xaf\xf7\xd5\x6c\xfe\x0f\xea\x7e\xea\xe6\x3c\x09\x9c\xf8\xef\xb5\x6b\x4e\x0a\x77\xcb\x35\xcd\x7d\xbf\x5c\xc7\x3b\xcf\x8c\xb5\x19\x35\x7a\xd9\xaf\x8f\x9e\xfe\xe5\xff\xbd\x4e\x8d\x13\xea\x3c\x87\x81\x4e\x93\x57\xa5\x8e\x05\x00\x1c\x8b\x63\xf1\x7b\x00\x37\xa6\x18\x25\xfe\x05\x00\x00"
+
+func consolidate_vaultsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_consolidate_vaultsCdc,
+		"consolidate_vaults.cdc",
+	)
+}
+
+func consolidate_vaultsCdc() (*asset, error) {
+	bytes, err := consolidate_vaultsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "consolidate_vaults.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x22, 0x79, 0xa7, 0x5a, 0xc9, 0x22, 0xa0, 0xf8, 0xa5, 0x98, 0xcf, 0xf7, 0x4c, 0x29, 0xf4, 0x69, 0xbb, 0x23, 0xf9, 0xc8, 0x58, 0xa2, 0xd5, 0xe6, 0x72, 0xc4, 0x92, 0x2a, 0x43, 0x22, 0x1e, 0x86}}
+	return a, nil
+}
+
+var _create_forwarderCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x4d\x6f\xeb\x36\x10\xbc\xf3\x57\x0c\x72\x68\x93\xc0\x91\xd1\xaf\x8b\x91\x16\x08\xd2\xbe\xa2\x40\x51\x3c\xb4\x69\xaf\xcd\x9a\x5a\x9b\x6c\x64\x52\x20\x57\xd6\x33\x1e\xf2\xdf\x0b\x52\x12\x2d\xe5\x05\x09\xa2\x43\x2c\xed\xec\xce\xec\x0c\xb9\xbe\xbe\x56\xea\xc1\xd8\x08\x09\xe4\x22\x69\xb1\xde\xc1\x46\x10\x84\x0f\x6d\x43\xc2\xd8\xf9\x00\x5a\x7c\x17\x43\x02\xed\xbb\xa6\xc6\x96\xd1\x45\xae\x95\x78\x44\x16\x74\x2d\xc8\x81\xb4\xf6\x9d\x13\x88\x4f\xe0\x9e\x42\x8d\x9a\x5b\x1f\xad\x70\x0d\xf1\x4f\xec\x62\xfa\x46\xce\x8b\xe1\x80\xc0\x9a\xed\x91\x43\xa5\xd4\x6f\x3b\x90\x3b\x79\xc7\x88\xec\xea\x38\x2f\x4e\x73\xc2\xd7\x11\x1f\x86\x8e\x1c\xf0\xe7\x88\x5b\x29\x31\x5c\x7e\xa1\xb7\x4d\x83\xff\xba\x28\x65\xb8\x18\x1f\x79\xd6\x2b\x95\xff\x43\x5d\x23\x83\x12\x43\x11\x5b\x66\xa7\x92\x02\x8a\xf9\x73\x60\x6d\x5b\xcb\x4e\x40\xae\x06\x1f\x6c\xfa\x07\x7c\x4c\x6f\x32\xc8\xba\xda\x6a\x12\x8e\xaa\x37\x56\x9b\xcc\x6e\x1a\x98\x54\x9a\x69\x60\x35\x2e\xb8\xa7\xd3\x0a\x36\xe9\x83\xdf\xed\x6e\xb4\x21\xeb\x10\x39\x1c\xad\x66\xf4\xe4\x24\x53\x3b\x78\x67\xc5\x07\xf4\xc6\x27\x1b\xc6\x86\xd6\xed\xd5\x99\xbe\x95\x15\xac\x40\x93\x43\x4f\xa2\xcd\x40\x2b\xc3\x23\x33\x7a\xc3\x81\x67\x04\xa0\xe9\xc0\xd8\x05\x7f\xa8\x94\xfa\x4b\xb8\x1d\x2b\x07\xb7\x06\xab\x22\x7a\x2b\x66\x00\x14\x15\x61\xa3\xd4\x37\x15\x1e\x0c\xe3\x43\xe7\xf6\x76\xdb\x30\x1e\x72\x85\xf6\x4e\x02\x69\x81\x75\xc2\x61\x47\x9a\x11\x4d\xce\x03\x35\x81\xa9\x3e\xa5\x5c\xd4\xdc\x36\xfe\xc4\x35\xa2\x3f\x70\x26\xa5\xbe\x1d\xba\x51\xdb\x36\x56\x53\xea\x27\xcb\x7e\x63\x97\x19\xba\x52\xdf\x0d\xa0\x99\x23\x63\xbc\xc6\x62\x43\x47\x06\x8d\x86\xa6\xb0\x4a\xce\xf3\xd0\x38\x30\x09\xd7\x0a\x40\x36\x32\x8a\x0f\x5c\xc3\x3a\x58\x89\xf9\x17\xed\x79\xd0\x4e\x68\xbb\x6d\x63\xa3\xe1\xba\x64\x49\x7d\x5f\xe1\xe7\x2c\x23\xef\xf3\x31\xab\x1f\x03\x68\xdd\xbe\xd2\xb5\x7e\x3c\x93\x4f\x91\x46\x6d\x77\x3b\x0e\x33\x9a\xea\x87\x2a\x65\x16\x04\xc7\x3d\xee\x06\xee\x1b\xdc\x67\x66\xb9\xed\x58\x08\xe7\xc3\x81\x9a\xe6\xb4\xca\x74\xc5\xb0\x43\xe8\x5c\x2e\x79\xd4\xb9\xfc\xdf\x62\xcd\x30\x7a\x76\x28\x07\xd0\x9e\x45\xac\xdb\x63\x71\x20\x92\xf5\x8b\x41\x43\x80\x5f\x04\xbd\x52\xd7\x6b\xa5\xec\xa1\xf5\x41\x8a\xdf\x59\x70\xce\x0e\x2e\xaa\x6a\x3d\x49\x8d\xeb\x45\x41\x22\x73\x31\x41\x7f\xf9\x44\x87\xf6\x0d\xe4\xfc\xfb\x02\xf8\x62\xb9\xaf\x61\x3b\xb1\x8d\x95\xd3\x7d\x79\xf1\x8a\x21\x17\x4a\xcd\xd6\x72\x39\x5d\x2e\x1b\xdc\xd5\x75\xe0\x18\xaf\xf0\x59\xe5\x5d\xb5\x81\x5b\x0a\x7c\x49\x5a\xcb\x06\x77\x9d\x98\xd1\x9c\x52\x91\x9e\xf5\x1a\xbf\xb2\x4c\xab\x1a\x16\xaa\xa9\xa5\x6d\x66\x92\xce\xca\x62\xb5\x5b\xce\xd4\x47\x9b\xd2\x6d\x57\x3a\x35\x2c\xb3\x10\xff\x88\x3d\xcb\x38\xb0\x90\xbc\x2a\xc5\xe9\xa9\xf6\x2c\xf7\x65\xd4\xed\x57\x9f\x97\x4b\x9f\xfc\x7d\xfe\xe9\x72\xb1\xd3\xe9\xfd\xc7\x14\x67\xfd\x91\xc4\x5c\x2d\xe4\xcc\x92\x57\xe2\x34\x1c\x8e\x74\x90\xac\x4c\x37\xe4\xcb\xb4\xd4\x7e\x4a\xd6\x08\x4b\xb7\xd2\xd4\x37\x89\x3b\xe6\x13\x78\x7b\x83\x2f\x5c\xc9\x13\xff\xe0\x7e\x7c\xc7\xe1\xb2\x2c\x62\x73\xde\xc9\x59\x7d\xb2\xa4\x8a\x74\xe4\xcb\xdb\x9b\xdc\x75\x05\xf1\x1b\xac\xc7\x03\xbb\xe6\x99\xde\xd2\x73\xa9\xf2\x6f\xd7\x58\xf7\x94\x85\xf0\x27\x1b\xf3\xa9\x78\xc5\xc0\x02\x49\x37\x73\x9a\xba\xd8\xf9\xbb\x8b\xad\xb4\x61\xfd\xf4\x96\x35\x29\x4c\xd3\x8c\x22\xad\xcb\xe4\xde\xb7\x6d\x02\x3d\x2f\xa4\xfd\x3e\x09\x4b\x17\xca\xd9\x8b\xd7\xf2\x59\x60\x79\x6c\x1a\xfa\x16\xd7\x52\x9d\x9e\x77\xc8\xad\x0a\xb9\xf4\x27\x14\xf6\x2c\xef\x39\x54\x20\x57\x0a\x00\x9e\xd5\xf3\xff\x03\x00\xdf\x8c\x99\x8e\x80\x08\x00\x00"
 
 func create_forwarderCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -122,7 +187,127 @@ func create_forwarderCdc() (*asset, error) {
 	return a, nil
 }
 
-var _mint_tokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\x4d\x6f\xdb\x48\x0c\xbd\xfb\x57\x10\x3a\x04\x32\x36\x91\x2e\x8b\x3d\x18\x71\x02\x27\xdb\xf4\xd4\x22\xc8\x47\xef\xa3\x11\x65\x4f\x2b\xcd\x08\x1c\x2a\x8e\x11\xe4\xbf\x17\xf3\x21\x59\xb2\x63\xfb\x62\x40\xf3\xf8\x48\x3e\x3e\x52\x35\xad\x21\x86\x87\x4e\xaf\x55\x51\xe3\x8b\xf9\x83\x1a\x2a\x32\x0d\x24\x59\x96\x4b\xa3\x99\x84\x64\x9b\x4f\x00\x99\x2c\x65\x32\x8b\xa1\xdf\xde\x45\xd3\x9e\x89\x1c\xbf\x87\xc0\x59\x9e\xe7\xf0\xb2\x51\x16\x98\x84\xb6\x42\xb2\x32\x1a\x94\x85\xed\x46\x30\xf0\x06\xa1\x51\x9a\x91\x60\x25\xa5\xe9\x34\x43\x67\xd1\x02\x1b\xff\x19\x34\x6e\x81\x1d\x99\x8d\x3c\xb8\x83\x96\xcc\x9b\x2a\xd1\xc7\x12\x4a\xd5\x2a\xd4\x0c\xa2\x2c\x09\xad\x05\xa1\x4b\x10\x8d\x67\x8a\x24\x97\xfe\x9b\x43\x8f\x98\x04\x61\x28\xa8\x42\x22\x2c\x1d\xd6\x21\x06\x96\xca\x95\xe4\xa2\x95\x5e\xcf\x66\xa3\xd2\xd3\x21\xe5\x02\x56\x01\x7d\x19\x13\x2e\xe0\xf5\x41\xbd\xff\xf7\xef\x1c\x3e\x66\x33\x00\x00\x97\xe8\x09\x2b\x24\xd4\x12\xfb\x14\x51\x22\x08\x1a\xae\xca\x46\x69\x78\x42\x6b\x3a\x92\x08\xa6\xf8\x8d\x92\x7d\x70\x8d\x1c\x0a\xf6\x90\x05\x5c\x4c\xb4\xf5\x1f\x95\x65\x12\x6c\xe8\x4c\xb6\x7e\x94\x31\xdd\x13\x4a\x54\x6f\x48\x60\xaa\xa9\x7e\xd3\x94\x3d\x6c\x01\x17\x1f\x53\x33\xf4\x2f\x9f\xfb\x9c\x2f\x5e\x59\x16\x35\xd8\xae\x6d\xeb\x9d\xe7\xf6\x4a\x43\x81\x95\xa1\x30\xa9\xa2\x23\x3d\x24\x09\xc0\x3b\xff\xda\xab\x16\x08\x5b\xc2\x56\x10\xa6\x56\xad\xb5\xcb\xbf\xea\x78\x13\x9d\xe1\x64\x85\xf8\xb3\x58\x57\xd9\x98\x05\x96\x13\x6f\x66\xbe\xa0\x67\x0f\x98\x0d\x51\x79\x0e\x77\x86\xc8\x6c\x41\x00\x1d\x2a\x25\xfc\x24\x46\x03\x18\xf2\xec\xa7\x00\x4b\x08\x85\x65\x85\xe7\xb9\x3e\x33\x94\x9b\xd4\xed\xc7\x02\x8e\x11\xcf\x6c\x48\xac\xf1\x51\xf0\x66\x3e\x64\x72\xbf\xdb\x5b\x68\x85\x56\x32\x4d\x9e\x7d\x16\xb7\x26\xda\xf0\xde\xbb\xa1\xc8\x64\x3e\x69\xe9\x3b\x06\x84\x88\x0b\x74\x38\x5a\x6f\xff\xe2\x54\xdf\x8a\x1c\xd2\xcf\xf4\x8b\xae\x07\xbf\x2c\x61\x8d\x1c\x07\xb1\x5f\x81\x69\xf9\xd9\x1a\xf9\x5e\xb4\xa2\x50\xb5\xe2\x5d\x3a\x69\xbc\x27\x7a\xec\x8a\x5a\xc9\xe3\xd6\x07\x41\x4f\xf9\xed\x26\x3d\xa5\xd5\xab\x16\xce\xe0\x6c\xfa\x26\xfb\x7e\xf6\xbd\x26\x21\x36\x9a\x16\xdf\x51\x76\x8c\xfd\x96\x46\x19\xef\x09\x05\x23\x88\xfe\x1e\x39\xd5\xfc\x0d\x8a\x57\xa3\x87\x3a\xff\x46\xc8\xf5\xd5\xa1\x41\x32\xe9\x59\x7e\xe2\xf6\x87\x87\xa4\xa2\xae\xcd\x16\xcb\x55\x3c\x10\xe1\x50\xcc\x8f\xc9\xca\x5f\xa2\xab\xd9\x31\x06\xee\xcc\xfd\x79\x09\x6c\x2a\x0e\x82\xc7\x55\xff\x8f\xad\xb1\xca\x1b\xa0\xe9\x9d\xec\xfb\xc7\xf3\x03\xcd\xca\x10\x18\x4d\x7a\x7d\x35\xaa\x62\x94\xa1\x44\xcb\x64\x76\xb1\xa8\xb1\x88\xad\xb1\x3c\x5a\xc8\x53\xcb\x07\xcb\xe5\x17\xcb\xfa\xcf\x70\x31\x93\xa3\xeb\xd1\x74\x96\xa1\x40\x50\xda\x69\x69\xb1\x84\x62\x17\xfc\xed\x43\x92\x58\xc4\xe7\xdf\x00\x00\x00\xff\xff\x72\xee\xdb\x6b\xcd\x06\x00\x00"
+var _create_secondary_vaultCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x6b\xdc\x30\x10\x85\xef\xfa\x15\x8f\x3d\x79\x21\xb5\xef\x4b\x73\x08\x21\x3d\x17\xb2\xf4\x3e\x91\x66\xd7\x22\xf6\x48\x48\xe3\xb4\x4b\xd8\xff\x5e\x64\xc7\xc2\x86\xc0\x9c\xa4\x79\x9f\xe6\xbd\x51\xd7\xe1\xdc\xfb\x0c\x4d\x24\x99\xac\xfa\x20\xf0\x19\x04\xe5\x31\x0e\xa4\x8c\x4b\x48\xa0\xed\xbd\xe9\x3a\x68\x00\x39\x07\x92\xa0\x3d\xa7\x07\xf0\x18\xf5\x86\x97\x7f\x34\xc6\x81\xcf\xe1\x9d\x05\x7f\x68\x1a\x74\x6e\x14\x90\xb5\x61\x12\x2d\x4a\x52\x10\xb2\x86\x44\x57\x46\x24\xed\x31\x23\xa0\x3d\x09\xb4\x67\x64\x25\x71\x94\x1c\x82\xb0\xe9\xba\xa2\x39\xf7\x0c\xe1\xbf\x5f\x48\x9f\x21\x41\x31\x78\x79\x67\xb7\x3c\x70\x43\x9c\xde\x06\x6f\x67\xa0\x31\x7e\x8c\x21\x29\x7e\x4d\x72\xf5\x6f\xeb\x3c\x97\x14\x46\x1c\xda\xb6\xb3\x41\x34\x91\xd5\xdc\xed\x1a\x5a\xeb\xec\x61\x95\xee\x9c\x7c\xa3\xdc\xde\x2f\x42\xb3\x49\xa8\x29\x63\x9c\xf0\xba\xb8\xfc\x4d\xda\x1f\xf1\x69\x0c\x00\xc4\xc4\x91\x12\x37\xd9\x5f\x85\xd3\x09\x4f\x93\xf6\x4f\x4b\x3a\xb5\xa7\xd4\x97\xeb\x1a\x46\x21\x96\xc5\x24\xce\x9c\x3e\xd8\xcd\x6b\x29\x71\xc5\xe4\x47\x4a\xb7\x25\x9b\x2a\xf7\x97\x39\x8a\x56\xc3\xab\x26\x2f\xd7\xe6\x88\xc7\xc7\x9d\xab\x76\x16\x6c\x46\xdc\xf6\x7e\x56\x50\xa9\x48\xe2\x6d\x73\x78\x26\x29\xb9\xdb\xc4\xe5\x5b\x10\x32\xdb\x20\xae\xbe\x0d\xd2\xfd\xfe\xb6\x4b\x3e\x1c\x2b\xf1\xbe\x33\xf9\xbc\xd2\xca\x7e\xb7\xf3\xad\x50\x71\x88\x93\xc2\x2b\xbc\xac\xc8\x0a\x58\x52\x6c\x33\x7d\x70\x53\x0f\x4b\xfd\xfc\xb1\xf3\xba\xcc\xfc\x32\x46\xbd\xcd\xd8\xe6\xf8\xb0\x6b\xd7\x70\x9a\xf3\xaa\x87\x47\x03\x00\x77\x73\x37\xff\x07\x00\x03\x96\x13\x70\x21\x03\x00\x00"
+
+func create_secondary_vaultCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_create_secondary_vaultCdc,
+		"create_secondary_vault.cdc",
+	)
+}
+
+func create_secondary_vaultCdc() (*asset, error) {
+	bytes, err := create_secondary_vaultCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "create_secondary_vault.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x66, 0x3e, 0xa1, 0x6f, 0x2f, 0x96, 0xce, 0x8a, 0xfd, 0xcc, 0xa1, 0x9c, 0x14, 0x43, 0xa1, 0x76, 0x56, 0x52, 0x5a, 0x5f, 0x4f, 0xf4, 0x14, 0xb2, 0xa6, 0x65, 0xfb, 0xa4, 0x8d, 0x88, 0x78, 0x9a}}
+	return a, nil
+}
+
+var _delegated_mintCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xdd\x4e\xe3\x3c\x14\xbc\xcf\x53\x8c\x7a\x81\x5a\x7d\x7c\xc9\xcd\x6a\x2f\xaa\x16\x04\xab\x65\xaf\x90\x10\x94\x07\x70\x9c\xd3\xc4\x22\xb1\x2d\xdb\x01\x2a\xc4\xbb\xaf\x6c\xe7\xb7\xa5\x68\x11\x52\xa5\xf8\x9c\x99\x33\xe3\x39\x16\x8d\x56\xc6\xe1\xae\x95\xa5\xc8\x6b\xda\xa9\x17\x92\xd8\x1b\xd5\x60\x91\xa6\x19\x57\xd2\x19\xc6\x9d\xcd\x66\x05\x29\x2f\xf8\x22\xe9\x5a\x7f\xbf\xb3\x46\x7f\xd3\x39\x3d\x8f\x8d\x49\x96\x65\xd8\x55\xc2\xc2\x19\x26\x2d\xe3\x4e\x28\x09\x61\xf1\x56\x31\x07\x86\x82\x6a\x2a\x99\xa3\x02\x8d\x90\x8e\x0c\x18\xe7\xaa\x95\x0e\xad\x25\x0b\xa7\xc2\x67\x48\x7a\x83\xf3\xa0\x36\xe0\xb9\xca\xa8\xb6\xac\xe0\x2a\xc2\x7d\x6c\xe3\x4c\xb3\x5c\xd4\xc2\x1d\x20\xac\x6d\xa9\xf0\xbd\xc2\x21\x3f\x84\xaa\xd0\x0c\x56\x34\x42\xa6\xdd\x48\x74\x80\x36\xea\x55\x14\x14\x2a\x0c\x71\xa1\x05\x49\x07\x56\x14\x86\xac\x05\x93\x05\x58\x13\x86\xe9\xe6\xb8\x0c\xdf\x06\xbc\x38\x0c\x33\x14\xb5\xed\xc9\x98\xc8\xeb\x2b\x06\x94\xbd\x1f\xcf\xab\x10\xb2\x4c\x92\x89\x0b\xcb\x81\x72\x8d\x9b\x58\x7d\xd9\x11\xae\xf1\x7c\x27\xde\x7f\xfe\x58\xe1\x23\x49\x00\xc0\x13\x3d\xd2\x9e\x0c\x49\x4e\x3d\x45\x27\xdd\xb3\x4d\xe4\x6b\x25\xa4\xf3\xd6\x85\xc6\x9a\x5c\xe7\xec\x1a\x17\xb3\xeb\x89\xdd\xdf\xc0\xf7\x31\x40\xbc\xee\x47\xe2\x24\x5e\xc9\x40\xed\xe7\x86\x0d\x3c\xc1\x94\xbe\x6c\x8d\x8b\x8f\x79\x90\xfa\x93\xcf\x91\x73\x17\xac\x74\xac\x86\x6d\xb5\xae\x0f\x01\xdb\xa3\x58\xe4\xb4\x57\xde\xd9\x8a\xc2\xfc\x03\x49\x2c\xbc\x0d\xa7\xbd\x4d\x11\x50\x1b\xd2\xcc\xd0\xd2\x8a\x52\x7a\xfe\x9b\xd6\x55\x37\x31\x4d\xde\x47\x74\x7f\x96\xea\x7d\x3a\x45\xc1\x16\x33\x63\x9c\x72\xac\x7e\x0a\x05\xc9\xd0\x95\x65\xb8\x55\xc6\xa8\xb7\x61\x22\x32\xb3\x1c\x9e\x06\xb0\x8b\x5e\x08\xdd\x80\x33\xde\xc7\xaf\xb1\x61\x8b\x38\x73\xca\x95\x3e\x6c\xc6\x83\xcd\x57\x37\x76\x75\xb5\xf4\x9b\xb7\x46\x66\x9d\x32\xac\xa4\x8c\x26\x45\xf7\x47\xd8\xab\x81\xd9\xff\x5f\x5f\x43\x33\x29\xf8\x72\xf1\x14\x08\x51\x31\x0b\xa9\x1c\x72\x22\xd9\xcf\xcd\x7a\x79\xa3\xa4\xc5\x6a\x74\x22\xf8\xd7\x55\x6c\x4f\xb4\xa4\x79\x70\x69\x79\x8e\x77\x57\xd1\x29\x7c\x18\x23\x8c\x60\xe8\x55\xbd\x50\x31\xe5\xcb\x32\xfc\xf1\xe1\xaa\x68\x78\x1b\x8e\x13\x18\xd6\x32\x12\x83\xc1\x1c\x05\x59\x18\x5f\x19\xb2\x3b\x80\x06\x11\xb3\xbc\x62\x8b\x92\x5c\x97\x97\x71\x35\xe7\x3a\xd2\x92\xdc\xa8\x75\x39\xbb\x9d\x1e\xe8\xa1\xcd\x6b\xc1\x1f\x98\xab\x8e\x7a\xe3\x80\x9b\xb3\x6b\x71\x75\xd6\xb4\x67\xc9\xf2\xda\x6f\x4a\x2f\xb2\xd7\x33\x6a\x5d\xc4\xde\x6e\xb7\xe8\x9d\x78\xeb\x08\x1f\x33\x1b\x7d\x36\xfa\xf5\xf2\x8e\x15\xa4\x95\x15\xc1\xda\xa6\x5f\xfa\x7f\x70\x2a\xed\xfa\xba\x18\x6e\xfe\x9f\x24\x22\xfc\x04\x5d\x76\xd9\x3f\x65\xf1\x77\x35\x1b\x50\x2b\xeb\x26\x3b\x79\x6e\xff\xb0\xdd\x7e\xb1\xaf\xff\x0d\xaf\xe4\xe2\xe4\x01\x69\x5a\xeb\xd3\x0c\x21\xb9\x21\x66\x27\x6b\x18\x5a\x16\x09\x00\x7c\x26\x9f\xc9\xdf\x01\x00\xec\x5f\xc4\x0d\x0d\x07\x00\x00"
+
+func delegated_mintCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_delegated_mintCdc,
+		"delegated_mint.cdc",
+	)
+}
+
+func delegated_mintCdc() (*asset, error) {
+	bytes, err := delegated_mintCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "delegated_mint.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5b, 0x5e, 0xfc, 0xb9, 0x36, 0xf4, 0x7a, 0xae, 0x24, 0xb9, 0xc4, 0x2b, 0x19, 0x88, 0x31, 0x9b, 0xf4, 0x31, 0xca, 0x22, 0xa5, 0x90, 0xd2, 0x3b, 0x90, 0x5d, 0xfc, 0x2d, 0xb2, 0xc8, 0xe0, 0xe5}}
+	return a, nil
+}
+
+var _deploy_contractCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x4f\x3d\x6b\xf3\x30\x10\xde\xf5\x2b\x9e\xed\xb5\xc1\xaf\xb4\x67\x0b\x5d\xba\xd7\x7b\xb9\x4a\x87\x2d\x92\x9c\x8c\xee\x0c\x09\x25\xff\xbd\xa8\x8e\x71\x35\x9c\x38\xe9\xf9\x0c\x01\xe3\x9c\x15\x56\x49\x94\xa2\xe5\x22\x48\xbc\x5c\xcb\x43\x41\x88\x45\xac\x52\x34\x58\x81\xcd\x0c\xcd\x93\x70\xfd\xa7\xa0\x18\xcb\x2a\x36\xb8\x10\xc0\x7e\xf2\x20\x58\xb9\xb0\x1c\x8c\xca\xb6\x56\xe1\x84\xaf\x07\xde\x56\xb5\x72\x1b\x1b\xc0\xbb\x10\x1a\x69\x9c\x19\xb1\x24\x46\x56\x2c\xa4\xca\x09\x33\xdf\xff\xb3\xb4\xc7\x34\xe0\x9a\x2f\xbc\xbb\xae\x4b\x22\xe3\xcf\x23\xcc\x91\xd5\x3b\xf7\x67\xeb\x84\x6e\x7c\xc2\x87\xd5\x2c\xd3\xf0\xab\xbf\x6f\x3d\xbe\x9d\x03\x80\xa5\xf2\x42\x95\xbb\xad\xca\x09\xe7\xd5\xe6\xf3\xd6\xa6\x61\xf0\x3a\xdb\xb7\xdf\x3d\xd5\x53\x4a\x2f\xf9\x36\x77\xf1\x36\x7d\xe2\x76\xbd\xf3\xbd\xeb\x7b\x07\x00\x4f\xf7\x74\x3f\x03\x00\x4e\x77\xb4\x1c\x59\x01\x00\x00"
+
+func deploy_contractCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_deploy_contractCdc,
+		"deploy_contract.cdc",
+	)
+}
+
+func deploy_contractCdc() (*asset, error) {
+	bytes, err := deploy_contractCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "deploy_contract.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xaa, 0x67, 0x8, 0xb3, 0xe8, 0x65, 0x7b, 0x7d, 0x51, 0x9e, 0xd4, 0x49, 0x78, 0xe4, 0x1b, 0x67, 0x17, 0x75, 0x7d, 0xd9, 0xd8, 0x9c, 0x93, 0xe, 0xab, 0x77, 0x69, 0x40, 0x59, 0xe8, 0xb4, 0x3d}}
+	return a, nil
+}
+
+var _destroy_vault_at_pathCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x5d\x6b\xdb\x40\x10\x7c\xd7\xaf\x18\xfc\x52\x1b\x12\xe9\xdd\x24\xa5\xa1\x1f\xd0\xb7\xd2\x84\xbe\xaf\x4f\x2b\xeb\xa8\xb4\x7b\xdc\xad\x4c\x4d\xf0\x7f\x2f\x27\x45\x8a\x44\x0b\x7a\xda\x9d\x99\x9b\x99\x55\x55\xe1\xa5\xf5\x09\x16\x49\x12\x39\xf3\x2a\xf0\x09\x04\xe3\x3e\x74\x64\x8c\x46\x23\x68\xb3\xb7\x96\xac\xa8\x2a\x44\xee\xf5\xc2\x19\xfc\xf5\x0f\xf5\xa1\xe3\x17\xfd\xcd\x82\x5f\x34\x74\x86\x64\x1a\xb9\x06\x19\x08\x6e\x48\xa6\xfd\x38\xa2\x33\x23\x90\xb5\x99\x4f\x52\xa3\xe6\x64\x51\xaf\x09\x3e\x4b\xe6\xe9\x97\x69\xe2\xe5\x0c\x9a\xb5\x86\x93\x45\x72\x96\x51\x29\x3f\x4b\x5e\xf2\xfe\x44\x1d\x89\x63\x34\x51\x7b\x58\xcb\x30\x35\xea\x90\x86\x10\xba\xeb\x5d\x16\x4b\x0a\x82\xa8\xdc\x73\x1f\xec\xfa\x26\xe7\x13\xb8\x69\xd8\x99\xbf\x70\x77\xc5\x69\x88\xc2\x75\x99\xe1\xdf\x1b\x88\x5a\x9b\xb5\x7d\x5a\x45\xc8\xda\xd9\xf5\xdd\xf4\xca\xaa\x8b\x5a\x39\xcd\x9c\xb2\x28\x7c\x1f\x34\x1a\xbe\x0d\x72\xf6\xa7\xb9\x90\xd1\xde\xae\x2c\x2b\xa7\x32\xe5\xa8\x36\x80\xd2\xd5\x6e\x37\x53\x37\x55\xfe\x87\xb9\xde\x4f\xc4\x62\xe5\x67\x9f\x5d\x1e\xf1\x3c\x35\xfd\x83\xac\x3d\xe0\xb5\x28\x00\x20\x44\x0e\x14\x79\x9f\xfc\x59\x38\x1e\xf1\x34\x58\xfb\xe4\x9c\x0e\x62\x0b\x26\x7f\x55\x85\x9f\xdc\x0c\x29\xb7\x39\x9f\x67\x8c\x4d\x13\xf8\x43\x42\x88\xbe\xa7\xf8\x56\xe7\x42\xf4\xcd\x78\xd9\xd2\xf4\xd9\xa2\x97\xf3\xfe\x80\xc7\xc7\x4d\x9e\x72\x24\xac\xcc\xad\xb1\xaf\x8b\x50\xfe\x02\x89\x77\xfb\xdd\x67\x12\x51\xdb\xd8\x18\x35\xe6\xa3\x24\x23\xa9\x29\xd6\x9b\x7f\x6b\x77\x58\xa4\x6e\xef\xb9\x3a\x36\x5c\x46\xee\xc3\x3d\xa6\x12\xca\x4e\xa9\x7e\xf8\xf4\xaf\xc5\x8f\xfb\xdc\xfc\x71\x54\x3b\xbc\x4b\xcc\x36\x2e\x4b\xee\x5b\x71\x2b\xfe\x0e\x00\x2e\x6b\x0f\x95\x43\x03\x00\x00"
+
+func destroy_vault_at_pathCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_destroy_vault_at_pathCdc,
+		"destroy_vault_at_path.cdc",
+	)
+}
+
+func destroy_vault_at_pathCdc() (*asset, error) {
+	bytes, err := destroy_vault_at_pathCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "destroy_vault_at_path.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x40, 0x6d, 0x3d, 0x3d, 0x55, 0x68, 0x63, 0xe4, 0x78, 0xd2, 0x24, 0x5, 0x85, 0x38, 0x9b, 0x22, 0x9a, 0x89, 0x68, 0xc2, 0x31, 0x9c, 0x89, 0xc2, 0x40, 0x3b, 0x5e, 0x40, 0xe8, 0xee, 0x41, 0x62}}
+	return a, nil
+}
+
+var _distribute_initial_supplyCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x5b\x6f\xdb\x36\x14\x7e\xd7\xaf\xf8\xea\x87\xcd\x46\x57\xb9\x0f\xc3\x1e\x8c\xb8\x85\x1b\xac\xc3\x1e\x3a\x14\x49\xfa\x34\x0c\x08\x45\x1d\xd9\x5c\x24\x52\x20\x8f\x9a\x18\x81\xff\xfb\xc0\x8b\x6e\x76\x52\x60\x51\x90\xd8\xd4\xb9\xf0\xbb\xf0\x50\x35\xad\xb1\x8c\xcf\x9d\xde\xab\xa2\xa6\x3b\xf3\x40\x1a\x95\x35\x0d\x16\x79\xbe\x96\x46\xb3\x15\x92\xdd\x7a\x16\x90\xcb\x52\x2e\xb2\x94\xfa\xfb\x93\x68\xda\x1f\x64\x4e\xdf\xc7\xc4\x6c\xbd\x5e\xe3\xee\xa0\x1c\xd8\x0a\xed\x84\x64\x65\x34\x94\xc3\xe3\x41\x30\xf8\x40\x10\x65\xa3\x34\x84\x94\xa6\xd3\x8c\xce\x91\x83\xa8\x98\x2c\x4a\x6a\x6b\x73\x54\x7a\x1f\xc2\xd8\xd7\x0c\xd5\xd8\xa0\x54\x8e\xad\x2a\x3a\x26\x28\x76\x50\x5a\xb1\x12\x35\x5c\xd7\xb6\xf5\x31\xc7\x9f\x8c\x46\x69\x76\x21\x51\x34\xa1\xb0\x6b\x49\xaa\x4a\x51\x89\xca\x58\x90\x90\x07\x88\xb2\xb4\xe4\x5c\x28\xaa\x74\x08\xbe\x4f\x6b\xbb\x90\xf4\x45\xb4\xf7\x68\x85\x15\x0d\xf9\x0d\x09\x5d\xfa\x4d\x19\x17\x7a\x32\xd8\x80\x3d\x8a\x94\x93\x27\xac\x7d\x4b\x87\xa6\x73\xe1\x2d\xba\xd6\x07\xdf\xb3\x61\x51\xdf\xe7\x59\x36\xe1\x62\x79\xde\x71\x83\xe7\x5d\x5c\xda\xe0\xdb\x67\xf5\xf4\xdb\xaf\xa7\x5f\x10\x32\xfb\xef\x2b\x3c\x67\x19\x00\xf8\x7e\x37\x54\x91\x25\x2d\xc9\x77\xf0\x10\x92\x06\x88\x22\xed\x02\xbb\x37\xe4\x4c\x67\x25\xc1\x14\xff\x92\xe4\x90\x5c\x93\x47\xf0\x40\x3a\x84\x6c\xf0\xd3\x4c\xbc\xb0\xe8\x59\x16\x6c\xec\xd8\xed\x2e\x28\xc1\x03\xd7\x30\x55\x2c\xe2\x50\x50\x65\x2c\x05\x16\x07\x79\x94\xd1\x43\xaf\x28\xce\xa7\x10\xd5\x23\x89\x85\x5b\x4b\xad\xb0\xb4\x74\x6a\xaf\xc9\x6e\xb0\xeb\xf8\xb0\x8b\x7e\xf0\x50\x91\x7e\x1c\xd5\x55\x3e\xad\x82\x6d\x0f\x36\x60\xcd\x03\x49\xb7\x21\x20\x1b\xb2\xd6\x6b\x5c\x1f\x48\x3e\x44\xa9\x46\x43\xb8\x89\x30\xdc\xa3\x1a\xb2\xbe\x0b\x0b\xd7\x35\xd8\xe2\x7d\xfe\x7e\x58\xf5\xce\x49\x72\xc1\x7b\xf6\x4c\xb9\xfc\x81\x8e\x6e\xb2\x61\xff\x1b\x8b\xf8\xbf\x6f\x2f\xe2\xff\x4e\x0b\xff\xbc\x19\x52\x4e\xc3\x27\x55\x85\x0d\xbc\xd9\x26\xbe\xe7\x65\x5b\xa1\x95\x5c\x2e\xee\xa6\x64\x53\xf9\x9a\xf1\x06\x7c\x8b\xd5\x50\xe5\x34\xa3\xe8\x93\xb1\xd6\x3c\x42\xc0\x9e\xdb\x29\x1e\xcf\x89\x6f\x06\x29\x46\xf3\x60\x8b\xa8\x5d\x5e\x84\x3a\x57\x3f\xf0\xd2\x87\xa5\x9f\x1b\x9b\xb9\x72\x21\xe2\x96\x8d\x15\x7b\xfa\x2a\xf8\x30\x6e\xd3\x3f\x1f\x3f\xf6\x78\x6f\x43\x17\x3f\x3e\xb4\xe1\x71\x2c\xc4\x19\x92\xc0\x25\x60\xf4\x44\xd2\xcf\x87\xe7\x19\xce\x6b\x4b\x82\x09\x22\xcc\x07\xb2\xd1\x14\x52\xe8\xf0\x1d\xf4\x24\x24\xd7\xc7\x91\xaf\x21\xd5\x1f\x96\x94\x72\xf5\xee\x1c\x7e\x2e\x43\xd5\xbf\xe8\xf1\x4b\x08\x59\x8a\xba\x36\x8f\x54\x46\xa1\x37\xb1\xd4\x2a\xfb\xbf\x36\x1a\xe2\xd3\xde\xff\x20\x7e\x49\x20\x4b\x52\xb5\x8a\x34\xff\xec\x70\x43\x92\xd4\x77\xb2\xb3\x54\xbf\x77\x9b\x5e\xdc\x50\x85\x2d\xf6\xc4\xe9\x7c\xf5\xd3\x67\x4e\xb8\x7f\xf2\x3d\xf1\xb5\x68\x45\xa1\x6a\xc5\xc7\xe5\x4c\xae\xbe\xcd\xd7\xae\xa8\x95\xbc\x14\xcc\x3f\x83\x15\x9e\xe7\xd7\x49\x9f\x7b\xfa\xb0\xbc\x4c\x1a\x95\xfe\xa6\x45\x51\x7b\x79\x11\xeb\x0c\x08\x46\x02\x16\xab\x0b\x8a\x3c\xff\x17\xa4\xa4\x0b\x60\x32\xbd\xa1\x46\x2b\xfb\x67\xc2\x4e\x9e\x42\x92\x4b\xaf\xde\x45\xd5\x73\xff\x2f\x00\x70\x4b\x91\x64\x7d\xfd\x38\xaf\x5e\x3c\x66\x25\x39\xb6\xe6\x98\x7c\x34\xf5\x6a\x6b\x1c\x4f\xe6\xc6\x6b\x43\x0d\xdb\xed\x0b\x43\xf0\x6d\x7f\x39\x2c\x2e\x86\x73\xb8\x7f\x0a\x82\xd2\xde\xa1\x8e\x4a\x14\x13\x73\x2f\x32\x00\x38\x65\xa7\xec\xbf\x01\x00\x02\xc1\x14\xbc\x19\x08\x00\x00"
+
+func distribute_initial_supplyCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_distribute_initial_supplyCdc,
+		"distribute_initial_supply.cdc",
+	)
+}
+
+func distribute_initial_supplyCdc() (*asset, error) {
+	bytes, err := distribute_initial_supplyCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "distribute_initial_supply.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf1, 0x95, 0x2e, 0x78, 0x44, 0x2a, 0xfa, 0xc5, 0x40, 0x9d, 0x75, 0x67, 0x2b, 0x7d, 0x42, 0xa3, 0x95, 0x4, 0x3c, 0x66, 0x2e, 0xbb, 0x6b, 0xae, 0x94, 0xae, 0xca, 0x39, 0x4f, 0xe9, 0xed, 0xef}}
+	return a, nil
+}
+
+var _issue_minter_capabilityCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\xc1\x6e\xdb\x3a\x10\xbc\xeb\x2b\x06\x3e\xbc\x67\x03\x7e\xd2\xe5\xa1\x07\x23\x4d\xe0\x06\x0d\x7a\x68\x8b\x02\x49\x3f\x60\x2d\x6d\xa4\xad\x25\x52\x20\xd7\x76\x8c\x22\xff\x5e\x90\xb4\x65\xc9\x08\x52\x40\x17\x91\xbb\xc3\x99\x9d\xd9\xa2\xc0\x53\x23\x1e\xea\xc8\x78\x2a\x55\xac\x81\x78\x10\x94\xbb\xbe\x25\x65\x3c\x5b\x07\x9a\xdc\x6b\x43\x9a\x15\x05\x5a\x56\x0f\x6d\x18\x6a\xb7\x6c\x40\x55\x27\x06\x15\xb7\x5c\x87\xbe\x4e\x8c\x8a\xa9\xa1\x16\x64\xac\x36\xec\x40\x65\x69\x77\x26\xf4\x86\xf6\xa7\x86\x4f\x3d\xa5\x63\x52\x0e\xaf\x7e\x13\xa3\xec\x70\x10\x6d\x22\x72\x2d\xfb\x80\xdc\xb6\xf6\x40\xa6\x64\x90\xa9\xd0\x8a\xd9\x7a\x48\x80\x01\x29\x08\xbd\x93\x7d\x78\xb1\x27\x6d\xf2\x08\x3b\x90\xf0\x6a\x1d\x27\x92\x8e\xfd\xae\x8d\x8c\xce\xf5\x25\xf5\xb4\x91\x56\xf4\xb8\x0c\x58\x87\x46\xca\x06\xd6\xb4\x47\xe8\x85\x1a\x19\x38\xde\xdb\x2d\x63\x73\xc4\xce\x84\xc7\x03\x86\x68\x7e\x92\xf1\xc9\x9e\xb8\x26\x2d\x81\xa1\x8e\x29\x74\x3b\xaf\xf0\x52\x9b\x58\x34\x9a\x63\x96\x49\xd7\x5b\xa7\x78\xd8\x99\x5a\x36\x2d\x3f\xc5\x31\x3e\x3b\xdb\x61\x96\xe7\x45\x69\x8d\x3a\x2a\xd5\x17\x93\x82\xbc\xac\xca\xd9\xb9\xf5\xf3\x0b\x75\xfd\x3b\x9d\xe3\xfb\xd4\x98\x8d\x18\xcc\xe3\x60\xb9\x5a\x77\xc1\x96\x15\x7e\x3e\xc8\xcb\x87\xff\x17\xf8\x9d\x65\x00\xd0\x3b\xee\xc9\xf1\x3c\x0a\x5b\x61\xbd\xd3\x66\x9d\x1c\x5c\x0e\xf2\x26\xc7\x43\x67\xf8\xe2\x68\x9c\xb3\x07\x10\x1c\x3f\xb3\xe3\x60\xa0\xda\xd1\xac\xec\xe6\x17\x97\x3a\x74\xb4\xac\x29\x4a\xeb\x78\xfb\x31\x55\xe5\x9b\x88\x72\xf3\xcf\x44\x4b\x2c\x11\xaf\x8e\xd4\xba\xdb\x79\x90\xbe\x9a\x4c\x23\x55\x3c\xaa\x75\x54\xf3\x0f\xd2\x66\x31\xbc\x13\xbe\xbb\x3b\xf4\x64\xa4\x9c\xcf\x1e\xa5\x36\xec\x42\xe8\x8d\xd5\xeb\x38\xcf\x16\x13\x41\xf7\x31\xa8\x20\x18\x3e\xc4\x80\x87\x50\x9b\x0a\x5b\xe6\x1e\xa2\x10\x73\x51\xf7\xaf\x8f\xe9\xa3\x9a\x07\x84\x78\x9e\x7b\xda\xf3\xfc\xe6\xbf\x8b\xd2\x3c\xe5\xff\x3b\x1f\x52\xfc\xaf\x6d\x99\xfc\x2e\x96\x50\xbb\x42\x71\xc2\x2e\x78\xa4\x39\xb5\x4f\x29\x7f\x15\xb3\x8d\xa4\xce\x74\xaf\x37\x06\xde\xbe\x15\x7a\xf1\x7e\x97\xf6\xed\x14\x7f\x99\x1a\x95\xe0\xee\x87\x15\x1a\xec\x0a\x1b\x72\x65\x56\xe2\x75\x3b\x1f\x00\xc2\x57\x9c\x48\xbc\xa1\x60\x39\x29\x54\x72\x35\xeb\xbb\x92\x87\xfa\xc5\xc8\xd8\xf5\xd9\xa1\xcb\x9e\xa3\x21\x0f\x6a\x1d\x53\x75\xc4\x86\xf9\x24\xb3\xba\xb2\xf9\xcb\x79\x89\x47\x9d\x6a\x27\x6b\x3d\x94\x9f\x0f\x92\xad\xd7\x53\xf9\xab\x59\x97\xd2\x45\x06\x00\xaf\xd9\x6b\xf6\x67\x00\x13\x10\x34\x8f\x94\x05\x00\x00"
+
+func issue_minter_capabilityCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_issue_minter_capabilityCdc,
+		"issue_minter_capability.cdc",
+	)
+}
+
+func issue_minter_capabilityCdc() (*asset, error) {
+	bytes, err := issue_minter_capabilityCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "issue_minter_capability.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2a, 0x65, 0xe8, 0xd, 0x53, 0x5c, 0xc1, 0x21, 0x29, 0x7, 0xa1, 0xa6, 0x78, 0x92, 0x9a, 0xbd, 0x41, 0x2b, 0x57, 0xc4, 0x1a, 0x24, 0x65, 0x3d, 0x5a, 0xbb, 0xc, 0x17, 0x51, 0xbf, 0xdd, 0xa4}}
+	return a, nil
+}
+
+var _mint_tokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\x4d\x6f\xc2\x46\x10\xbd\xfb\x57\x3c\x71\x88\x8c\x9a\xd8\x97\xaa\x07\x04\x89\x48\xda\xf4\xd4\x2a\xca\x47\xef\xeb\xf5\x00\xdb\xda\xbb\xd6\xee\x38\x04\x45\xf9\xef\xd5\xae\xd7\xc6\x26\x01\x84\x04\xb2\xdf\xbc\x37\xf3\xe6\x43\xd5\x8d\xb1\x8c\xc7\x56\x6f\x55\x51\xd1\xab\xf9\x8f\x34\x36\xd6\xd4\x98\x65\x59\x2e\x8d\x66\x2b\x24\xbb\x7c\x02\xc8\x64\x29\x67\x49\x0c\xfd\xe3\x43\xd4\xcd\x85\xc8\xf1\xfb\x2e\x30\xc9\xf3\x1c\xaf\x3b\xe5\xc0\x56\x68\x27\x24\x2b\xa3\xa1\x1c\xf6\x3b\xc1\xe0\x1d\xa1\x56\x9a\xc9\x62\x2d\xa5\x69\x35\xa3\x75\xe4\xc0\x26\x3c\x86\xa6\x3d\xd8\x93\xb9\xc8\x43\x07\x34\xd6\xbc\xab\x92\x42\xac\x25\xa9\x1a\x45\x9a\x21\xca\xd2\x92\x73\x10\xba\x84\xa8\x03\x53\x24\xb9\x0e\xcf\x3c\x7a\xc4\x24\x2c\x75\x09\x6d\xc8\x5a\x2a\xbd\xa0\x47\x0c\x2c\x1b\x9f\x92\x4f\x41\xe9\x6d\x92\x8c\x52\x4f\x07\xc9\x05\xd6\x1d\xfa\x3a\x0a\x2e\xf0\xf6\xa8\x3e\x7e\xfb\x75\x8e\xcf\x24\x01\x00\x9f\xf2\x33\x6d\xc8\x92\x96\xd4\x4b\x44\x8b\xd0\x79\xb8\x2e\x6b\xa5\xf1\x4c\xce\xb4\x56\x12\x4c\xf1\x2f\x49\x0e\xc1\x15\x71\x57\x7a\x80\x2c\x70\x35\xf1\x36\x3c\x54\x8e\xad\x60\x63\x2f\xa8\xf5\xad\x8c\x72\xcf\x24\x49\xbd\x93\x85\xd9\x4c\xfd\x9b\x4a\xf6\xb0\x05\xae\x3e\xa7\xc3\xd0\xbf\xf9\x3a\x6a\xbe\x06\x67\x59\x54\x70\x6d\xd3\x54\x87\xc0\xed\x59\x1c\x0a\xda\x18\x6f\xf4\x8e\x50\xb4\x56\x0f\x22\x1d\xf0\x3e\xbc\xed\x5d\xeb\x08\x1b\x4b\x8d\xb0\x94\x3a\xb5\xd5\x5e\x7f\xdd\xf2\x2e\x4e\x86\xb7\x15\xf1\xe3\xa8\xda\x64\x63\x16\xac\x30\xf1\x87\x0d\x8b\xea\x25\x00\x92\x21\x2a\xcf\x71\x6f\xac\x35\x7b\x08\xd8\x53\xa7\x84\x77\x74\xdc\x80\x41\xe7\xd8\x05\xac\xd0\x25\x96\x15\x81\x67\x79\xa1\x29\xb7\xa9\xdf\x8f\xc5\x34\xad\x80\x78\x61\x63\xc5\x96\x9e\x04\xef\xe6\x83\x92\xff\xde\xdd\xa1\x11\x5a\xc9\x74\xf6\x12\x54\xfc\x9a\x68\xd3\x6d\x49\x48\xa2\x4b\x72\x36\x9f\x94\xf4\xa7\xef\x9a\x9f\xdd\xb8\x40\xa7\xad\x0d\xe3\x5f\x9c\xab\x5b\x59\x8f\x0c\x43\xf1\x43\xd5\x7d\xbb\xb1\xc2\x96\x38\x36\xe2\xb8\x02\xd3\xf4\xb3\x2d\xf1\x83\x68\x44\xa1\x2a\xc5\x87\x74\x52\x78\x4f\xf4\xd4\x16\x95\x92\xdf\x4b\x1f\x0c\x3d\x37\x6f\xb7\xe9\x39\xaf\xde\xb4\x28\x2a\x6f\x50\x5f\x64\x5f\xcf\xb1\xd6\x59\x17\x1b\x87\x96\x3e\x48\xb6\x4c\xf8\x9c\xd8\xf8\x60\x49\x30\x41\xf4\xf7\xc8\xbb\xe6\xff\xf6\x57\xa3\x87\xfa\xbd\x8c\x90\xe5\xcd\xe9\x80\x64\x32\xb0\xfc\x4d\xfb\xbf\x02\x24\x15\x55\x65\xf6\x54\xae\xe3\x81\xe8\x0e\xc5\xfc\x3b\x59\xf9\x8f\x68\x2b\xc6\xf2\x26\x72\x67\xfe\x27\xcc\x8c\x4b\xc5\x49\xf0\x10\x9d\xe7\xf8\x9d\x1a\xe3\x54\x18\x80\xba\x9f\xe4\x50\x3f\x5d\x6e\x68\x56\x76\x81\x71\x48\x97\x37\xa3\x2c\x46\x0a\x25\x39\xb6\xe6\x10\x93\x1a\x9b\xd8\x18\xc7\xa3\x85\x3c\xb7\x7c\x58\xad\x7e\x58\xd6\x5f\x86\x8b\x39\xfb\x76\x3d\xea\xd6\x31\x0a\x82\xd2\xde\x4b\x47\x25\x8a\x83\x2f\x2f\x86\xcc\x12\x00\xf8\x4a\xbe\xfe\x1f\x00\x72\xee\xdb\x6b\xcd\x06\x00\x00"
 
 func mint_tokensCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -142,7 +327,7 @@ func mint_tokensCdc() (*asset, error) {
 	return a, nil
 }
 
-var _privateforwarderCreate_account_private_forwarderCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\xcb\x6e\xdb\x30\x10\xbc\xeb\x2b\xb6\x39\xb4\x36\x90\x48\xf7\x20\x29\x10\x04\xf1\xa9\x08\x8c\x26\xed\x7d\x4d\xaf\x2d\xc2\xb4\x28\x2c\x57\x56\x0c\xc3\xff\x5e\x50\x0f\x46\x94\xa3\x36\xa8\x2e\xb6\xa8\x99\xe1\xec\x70\xa8\xf7\xa5\x65\x81\x45\x55\x6c\xf5\xca\xd0\xab\xdd\x51\x01\x1b\xb6\x7b\xb8\x4a\xd3\x2c\x4d\x33\x65\x0b\x61\x54\xe2\xb2\x08\x93\xaa\xb5\xba\x4a\x3a\xf6\xd3\x1b\xee\xcb\xbf\x93\x87\x90\x88\xbb\x64\x7d\x40\xa1\x9f\xa4\x48\x1f\x88\x17\x96\x6b\xe4\x35\xf1\x84\xce\x14\xbc\xd5\x4c\xb2\x2c\x83\xd7\x5c\x3b\x10\xc6\xc2\xa1\x12\x6d\x0b\xd0\x0e\x2a\x47\x6b\x10\x0b\x8a\x09\x85\x00\xfd\x02\x7f\x73\xb0\x30\xb6\x06\x54\xca\x56\x85\x40\xad\x25\x07\x84\xb2\xdd\x02\x36\xbd\x76\x92\x0c\xd5\x4e\x49\x02\x00\xe0\x77\x7a\xa6\x1a\x1e\x3a\xb2\xe4\xe8\x15\x8c\x81\xdc\x9a\x35\x48\x3e\x14\xf0\x04\x43\x02\x05\xd5\x1d\xfe\x16\x1e\x2a\xc9\xbb\x97\x56\xb1\x64\x2a\x91\x69\x56\xe2\x91\x38\xfa\x3e\x87\x53\x83\xf0\x8f\x23\xb3\x49\xdf\x75\xe0\x7e\x08\xec\xb9\xcd\xcf\xbc\xe1\x9c\x5b\x71\x7a\x23\x55\x09\xf5\xee\xdb\x09\xe0\x05\x0f\x3e\x0c\xa6\x6d\x65\x90\xe1\x80\x95\x11\x1f\x93\x77\x5f\x50\x48\x66\x6a\xf3\xd4\xe1\x81\x66\x77\x37\xf1\xe1\x36\x11\x3f\xed\x4b\x39\xfe\xf6\x7a\xb3\xf9\x75\xe0\xfb\x47\xec\x6d\x54\x98\xb4\x41\xbd\x88\x65\xdc\xd2\x12\x25\x0f\xe8\x79\xe4\xf5\xb1\x3f\xba\xfe\x7c\xb8\xeb\x40\x00\xf9\x84\xfb\xc5\x47\x2c\x71\xa5\x8d\x96\x23\xdc\x5f\xd8\x36\xba\xd8\xdd\x7d\x3d\xc5\x85\xee\x2b\x75\xfe\x3e\x8b\xfc\x66\xdd\x7e\x19\x0d\x4c\xf7\xe0\xd1\x68\xc8\x5b\x92\x4f\x8f\xf7\x25\x9a\xef\x97\xa3\x26\xf7\xf1\x78\x51\x6d\x2f\xbb\x39\x1c\x3e\xac\xc2\xdd\xcd\xe4\xc5\xea\x0e\xe8\x99\xea\xb0\x34\x63\x52\xba\xd4\xe4\x6b\x79\x19\xe0\xfc\xb2\x32\x43\x9f\xef\x9b\x8a\x0d\x57\xc9\xb5\xf3\xfe\xab\x38\x81\x7b\xdd\xd4\x62\xd2\xf2\xe8\xc3\x20\xcd\xd8\xdc\x0f\x5d\xec\xe2\xab\xd7\x98\x0a\x5e\xcb\x61\xfe\x1f\xb7\x62\xd2\x43\xf8\x37\xea\xc7\x67\x4d\x2f\xab\x95\xd1\xca\x7b\xfe\xb8\x34\xff\x31\xfc\xf8\xa6\x9c\x93\xf3\x9f\x00\x00\x00\xff\xff\x0e\x37\x36\xd9\xd0\x05\x00\x00"
+var _privateforwarderCreate_account_private_forwarderCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\x41\x6f\xe2\x3c\x10\xbd\xe7\x57\xcc\xd7\xc3\xb7\x20\xb5\xc9\x1d\xd1\x95\xaa\xaa\x9c\x56\x15\xda\x76\xf7\x3e\x98\x81\x58\x18\x3b\x9a\x4c\x48\x11\xe2\xbf\xaf\x1c\x12\x13\xa7\x64\x77\xb5\xc2\x12\x60\xbf\xf7\x3c\xf3\xe6\x59\xef\x0b\xc7\x02\x8b\xca\x6e\xf5\xca\xd0\xbb\xdb\x91\x85\x0d\xbb\x3d\xdc\xa5\x69\x96\xa6\x99\x72\x56\x18\x95\x94\x59\x84\x49\xd5\x5a\xdd\x25\x2d\xfb\xe5\x03\xf7\xc5\xef\xc9\x7d\x48\xc4\x5d\xb2\x3e\xa0\xd0\x77\x52\xa4\x0f\xc4\x0b\xc7\x35\xf2\x9a\x78\x44\x67\x0c\x7e\xd1\x4c\xb2\x2c\x83\xf7\x5c\x97\x20\x8c\xb6\x44\x25\xda\x59\xd0\x25\x54\x25\xad\x41\x1c\x28\x26\x14\x02\xf4\x1b\xfc\xa5\x84\x85\x71\x35\xa0\x52\xae\xb2\x02\xb5\x96\x1c\x10\x8a\xcb\x15\xb0\xe9\xb4\x93\xa4\xaf\x76\x4a\x12\x00\x00\x7f\xd3\x2b\xd5\xf0\xd4\x92\x25\x47\xaf\x60\x0c\xe4\xce\xac\x41\xf2\xbe\x80\x27\x18\x12\xb0\x54\xb7\xf8\x19\x3c\x55\x92\xb7\x7f\x2e\x8a\x05\x53\x81\x4c\x93\x02\x8f\xc4\xd1\xf9\x14\x4e\x0d\xc2\xaf\x92\xcc\x26\xbd\xea\xc0\x63\x1f\xd8\x71\x9b\xaf\x69\xc3\x39\x5f\xc4\xe9\x83\x54\x25\xd4\x55\xef\x3f\x59\x06\x6f\x78\xf0\x66\x30\x6d\x2b\x83\x0c\x07\xac\x8c\x78\x9b\x7c\xf5\x96\x82\x33\x63\x97\xa7\x25\x1e\x68\x32\x7f\x88\x87\xdb\x58\xfc\xb2\x2f\xe4\xf8\xd3\xeb\x4d\xa6\xf7\x81\xef\x97\xb8\x19\x44\x84\x06\xf5\x26\x8e\x71\x4b\x4b\x94\x3c\xa0\xa7\x51\xad\xcf\xdd\xe8\xba\xf9\x70\x9b\x81\x00\xf2\x0e\x77\x9b\xcf\x58\xe0\x4a\x1b\x2d\x47\x78\xfc\x54\xb6\xd1\x76\x37\xff\xff\x14\x07\xba\x8b\xd4\xf9\xeb\x24\x28\xfa\x95\xb5\xf7\x65\xd4\x2b\xba\x03\x0f\x5a\x43\xde\x92\xfc\x75\x7b\xff\x45\xfd\xfd\x28\xa9\x49\xcd\xb0\xbd\x28\xb6\x9f\xb3\xd9\x29\xf8\xe6\xc3\x2e\xcc\x1f\x60\xfc\xa5\x34\x46\xbe\x52\x1d\xb6\x26\x4c\x4a\x17\x9a\xac\xcc\x6e\x18\x18\x8f\xa1\x89\x4c\xbf\xce\xeb\xa5\xe2\xc2\x53\x2a\x2f\xe3\xfc\x53\x70\x02\xf7\xbe\x89\xc5\x68\xc9\x83\x83\x9e\x9b\x71\x71\xdf\xb4\xdd\xc5\x4f\xcf\xbb\x77\xb5\xad\xe8\xfb\x7f\x3b\x15\xa3\x35\x84\x5f\x83\x7c\x8c\x12\x06\x07\xcb\x6a\x65\xb4\xf2\x01\xbf\x1d\x9a\x7f\x68\x3e\xe8\x4c\x93\x04\x00\xe0\x9c\x9c\x7f\x0d\x00\x0e\x37\x36\xd9\xd0\x05\x00\x00"
 
 func privateforwarderCreate_account_private_forwarderCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -162,7 +347,7 @@ func privateforwarderCreate_account_private_forwarderCdc() (*asset, error) {
 	return a, nil
 }
 
-var _privateforwarderCreate_private_forwarderCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x92\xcf\xca\xdb\x30\x10\xc4\xef\x7a\x8a\xe5\x3b\xb4\xfe\x20\xb1\xef\x26\x2d\x84\xd2\x1c\x4b\x68\x43\xef\x6b\x79\x6b\x8b\x38\x92\x59\xaf\x9d\x06\x93\x77\x2f\xf2\x3f\xec\x24\x2e\xa5\x3e\x18\x21\xcd\xfc\x46\x1a\xc9\x5c\x4a\xc7\x02\x87\xda\x66\x26\x29\xe8\xe4\xce\x64\xe1\x17\xbb\x0b\xbc\x85\x61\x14\x86\x91\x76\x56\x18\xb5\x54\xd1\x42\x13\xea\x54\xbf\xa9\xc1\xfd\xf5\x37\x5e\xca\xbf\x9b\xe7\x92\x85\xf7\xc8\xa6\x41\xa1\xef\xa4\xc9\x34\xc4\x07\xc7\x57\xe4\x94\x78\x85\xb3\x26\xef\x99\x2a\x8a\xe0\x94\x9b\x0a\x84\xd1\x56\xa8\xc5\x38\x0b\x9a\x09\x85\x2a\x40\xb0\x74\x85\xb2\x07\x00\x0f\x04\x30\x16\xd0\x02\x6a\xed\x6a\x2b\x20\x39\x0a\x78\x4c\xea\xa8\xb2\x1f\x05\xb0\x60\xc2\xf4\x06\x39\x36\x04\xf8\x6c\x77\xec\x67\xeb\xa4\x30\x1a\xa4\x2b\x60\x5c\xf2\x94\xa4\x96\x8e\xf4\x88\xf9\x89\x75\x21\x4a\xcd\xb7\xd9\x2a\x05\x00\x50\x32\x95\xc8\x14\x54\x26\xb3\xc4\x31\xec\x6b\xc9\xf7\xfd\xe6\xde\xa1\xed\x24\xfe\x1b\x43\xbe\x60\x89\x89\x29\x8c\xdc\xe0\x13\xf4\x9e\xb0\x30\xf6\xbc\xfb\xb0\x68\xbc\xcb\x6b\x97\x37\x38\x76\x78\xff\x1c\x4c\x58\xff\x45\xc3\x19\x23\x9a\x11\x46\xf1\x66\x21\x15\xe4\x8c\x24\x86\xe7\xac\x1f\xe2\x18\x33\x3a\xa2\xe4\x93\xe3\x5d\x4d\xc3\x82\x04\x1a\xaf\x83\xdd\x76\xf5\x09\x84\xfd\xcd\x7d\xa3\xeb\x34\x15\x30\x69\x53\x1a\xb2\x12\xbf\xa8\x60\x16\x30\x54\x51\x61\x43\xc1\x6e\xdb\x45\x6d\x40\x5c\xbc\x1e\xf6\xb0\x30\x3b\xc0\x33\xb6\x6f\xb8\x5d\x65\x4d\xa3\xc7\x6e\xff\x35\xfd\xd8\x3d\x28\x1f\xfe\xba\xf0\xff\x38\xc5\xec\x1a\xfc\xff\xae\xee\xea\x4f\x00\x00\x00\xff\xff\xbf\x3d\xfd\xff\xfd\x03\x00\x00"
+var _privateforwarderCreate_private_forwarderCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x92\x41\x8b\xdb\x30\x10\x85\xef\xfa\x15\x8f\x3d\xb4\x09\xec\xda\xf7\x90\x16\x96\xd2\x3d\x96\xd0\x2e\xbd\x4f\x94\x69\x2c\xd6\x91\xcc\x78\xec\x74\x31\xf9\xef\x45\xb6\x63\x6c\x27\xee\x61\xc1\x07\x21\xbd\xf7\x3d\xcd\x93\xdd\xa9\x08\xa2\x78\xa9\xfc\xd1\xed\x73\x7e\x0d\x6f\xec\xf1\x47\xc2\x09\x0f\x49\x92\x26\x49\x6a\x83\x57\x21\xab\x65\x3a\xd1\x24\xf6\x60\x1f\x4c\xef\xfe\xfe\x97\x4e\xc5\xff\xcd\x63\xc9\xc4\xbb\x13\x57\x93\xf2\x4f\xb6\xec\x6a\x96\x97\x20\x67\x92\x03\xcb\x02\x67\x49\xde\x31\x4d\x9a\xe2\x35\x73\x25\x54\xc8\x97\x64\xd5\x05\x0f\x2b\x4c\xca\x25\x08\x9e\xcf\x28\x3a\x00\xa4\x27\xc0\x79\x90\x07\x59\x1b\x2a\xaf\xd0\x8c\x14\x11\x73\x08\x5c\xfa\xcf\x0a\xca\x85\xe9\xf0\x8e\x8c\x6a\x06\xdd\xda\x83\xc4\xdd\x6a\x9f\x3b\x0b\x8d\xd3\x0d\x47\x91\xb2\xaf\xb4\x25\xcd\x31\xbf\xa9\xca\xd5\x98\xf1\x35\x1b\x63\x00\xa0\x10\x2e\x48\x78\x55\xba\xa3\x67\xd9\xe0\xb9\xd2\xec\xb9\xbb\xdc\x1a\x4d\x2b\x89\xdf\x35\xe4\x1b\x15\xb4\x77\xb9\xd3\x77\x7c\x41\xe7\x49\x72\xe7\xdf\xb6\x9f\x26\x8d\xb7\x79\xcd\xf4\x05\xaf\x1d\x5e\xbe\xae\x06\x6c\xfc\xd2\x7e\xc6\x94\x47\x84\xab\xf8\x71\x22\x55\x92\x23\xeb\x06\xb7\x59\xbf\x34\x08\x1d\x79\x47\x9a\x0d\x8e\xb5\x19\x96\x39\x2b\xea\xa8\xc3\xf6\x09\xcb\x6f\xda\xbe\xdc\x0f\x3e\x0f\x5b\x2b\x61\xeb\x0a\xc7\x5e\x37\x77\x2a\x18\x05\xf4\x55\x94\x54\xf3\x6a\xfb\xd4\x46\x3d\x42\xc3\x66\x39\x6c\x76\x30\x1a\xe0\x16\xdb\x35\xdc\x2c\xb2\x86\xd5\xbc\xdb\x45\xc7\xec\x60\xd7\xfe\x50\xb1\xbd\xfb\x85\x7f\x60\x8a\x81\xb3\x36\x00\x70\x31\x17\xf3\x6f\x00\xbf\x3d\xfd\xff\xfd\x03\x00\x00"
 
 func privateforwarderCreate_private_forwarderCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -182,7 +367,7 @@ func privateforwarderCreate_private_forwarderCdc() (*asset, error) {
 	return a, nil
 }
 
-var _privateforwarderDeploy_forwarder_contractCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\x4d\x4b\x03\x31\x10\x86\xef\xf9\x15\x73\xdc\x42\xe8\x5e\x65\x6f\x05\x11\xbc\x48\xa1\x7a\x12\x0f\x63\x32\xee\x0e\xb6\x33\x61\x32\x4b\x11\xe9\x7f\x97\xed\x17\xab\x5e\x9a\x43\x48\x78\x9f\x64\xde\xa7\x6d\x5b\xb8\xa7\xb2\xd5\xaf\x0a\x3e\x10\x3c\xeb\x27\xc9\x83\xda\x1e\x2d\xb3\xf4\x90\x54\xdc\x30\x39\xec\xd9\x87\x23\x51\x0b\x25\xfe\x60\xca\xc0\xc2\x0e\x05\x0d\x77\xe4\x64\x35\x04\x37\x94\x8a\xc9\x59\xa5\xb9\x3c\x7c\xc2\x1d\x75\xb0\x71\x63\xe9\x63\x80\xd9\x4a\x9a\xa9\x83\xd7\x97\x47\xf1\xbb\xb7\xdf\x51\x25\xc9\x64\x1b\x57\xc3\x9e\xd6\xe8\xc3\xf4\xc3\xf5\xf2\x87\xbd\x89\x2a\xe3\xfb\x96\xd3\x09\x5a\x5f\xcf\x0b\xf8\x0e\x01\xa0\x18\x15\x34\x6a\x2a\xf7\x42\xd6\xc1\x6a\xf4\x61\x95\x92\x8e\xe2\x67\x02\xe0\x94\x2d\x2f\x5a\x75\x89\x39\x37\x72\x94\x9b\xab\xc6\xb3\xd6\xb4\xc7\xff\x1e\x71\x5e\x37\xce\x5a\x2d\xa6\x29\x87\x70\x08\x10\x7e\x02\x00\x00\xff\xff\xf7\x8a\xb2\xb8\x93\x01\x00\x00"
+var _privateforwarderDeploy_forwarder_contractCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\x3f\x4b\x03\x41\x10\xc5\xfb\xfd\x14\xaf\xbc\xc0\x91\x6b\xe5\xba\x80\x08\x36\x12\x88\x56\x62\x31\xee\x8e\x77\x83\xc9\xec\x32\x3b\x47\x10\xc9\x77\x17\xf3\x47\x4e\x6d\xb2\xc5\x32\xc3\xfb\x0d\xbc\x5f\xd7\x75\xb8\xe5\xb2\xcd\x1f\x15\x3e\x32\x1e\xf3\x3b\xeb\x5d\xb6\x3d\x59\x12\x1d\x10\xb3\xba\x51\x74\xec\xc5\xc7\x23\x51\x0b\x47\x79\x13\x4e\x10\x15\x47\x21\xa3\x1d\x3b\x5b\x0d\xc1\x8d\xb4\x52\x74\xc9\xda\x5c\x0e\x1f\x68\xc7\x3d\x36\x6e\xa2\x43\x1b\x30\x7b\x31\x27\xee\xf1\xfc\x74\xaf\x7e\xf3\xf2\x3b\xaa\xac\x89\x6d\xe3\xd9\x68\xe0\x35\xf9\xd8\x63\xb6\xfc\x61\xaf\xa2\xca\xf4\xba\x95\x78\x82\xd6\x3f\xf3\x02\x9f\x21\x00\xc5\xb8\x90\x71\x53\x65\x50\xb6\x1e\xab\xc9\xc7\x55\x8c\x79\x52\x3f\x13\xc0\x29\x5b\x5e\xb4\xea\x92\x52\x6a\xf4\x28\x37\x57\x6d\xcf\x5a\xdf\x7f\xfb\xdf\xa3\x9d\xd7\x6d\x67\xad\x16\x21\x00\x87\x70\x08\x08\x5f\x03\x00\xf7\x8a\xb2\xb8\x93\x01\x00\x00"
 
 func privateforwarderDeploy_forwarder_contractCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -202,7 +387,7 @@ func privateforwarderDeploy_forwarder_contractCdc() (*asset, error) {
 	return a, nil
 }
 
-var _privateforwarderSetup_and_create_forwarderCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xc1\x6e\xa3\x40\x0c\xbd\xf3\x15\x56\x0f\x15\x48\x29\xdc\x2b\x5a\xa9\x5b\xb5\xc7\x55\xb4\x5b\xed\xdd\x19\x1c\x18\x85\xcc\x20\x63\x92\x46\x51\xfe\x7d\xc5\x84\x10\x26\x84\x6e\x76\xb5\x9c\xa2\xd8\x7e\x7e\xf6\x7b\x9e\x40\xaf\x2b\xcb\x02\xef\x8d\xc9\xf5\xa2\xa4\x0f\xbb\x22\x03\x4b\xb6\x6b\xb8\x8b\xe3\x24\x8e\x13\x65\x8d\x30\x2a\xa9\x13\x2f\x27\x56\x99\xba\x3b\x55\xbf\x7d\xe2\xba\xfa\xba\x78\x98\xe2\xd5\xce\x59\x6f\x50\xe8\x07\x29\xd2\x1b\xe2\x77\xcb\x5b\xe4\x8c\x78\x02\x67\x2a\xfd\x88\x19\x24\x49\x02\x1f\x85\xae\x41\x18\x4d\x8d\x4a\xb4\x35\x80\x59\x56\x03\xc2\x2f\x6c\x4a\x99\x01\x42\x75\xc4\x00\xee\x40\x60\x79\x42\x71\xf5\x08\x0b\x2c\xd1\x28\x02\x85\x15\x2e\x74\xa9\x65\x37\x03\x34\x59\x5b\xda\x2c\x4a\xad\x06\x81\xb6\x16\xa4\x38\x83\x05\xc1\xb0\xf5\x3e\x08\x00\x00\x2a\xa6\x0a\x99\xc2\x5a\xe7\x86\xf8\x11\x5e\x1a\x29\x5e\x94\xb2\x8d\x91\x08\xf6\x2e\xa5\xfd\xf4\x12\x8e\x19\x71\x4e\xf2\xda\xf7\x48\xef\x27\xa7\xee\x7f\x3d\x87\x93\x39\x17\x81\xb9\x1b\x61\x8e\x52\x44\xb1\x2a\x48\xad\xc2\x21\x85\xf6\x4b\x92\x7e\x45\xfd\x66\x60\x8b\x35\x60\xc9\x84\xd9\x0e\x6a\x12\x68\x2a\xaf\x86\x49\x1a\x36\xfd\x5f\x87\xe0\xca\x50\x0b\xcb\x6c\xb7\xe9\xbd\xe7\x05\xa7\xca\x73\xd8\xaa\xfd\x08\xe3\xc8\x4f\xb1\x8c\x39\x39\xba\xf0\xf4\x04\x46\x97\x63\xb6\xaf\x4c\x2d\x59\x04\x43\x5b\xdf\x8c\x0e\xc3\x69\x57\x35\x02\x5a\x40\x1b\xa8\x8f\x90\x1e\x48\xc7\xb0\xc6\x0d\x85\x5e\xa0\xfd\xd2\x07\xdf\xbd\xae\xdb\xdb\xba\x92\x9d\x83\x0f\xa3\xd9\xa8\x44\xec\x1f\x86\xf1\x2a\xa2\x6b\x7b\xeb\x28\x95\xda\xac\xd2\xfb\xbd\x7f\x7c\x27\x2d\x0f\xcf\x3e\xdb\xa4\xd3\x2d\xa1\x41\xef\x53\xb2\xcf\x52\x90\x73\x92\x5b\x59\x46\x67\x5e\x25\x49\x6f\xf6\xb3\x47\xe1\x69\xc2\xba\xd3\xcc\xbf\x24\x3b\x68\x38\x14\x78\x7c\x7f\x62\xdd\xf9\x1d\x85\x96\x02\x05\xac\x29\x77\x40\x9f\x95\xad\xa9\x1e\x82\xb4\x69\xa7\xcb\x5e\x6a\x2a\x33\x90\x82\x6d\x93\x17\x2e\xf2\xad\x8b\x68\x23\xc4\x4b\x54\x74\x5d\x88\xf1\xba\x2e\x06\xec\x70\x2e\x95\xf1\xea\xba\x9c\xf3\x25\xfe\x47\x69\xce\x17\x9b\x3e\x4c\x3e\xae\x9d\x87\xbf\xd3\xb6\xff\x2b\x64\x52\xba\xd2\x64\xe4\xf1\x8a\xbc\xd1\xc8\x97\xee\x54\xd2\x87\xbe\xdd\xcc\x79\xfe\xd6\x47\x68\x78\xd6\x13\x96\xbf\xe5\xcd\xf3\xd6\xf6\xf7\x0f\xe0\xf5\xb5\xff\xc3\x0c\x81\x7f\xc9\x87\xe0\x10\xfc\x0e\x00\x00\xff\xff\x4d\x81\x6e\xef\x5a\x07\x00\x00"
+var _privateforwarderSetup_and_create_forwarderCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xc1\x6e\xa3\x40\x0c\xbd\xf3\x15\x56\x0f\x15\x48\x29\xdc\x2b\x5a\xa9\x5b\xb5\xc7\x55\xb4\x5b\xed\xdd\x19\x1c\x18\x85\xcc\x20\x63\x92\x46\x51\xfe\x7d\xc5\x40\x08\x43\x42\x77\xb5\x5a\x25\x07\x84\xfd\x9e\x9f\xfd\x6c\x02\xbd\xad\x2c\x0b\xbc\x37\x26\xd7\xab\x92\x3e\xec\x86\x0c\xac\xd9\x6e\xe1\x2e\x8e\x93\x38\x4e\x94\x35\xc2\xa8\xa4\x4e\xbc\x9c\x58\x65\xea\xee\x8c\x7e\xfb\xc4\x6d\xf5\x35\x78\x9c\xe2\x61\x97\xac\x77\x28\xf4\x83\x14\xe9\x1d\xf1\xbb\xe5\x3d\x72\x46\x3c\xc3\x33\x97\xde\x71\x06\x49\x92\xc0\x47\xa1\x6b\x10\x46\x53\xa3\x12\x6d\x0d\x60\x96\xd5\x80\xf0\x0b\x9b\x52\x16\x80\x50\x75\x1c\xc0\x3d\x09\xac\xcf\x2c\x0e\x8f\xb0\xc2\x12\x8d\x22\x50\x58\xe1\x4a\x97\x5a\x0e\x0b\x40\x93\xb5\xd0\x66\x55\x6a\x35\x0a\xb4\x58\x90\xe2\x42\x16\x04\xe3\xd2\xc7\x20\x00\x00\xa8\x98\x2a\x64\x0a\x6b\x9d\x1b\xe2\x47\x78\x69\xa4\x78\x51\xca\x36\x46\x22\x38\xba\x94\xf6\xaf\xd7\xd0\x65\xc4\x39\xc9\xeb\x50\x23\xbd\x9f\xed\x7a\x78\x7a\x0e\x67\x73\x26\x81\xa5\x6b\x61\x89\x52\x44\xb1\x2a\x48\x6d\xc2\xb1\x84\xf6\x97\x24\xc3\x88\x86\xc9\xc0\x1e\x6b\xc0\x92\x09\xb3\x03\xd4\x24\xd0\x54\x1e\x86\x49\x1a\x36\xc3\xab\x53\x70\xa3\xa9\x95\x65\xb6\xfb\xf4\xde\xdb\x05\xe7\xca\x73\xd8\xba\xfd\xe8\x2d\x52\x17\xf9\x29\x96\x31\x27\x27\x17\x9e\x9e\xc0\xe8\xf2\x5a\xed\x2b\x13\x0a\x01\x82\xa1\xbd\xbf\x8c\x8e\xc3\x79\x57\x35\x02\x5a\x40\x1b\xa8\x3b\x4a\x8f\xa4\x57\x58\xe3\x8e\x42\x2f\xd0\xfe\xd3\x07\x4f\x97\x72\xd5\xde\xb6\x95\x1c\x1c\x7d\x18\x2d\xae\x20\x62\xff\xd0\x8c\x87\x88\x6e\xcd\xad\x97\x54\x6a\xb3\x49\xef\x8f\xfe\xf1\x9d\xbd\x3c\x3d\xfb\x6a\x93\xde\xb7\x84\x46\xb5\xcf\xc9\xbe\x4a\x41\xce\x49\xfe\x56\x65\x74\xd1\x55\x92\x0c\xcb\x7e\xd9\x51\x78\x9a\x59\xdd\x79\xe5\x5f\x8a\x1d\x15\x1c\x1b\x7c\x7d\x7f\x62\xdd\xf9\x39\x27\x40\x0a\x14\xb0\xa6\x3c\x00\x7d\x56\xb6\xa6\x7a\x4c\xd2\xa6\x9d\x2f\x7b\xad\xa9\xcc\x40\x0a\xb6\x4d\x5e\x38\x82\x6f\x7d\x44\x1b\x21\x5e\xa3\xa2\xdb\x46\x5c\x8f\x6b\xd2\x60\xcf\x33\x75\xc6\xc3\xf5\x39\x97\x4b\xfc\x8f\xd6\x5c\x2e\x36\x7d\x80\xd9\x6f\x42\xb7\xc3\xdf\x69\x3f\xbc\x0a\x99\x94\xae\x34\x19\x79\xbc\x61\x6f\x14\x4c\xc7\xe1\x4e\x25\x7d\x18\xca\x2d\xdc\xce\xcf\x16\x9c\x04\x46\x8d\x5c\x53\x77\x2b\x3f\x4b\x35\x3c\x4d\x46\x3c\x0b\x98\xfd\x00\xde\x1e\xfb\x3f\xf4\x30\xf0\x44\x01\x00\xc0\x29\x38\x05\xbf\x07\x00\x4d\x81\x6e\xef\x5a\x07\x00\x00"
 
 func privateforwarderSetup_and_create_forwarderCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -222,7 +407,7 @@ func privateforwarderSetup_and_create_forwarderCdc() (*asset, error) {
 	return a, nil
 }
 
-var _privateforwarderTransfer_private_many_accountsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x6e\xd3\x40\x10\x3d\xd7\x5f\x31\xcd\xa1\x24\x12\xac\x2f\x88\x83\x55\xa8\x22\x44\x38\x21\x55\x6d\xe1\x82\x38\x6c\x76\xc7\xf6\xaa\xce\x8e\x35\x3b\x4e\x52\x55\xf9\x77\x64\xef\x26\x8a\x09\x21\x17\x4e\xb6\xd6\xef\xbd\x7d\xef\xcd\xd8\xad\x5a\x62\x81\x45\xe7\x2b\xb7\x6c\xf0\x89\x9e\xd1\x43\xc9\xb4\x82\x89\x52\xb9\x52\xb9\x21\x2f\xac\x8d\x84\x7c\x84\x51\xc6\x9a\x49\x96\xd8\x5f\xb6\x7a\xd5\xfe\x9b\x7c\x0c\x19\x71\xef\xd9\xad\xb5\xe0\x03\x1a\x74\x6b\xe4\x05\xf1\x46\xb3\x45\x3e\xa3\x73\x0e\x1e\x35\xb3\x3c\xcf\xe1\xa9\x76\x01\x84\xb5\x0f\xda\x88\x23\x1f\xdf\x4b\xe4\x00\x42\xb0\xd2\xfe\x05\xb4\xb5\x8c\x21\x60\x00\xa9\x99\xba\xaa\x06\xa9\xd1\x31\xb4\x51\x1d\x38\xc9\x87\x2c\x3b\x12\x9a\x26\xda\x7c\x45\x9d\x97\x6f\xba\x2d\xe0\x75\x1e\x8f\x0a\xf8\xbe\x70\xdb\x0f\xef\x77\x33\x78\xcd\x32\x00\x80\xc1\x08\xc2\x0f\xdd\x35\x02\x8c\x81\x3a\x36\x08\x52\x6b\x81\x9a\x1a\xdb\xdf\x8c\x20\x7d\x1d\x21\x9e\x6a\x46\x58\xa2\xf3\xd5\xc1\x2f\xa3\x1d\xa4\x1a\x14\x58\xf7\x3a\x0f\x58\x16\x70\x33\xaa\x72\xd0\xcf\x0e\xb0\x14\x20\xd5\xe2\x7c\xf5\x88\xde\x22\x17\x70\x73\xb6\xb8\x88\x88\x12\x2d\x63\xab\x19\xa7\xc1\x55\xbe\x67\xcd\x3b\xa9\xe7\xc6\xf4\x71\x0f\xc1\x52\xb8\xaf\x28\xa0\x81\xb1\x44\x46\xdf\x27\xa3\x21\x51\x64\xbe\x09\x10\x84\x18\x6d\xf4\x7d\xe0\x05\x6c\x4a\xb5\x8f\x02\x1f\x13\x5a\x2d\x89\x99\x36\xb7\x7f\x49\xf6\x69\xda\xaf\x41\x01\xa7\x5f\x1e\x85\x58\x57\x78\xaf\xa5\x9e\x65\x57\x57\x57\x77\x77\xd0\x6a\xef\xcc\x74\xf2\x99\xba\xc6\x82\x27\x81\xa8\x7b\x6a\x92\x36\xd1\xe3\x20\x74\x3d\x99\x65\x63\x83\x67\x4a\x3c\xf5\x7b\xa1\xd3\xbd\xf9\x0b\xb0\xff\x9f\x64\x17\x1f\xb8\x45\xd3\x09\x1e\x0f\xae\x24\xde\x2f\x3f\x38\x0f\x7f\x2e\xb4\x7a\xc6\x97\x70\x8c\xbf\xd4\x89\x0a\xe8\x6d\xca\x37\x0c\x27\xec\x7f\x92\xb7\x69\xb9\x0b\xb8\x7d\x37\x1a\xbb\xda\x38\xa9\x2d\xeb\xcd\x54\x0f\xd7\x16\x27\x2e\x7e\xa6\x83\x5f\xd7\xb3\xa3\xd1\xec\x52\xb4\x5d\xf6\x3b\x00\x00\xff\xff\x1c\xeb\xe8\xe2\xb4\x04\x00\x00"
+var _privateforwarderTransfer_private_many_accountsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x6e\xda\x40\x14\x3c\xe3\xaf\x98\x70\x48\x41\x6a\xed\x4b\xd5\x83\x95\x36\x42\x55\xe9\xa9\x52\x94\xa4\xbd\x54\x3d\x2c\xbb\xcf\xf6\x2a\x66\xd7\x7a\xfb\x0c\x44\x11\xff\x5e\xd9\x6b\x28\x94\x52\x2e\x39\x61\x2d\x33\xb3\x33\xf3\xde\xda\x65\xe3\x59\x30\x6f\x5d\x69\x17\x35\x3d\xfa\x27\x72\x28\xd8\x2f\x31\x4e\xd3\x2c\x4d\x33\xed\x9d\xb0\xd2\x12\xb2\x23\x4c\xaa\x8d\x1e\x27\x03\xfb\xcb\x46\x2d\x9b\xff\x93\x0f\x21\x47\xdc\x3b\xb6\x2b\x25\x74\x4f\x9a\xec\x8a\x78\xee\x79\xad\xd8\x10\x9f\xd1\x39\x07\x8f\x9a\x49\x96\x65\x78\xac\x6c\x80\xb0\x72\x41\x69\xb1\xde\xc5\xef\x82\x38\x40\x3c\x96\xca\x3d\x43\x19\xc3\x14\x02\x05\x48\xc5\xbe\x2d\x2b\x48\x45\x96\xd1\x44\x75\xf0\x20\x1f\x92\xe4\x40\x68\x32\xd0\x66\x4b\xdf\x3a\xf9\xa6\x9a\x1c\x2f\xb3\x78\x94\xe3\xfb\xdc\x6e\x3e\xbc\xdf\x4e\xf1\x92\x24\x00\xd0\x1b\x21\xfc\x50\x6d\x2d\x60\x0a\xbe\x65\x4d\x90\x4a\x09\x2a\x5f\x9b\xee\x66\x82\x74\x75\x74\x9f\x4a\xa0\x98\xb0\x20\xeb\xca\xbd\x5f\x26\xd3\x4b\xd5\x24\x58\x75\x3a\xf7\x54\xe4\xb8\x3e\xaa\xb2\xd7\x4f\xf6\xb0\x21\xc0\x50\x8b\x75\xe5\x03\x39\x43\x9c\xe3\xfa\x6c\x71\x11\x11\x25\x1a\xa6\x46\x31\x4d\x82\x2d\x5d\xc7\x9a\xb5\x52\xcd\xb4\xee\xe2\xee\x83\x0d\xe1\xbe\x92\x40\x81\xa9\x20\x26\xd7\x25\xf3\x7d\xa2\xc8\x7c\x13\x10\xc4\x33\x99\xe8\x7b\xcf\x0b\x54\x17\xe9\x2e\x0a\x3e\x0e\xe8\x74\xe1\x99\xfd\xfa\xe6\x1f\xc9\x3e\x4d\xba\x35\xc8\x71\xfa\xcf\x83\x78\x56\x25\xdd\x29\xa9\xa6\xc9\x68\x34\xba\xbd\x45\xa3\x9c\xd5\x93\xf1\x67\xdf\xd6\x06\xce\x0b\xa2\xee\xa9\x49\xbf\x8e\x1e\xfb\x2b\xae\xc6\xd3\x3f\xc1\x7a\x83\x67\x4a\x3c\xf5\x7b\xa1\xd3\x9d\xf9\x0b\xb0\xd7\x4f\xb2\x8d\x3f\xb4\x21\xdd\x0a\x1d\x0e\xae\xf0\xbc\x5b\x7e\x58\x87\xbf\x17\x3a\x7d\xa2\xe7\x70\x88\xbf\xd4\x49\x1a\xc8\x99\x21\x5f\x3f\xb6\xb0\x7b\x24\x6f\x87\xe5\xce\x71\xf3\xee\x68\xec\xe9\xda\x4a\x65\x58\xad\x27\xaa\x7f\x47\xf9\x89\x8b\x9f\xc3\xc1\xaf\xab\xe9\xc1\x68\xb6\x09\x00\x6c\x93\x6d\xf2\x7b\x00\x1c\xeb\xe8\xe2\xb4\x04\x00\x00"
 
 func privateforwarderTransfer_private_many_accountsCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -242,7 +427,107 @@ func privateforwarderTransfer_private_many_accountsCdc() (*asset, error) {
 	return a, nil
 }
 
-var _scriptsGet_balanceCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x3f\x4f\xc3\x30\x10\xc5\x77\x7f\x8a\xa7\x0c\x90\x2e\xce\x82\x18\x2a\x4a\x55\x2a\x3a\x57\xa8\xb0\x3b\xce\xa5\xb5\x70\xec\xc8\x3e\xd3\xa2\xaa\xdf\x1d\xe5\x4f\x0b\x59\xc8\x14\xc9\xef\xf7\xd3\xdd\xbb\xa2\xc0\xee\x60\x22\xa2\x0e\xa6\x65\x04\x52\x55\x04\x1f\x08\xa5\xb2\xca\x69\x42\x6d\xc8\x56\xf0\x35\x94\x83\xd2\xda\x27\xc7\xf7\x11\x1b\xeb\x8f\x3b\xff\x49\x0e\x2f\x43\x4e\x08\xd3\xb4\x3e\x30\x36\xc9\xed\x4d\x69\x69\x78\xad\x83\x6f\x90\x49\x59\x48\x59\x68\xef\x38\x28\xcd\xb1\x98\x64\xa4\xae\x74\x76\xa5\x5f\x4f\xaa\x69\xff\x87\xff\x46\x06\x56\xb4\xa9\x44\x9d\x1c\x1a\x65\x5c\x3e\x0e\x39\xc7\xaa\xaa\x02\xc5\x38\x9b\xe3\x7d\x63\x4e\x8f\x0f\x38\x0b\x00\xb0\xc4\xdd\x22\x8c\x05\xf6\xc4\xab\x21\x7d\xa5\x66\xb7\xc8\x97\x4a\x96\xdf\xa8\xc6\xa2\x4f\xcb\x3d\xf1\x5a\xb5\xaa\x34\xd6\xf0\x77\x3e\x19\x62\xac\x60\x9b\x4a\x6b\xf4\x56\xf1\x61\xb0\x74\x9f\x2c\x7d\x08\xfe\xf8\x74\x37\x01\x3e\x3a\xf7\x79\xda\xc2\x28\xb9\x3c\xe7\xbf\xf4\x72\x89\x56\x39\xa3\xf3\x6c\xed\x93\xad\xe0\x3c\x63\x10\x5e\x6b\x47\xa0\x9a\x02\x75\x7f\xec\xfb\xbb\xf5\xee\x6c\x26\x7a\x49\x20\x4e\xc1\xdd\x76\x91\xe3\x51\xc5\x45\xfc\x04\x00\x00\xff\xff\xc9\xee\xfc\xac\xf8\x01\x00\x00"
+var _publish_receiverCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x4d\x8b\xdb\x30\x10\xbd\xfb\x57\x3c\x72\x68\xb3\x10\xe4\x7b\xe8\x2e\xa4\xd0\x9e\x97\x36\xf4\x3e\x91\x27\xf6\xb0\xb2\x24\xa4\xf1\x7e\x10\xf2\xdf\x8b\xa2\xc6\xc4\x10\xba\x30\x27\x69\xde\xd3\x7b\x6f\x46\x6d\x8b\xfd\x20\x19\x9a\xc8\x67\xb2\x2a\xc1\x23\x4e\x07\x27\x79\xe0\x0c\x82\xa5\x48\x07\x71\xa2\x1f\xd0\x00\x1d\x18\x59\x7a\xcf\xe9\x6b\xc6\x8f\x77\x1a\xa3\xe3\x7d\x78\x61\x8f\x3f\x34\x39\x6d\xda\x16\xa4\x05\x35\x65\x0d\x63\x25\xb2\x88\xa4\xc3\x06\x6c\x7a\x53\x38\x3c\x8d\x9c\x23\x59\x86\x28\x8e\x21\x81\x90\xc5\xf7\x8e\x41\x31\x3a\xb1\x54\x34\x98\xa6\x6d\x0b\xdb\x7e\xe0\x5b\x09\xfc\x1e\x43\xe6\x7c\xd1\xd1\x71\x0c\xb9\x50\x4c\xbe\xca\x26\xdf\x5d\x2e\x0e\xe4\xc8\x5b\xc6\x51\xd8\x75\xe6\xca\x52\x65\x63\x9c\xb2\x82\x5c\x62\xea\x3e\x30\xd0\x2b\x23\xb3\x62\x8a\x05\x29\x09\x64\x6d\x98\xbc\xe2\x4d\x74\x28\x47\xc8\x4a\xbe\xa3\xd4\x55\x83\xa6\x69\x64\x8c\x21\x29\x7e\x4e\xbe\x97\xc3\xd5\xfd\x31\x85\x11\x2b\xd3\x1a\xd3\xda\xe0\x35\x91\xd5\xdc\x2e\x5a\x8c\xed\xec\xea\x0a\x5e\x24\x77\x17\x7b\xdb\x51\xa1\xcd\xcd\x84\xd6\x25\xd1\x2d\x9e\x2f\xf1\x3e\x93\x0e\x0f\x38\x35\x0d\x00\xc4\xc4\x91\x12\xaf\xab\xdb\x2d\x76\x93\x0e\xbb\xea\x69\xee\x29\x25\xc7\x7f\x73\x34\x87\x90\x52\x78\xfb\xf6\x65\xf1\xe2\xc5\xec\xd3\xba\x48\xdb\x2e\xd4\xd6\x9b\xdf\x1a\x12\xf5\x5c\x5f\x7e\x7c\x84\x17\x87\xd3\xcc\x5d\x2a\x92\x17\xbb\x5e\xdd\x04\xdf\x05\xce\xf0\x41\x91\x35\x24\x06\x2d\x43\xb8\xd0\xae\x1e\x66\x8e\xf3\x3d\xa9\x4e\xfc\xcb\x3d\xa1\xa7\x65\xd2\xbf\xd8\xb2\xbc\x72\xda\x2c\x87\x64\xbe\xd7\xbd\x38\x3f\xad\x67\xee\x52\x25\xcb\xcd\xe2\x44\x29\xf5\xac\x9f\x18\x9f\x11\xff\x4f\x60\x77\xbb\xbe\x92\xe7\xd5\xbb\xfe\xb1\xae\x7c\x98\xb2\x68\x45\xc6\x22\x00\x00\x38\x37\xe7\xe6\xef\x00\xa0\x83\x1c\x7f\xa2\x03\x00\x00"
+
+func publish_receiverCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_publish_receiverCdc,
+		"publish_receiver.cdc",
+	)
+}
+
+func publish_receiverCdc() (*asset, error) {
+	bytes, err := publish_receiverCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "publish_receiver.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x97, 0xe1, 0xd6, 0x67, 0x60, 0xf7, 0x96, 0xe3, 0x1d, 0x1c, 0xc1, 0xbe, 0xae, 0x8d, 0x8a, 0xb9, 0xe0, 0x97, 0xa2, 0x57, 0x90, 0x6f, 0x9b, 0x46, 0xc1, 0xda, 0x94, 0xa3, 0x58, 0xe3, 0x11, 0xa2}}
+	return a, nil
+}
+
+var _revoke_minter_capabilityCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x6b\xdb\x40\x10\x85\xef\xfb\x2b\x1e\xb9\xc4\x81\xd6\x7b\x0f\xa1\x34\xd0\x1e\x7b\x4b\xcf\x61\xbc\x1a\x5b\x8b\x56\x33\x62\x76\x14\x37\x94\xfc\xf7\xb2\x92\x5d\xdb\x10\x74\x11\x3b\xf3\xde\x7c\xef\xc5\x88\x97\x3e\x57\xb8\x91\x54\x4a\x9e\x55\x90\x2b\x08\xce\xe3\x54\xc8\x19\x7b\x35\xd0\xcd\xdc\x7b\xf2\x10\x23\x0a\x7b\x85\xf7\x0c\xd7\x81\x05\xd4\x8d\x59\x60\xfc\xa6\x03\x2f\xcf\xbf\xb2\x38\x1b\x12\x4d\xb4\xcb\x25\xfb\x7b\x13\xe5\x5a\x67\xee\x70\xcc\xde\xaf\xff\xaf\xe3\xb2\xf6\x7a\x59\xdb\xa6\x2e\x85\x18\xdb\xf6\x6f\x29\x59\x86\x2c\x87\xc5\x70\xb2\xfc\xd6\x90\x26\xf2\x1e\x23\x0d\xbc\x9e\xef\xb8\xf0\x81\x9c\xef\x2b\xaa\xab\x71\xd7\x94\x17\x3b\xec\x29\x17\xb8\x62\xa7\x66\x7a\xfc\x82\xaa\xc8\x8e\x44\x02\x51\x14\x95\x03\x1b\x1a\x44\x08\x79\x9c\xd4\x1c\x3f\xff\xd0\x38\x15\x7e\x59\x62\xed\x4d\x47\xdc\x6d\xb7\x31\xa9\xb8\x51\xf2\x1a\xaf\xe7\x0d\xf6\x2e\x84\xeb\x7e\xfe\x86\x00\x00\x93\xf1\x44\xc6\x9b\xa5\x97\x47\x3c\xcf\xde\x3f\xa7\xa4\xb3\xf8\xc3\x79\xa5\x7d\xcb\x78\x3b\x2f\x41\x37\xf1\x14\x31\xf2\xd5\x89\xb5\xc7\x87\x8b\x24\x46\xfc\xe0\xea\xa6\xef\x4b\xfe\xc6\xce\xb6\xc6\xaa\x30\x1e\x29\x4b\xab\x8c\x4a\xd1\x23\x49\x62\x24\x92\x7b\xc7\x8e\x61\x3c\x57\xee\xfe\x1b\x15\xf6\xb3\xfa\xe9\xeb\x89\xa4\x28\x75\x4f\xdf\x6f\x22\xae\x00\xdf\x36\xad\x8a\x47\xc4\x56\x32\x1d\x3e\x67\x3c\x3b\x77\x27\xbe\xd5\x3d\x00\xc0\x47\xf8\x08\xff\x06\x00\x59\xe9\xef\x5d\x6f\x02\x00\x00"
+
+func revoke_minter_capabilityCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_revoke_minter_capabilityCdc,
+		"revoke_minter_capability.cdc",
+	)
+}
+
+func revoke_minter_capabilityCdc() (*asset, error) {
+	bytes, err := revoke_minter_capabilityCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "revoke_minter_capability.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2f, 0x71, 0xb1, 0x2f, 0xe, 0x92, 0x65, 0x8f, 0x14, 0x6b, 0xb7, 0xb6, 0xc6, 0x1b, 0xe4, 0xab, 0xa8, 0x16, 0xfd, 0xb, 0x69, 0x8, 0x5c, 0x83, 0x63, 0xd2, 0xb2, 0xe4, 0xa1, 0x50, 0x37, 0xd6}}
+	return a, nil
+}
+
+var _scriptsGet_ftCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\x5d\x6f\xdb\x36\x14\x7d\xd7\xaf\xb8\xee\xc3\x60\x03\x99\xb2\x67\x61\x5a\x90\x35\xc9\x50\xac\xeb\x8a\x44\xed\xcb\xd0\x07\x5a\xba\x56\x88\xc8\x94\x46\x52\x76\x8c\xc0\xff\xbd\xa0\x3e\xc8\x4b\x89\x0a\x0c\x18\xb0\x78\xee\x39\xd4\x31\x73\xc8\xcb\xf0\x7d\x53\x4b\x0d\x0f\xad\x28\xf9\xb6\xc2\xac\x7e\x41\x01\x3b\x59\xef\xe1\xb7\xd7\x87\x6f\x5f\xfe\xfa\xf4\xe7\xe7\xfb\xec\xdf\xbf\xef\xbf\xdc\xde\xdd\x3d\xde\x3f\x3d\x45\x83\xe0\xfe\x95\xed\x9b\x09\xdf\xe3\x45\xd7\xd7\xd7\x90\x3d\x73\x05\x2a\x97\xbc\xd1\x50\xa2\x56\xa0\x9f\x11\x0e\x1c\x8f\xbf\x6e\x99\xc2\x02\xf6\xa8\x59\xc1\x34\x03\xa6\x54\x9d\x73\xa6\xb1\x80\x23\xd7\xcf\x1d\x4f\x35\x98\xf3\x1d\xc7\xc2\xba\x83\xee\x75\xdd\xcc\x4c\x14\x20\x51\xb7\x52\x28\xe0\x1a\x98\x02\x06\x8a\x8b\xb2\x42\x50\x5a\xb6\xb9\x8e\xa2\xa6\xdd\x0e\xcf\xf0\x90\xc1\x5b\x04\x00\x60\xb0\x0a\x35\x08\xb6\xc7\x04\x9e\xb4\xe4\xa2\xf4\x0a\x05\xf6\x76\x79\x2d\x82\xf5\xfa\x28\x50\x26\x70\x5b\x14\x12\x95\xf2\x4a\xfa\xd4\x84\xe7\xc4\x57\x8d\x52\xb0\xea\xdb\xe3\xe7\x60\x5d\x62\x8e\xfc\x80\xf2\x2b\xd3\xcf\x09\x7c\x6d\xb7\x15\xcf\xcd\xb3\x47\xda\xb2\x8a\x89\x1c\xdf\xe5\x28\x5d\x4b\x56\x0e\x9c\x27\x37\xf0\x48\x8d\xac\x0f\xbc\xb0\x6f\x93\xfc\xc0\xf4\x9c\x35\x7a\xca\x96\x7e\xd4\xe0\x67\xb1\x3e\xbe\x66\x91\x90\xb7\x4a\xd7\x7b\xe2\x32\x81\xb7\x9e\x06\x9e\xf9\x73\x40\x45\x5c\x53\x15\x81\x83\x2a\xbb\x6a\x9e\xc8\xa2\xbe\x46\xfd\xdf\x32\x89\x9f\xf6\xac\x0c\xfb\xdf\x32\x21\x50\x7a\xf5\x1b\x7f\x02\x93\xe8\x4a\xd9\x77\x8d\xac\x73\xd4\xd1\xb8\xe0\x7a\xdd\x3d\x99\x0f\x0d\xe4\x95\x45\x03\x69\x74\x45\x3f\x8a\x0e\xa7\x39\x74\x68\x20\x84\xae\xb8\x94\x40\xc7\x58\x88\x9f\x23\x2c\x65\xcf\x31\x16\x83\x37\xf7\x41\x43\x33\xf3\x10\x2e\x86\xf2\xe6\xaa\x17\x87\x6d\x2a\x21\x36\xa9\x84\xc0\x73\x89\x5d\x1d\x4f\x61\x51\x22\x08\x64\xcc\x15\x43\x01\x23\xd2\xc5\x74\x99\xea\x66\x38\xef\xcc\x47\x61\xb5\x8b\x4d\xbe\x20\xed\xce\x3d\xbf\x40\x22\x06\x29\x90\x91\x4f\xeb\xc2\x06\x29\x74\xdf\x7e\xc9\xe4\x0d\x52\x30\x5f\x7e\x81\x44\x0e\x52\x1a\x40\x9f\x46\xc3\x07\xa9\x97\x45\x9f\x48\x32\x08\x29\x4d\xa4\x4f\x23\x49\x84\x94\xe6\xd2\xa7\xd1\x38\x42\xea\xa5\x33\xec\xcf\x24\x8b\xf8\xcb\x66\xbf\x77\x70\x34\xf0\xc8\x28\xfc\xe2\x81\x47\x87\x3e\x71\x16\x5a\x48\x61\x86\x85\x24\x24\x9d\x56\x42\xb0\xa0\xc4\xc6\xd3\x29\x2c\xe4\x0b\x48\x68\x21\xa5\x11\xf6\x69\x24\xbe\x90\xd2\x30\x4f\x66\xeb\x73\x6c\x66\xea\x9f\xfa\xd3\xf1\x1c\x9d\xfb\x0e\xbe\x6b\x05\xec\x19\x17\x6b\xd6\x9f\x73\xf6\xc0\xdb\x24\xae\xad\x9b\xf3\x98\xe5\x79\xdd\x0a\x0d\xa9\xb9\x69\xdc\xf6\x83\x51\xb4\x89\x2c\xed\xc0\xda\xca\x90\x06\xba\x35\x13\x97\xa8\x3f\xb2\x86\x6d\x79\xc5\xf5\x69\x4d\xef\x37\x36\x78\x76\x41\x36\x4e\xb6\xad\xa5\xac\x8f\xbf\xff\xf2\xf6\xcf\x70\x97\xf9\xce\xf1\xa8\xe2\x47\x54\x75\x75\x40\x79\xfe\x63\xed\xc8\x37\x37\xd0\x30\xc1\xf3\xf5\x87\x8f\x75\x5b\x15\x20\x6a\x0d\xbd\x1e\x18\x48\xdc\xa1\x44\x91\x23\xe8\xba\xbb\xff\x74\x4e\x3f\x4c\xad\xdf\x71\xd5\x54\xec\x04\x29\xf8\x2f\x2c\x51\x3f\x64\xdf\x09\x63\xdd\xe9\x37\xab\xa9\xde\x5c\xb7\xde\x11\x33\xcd\xe6\xca\xc5\x56\x06\x29\xbc\xf5\x6d\x73\x57\x4b\x78\xc1\x13\x70\xe1\xf9\x1c\xff\xbe\xf1\x0b\x9e\x14\x3d\x94\x7a\xf8\xbf\x17\x3c\xfd\x80\x34\x28\xe9\x6a\xab\xb8\x95\xd5\x90\x08\x6b\x67\xb6\x09\x96\x4e\xf3\x77\xec\x31\xcd\xe2\xd9\x3c\x53\x97\x33\x82\xf1\x14\xf3\x02\x85\x36\x37\x53\xe9\xac\x07\xa7\x33\xec\x1f\xab\xb0\x7b\xb2\x1f\xa9\x7b\x02\x5f\xe6\x9e\x08\xc2\xee\x09\xe1\x02\xf7\x13\xf6\xa2\x7b\xbb\x15\x3c\xf3\x16\xbd\xd0\xbb\xe5\x2f\x58\xb7\xf5\x4b\x9c\x7b\x64\xdf\xf8\x81\xc9\x60\x3b\x35\x1d\x91\x57\xd0\x51\xf9\xce\x4f\x21\xe1\xc3\xaa\xe7\x39\x7f\xb4\x98\x2e\xea\x56\xf1\x8e\x57\x18\xb7\x92\xaf\x37\xd4\x4e\xff\x8f\x0b\x3c\x64\xd3\xfb\x9f\x37\x91\x81\x5c\xbf\x27\x9d\x79\xc2\x23\x95\xd9\xe5\xb0\x23\xc6\xdd\x60\x15\x0f\x87\xa1\x23\x99\x96\x3d\x99\xac\x44\x6d\x1a\xd1\x7a\x43\x56\xdb\x09\x48\x0f\x9f\xe8\x48\xc5\xec\x58\x27\x19\xdb\xa5\xd9\x5e\xa3\xc6\x24\x80\xe2\x8e\x3d\x9e\xb4\x13\x32\x81\x1d\x97\x74\x76\xca\x25\xb0\xe3\x8e\x4d\x76\x4a\xa6\xf8\xdc\x73\x46\xd6\x87\x7a\x36\x78\x70\x7d\x06\x9f\x53\x1d\x81\x83\xb2\xd1\xc5\x54\x47\xf1\xa0\x70\x76\xe2\x24\x73\xe8\x6a\xf9\x3c\x48\xe6\xd0\xd5\xe2\x16\x4c\x66\x88\xe3\x92\x4b\xc0\x24\x17\xa4\x42\x36\xc3\x55\x68\x23\x25\x74\x10\xb8\xe8\x0e\x0f\x11\x00\xc0\x26\x3a\xff\x1c\x00\x1d\x05\x42\x1c\xba\x10\x00\x00"
+
+func scriptsGet_ftCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsGet_ftCdc,
+		"scripts/get_FT.cdc",
+	)
+}
+
+func scriptsGet_ftCdc() (*asset, error) {
+	bytes, err := scriptsGet_ftCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/get_FT.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf5, 0xc9, 0xd6, 0xde, 0x78, 0x24, 0xa8, 0xd4, 0xa1, 0x72, 0xd1, 0x1f, 0xa, 0xff, 0x81, 0x3c, 0xe6, 0x6b, 0xc4, 0xef, 0x91, 0xfd, 0xb, 0x50, 0x29, 0x39, 0x73, 0x41, 0x43, 0xa, 0x66, 0x5a}}
+	return a, nil
+}
+
+var _scriptsGet_account_is_setupCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x41\xab\xe2\x30\x14\x85\xf7\xfd\x15\x67\x5c\x0c\x0a\x92\xee\x65\x66\xc0\x19\xe6\xc1\xdb\xc9\xc3\x3f\x70\x9b\xde\xb6\x17\xd3\x24\x24\xb7\x4f\x45\xfc\xef\x8f\x5a\x2b\xba\x78\x90\x5d\xce\x97\x9c\xf3\x95\x25\xf6\x9d\x64\x64\x9b\x24\x2a\x6c\xc7\xf6\x90\x71\xec\x58\x3b\x4e\x20\x0f\xb2\x36\x0c\x5e\x31\x66\x58\x31\x44\x68\x40\x62\xcb\xf2\xc9\xf8\x7f\xa2\x3e\x3a\xde\x87\x03\xfb\x75\x51\x96\x10\xc3\xe6\x41\x8b\x66\xc4\xa1\x72\x62\xf1\x31\x01\x09\x96\x22\x55\xe2\x44\xcf\xe0\x93\x64\xcd\x20\x5f\xc3\x92\x47\xc5\xa8\x42\x4a\xe1\xc8\xb5\x19\x9f\x7a\x57\x24\xd6\x21\xf9\x8c\x86\x5c\x66\x88\xcf\xca\x54\x23\x34\x88\xe4\xc5\x1e\xc4\xb7\x6b\xe4\x00\x99\xda\x51\xc3\x63\x37\x4b\xce\xa1\x09\x63\xf9\x33\xa8\xae\x13\xe7\x6c\x8a\x42\xfa\x18\x92\xe2\x6d\xf0\xad\x54\xf7\xca\x68\x52\xe8\xb1\x30\xa6\x34\xa6\xb4\xc1\x6b\x22\xab\xb9\x7c\xc9\x18\x5b\xdb\xc5\x4c\x3f\xef\xfd\x06\x7e\x8e\x4c\x6c\x11\x87\x0a\xcd\xe0\xd1\x93\xf8\xe5\xdd\xe7\x06\xdb\xa9\xda\x6a\x83\xbf\x21\x38\x5c\x0a\x00\xf7\xc5\x68\x59\xb7\x53\x6e\xce\xaf\x6e\xd7\xe3\x31\x2d\xeb\xbf\x87\xc5\xe5\xcb\x7f\xb3\xe6\xdd\xcd\xfa\x8e\xb4\x7b\xe2\x26\xbb\xbf\x7e\x5e\x5e\xf7\xcd\xcc\xf5\xcf\x72\x85\x1f\xbf\xe1\xc5\x15\xd7\xe2\x6b\x00\x94\x7f\x10\x1e\x18\x02\x00\x00"
+
+func scriptsGet_account_is_setupCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsGet_account_is_setupCdc,
+		"scripts/get_account_is_setup.cdc",
+	)
+}
+
+func scriptsGet_account_is_setupCdc() (*asset, error) {
+	bytes, err := scriptsGet_account_is_setupCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/get_account_is_setup.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x35, 0x9b, 0x8b, 0x74, 0xd0, 0xcc, 0x4, 0x57, 0x51, 0x2f, 0xd8, 0xcc, 0xf8, 0x29, 0x73, 0x88, 0xa7, 0xb6, 0x5f, 0x98, 0x79, 0x0, 0xf8, 0x4a, 0xc7, 0xef, 0xa4, 0x2e, 0x5a, 0xce, 0xf2, 0x17}}
+	return a, nil
+}
+
+var _scriptsGet_aggregate_balanceCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x6e\xeb\x36\x10\xbc\xeb\x2b\xa6\xef\x90\xc6\x68\x20\xe5\x50\xf4\x60\xd4\x2d\x9c\xa2\x39\x07\x49\xda\x4b\x90\xc3\x9a\x5c\x59\x44\x64\xae\x40\xae\xec\x18\x81\xff\xbd\x20\x25\xb9\x76\xe2\x16\x0f\x10\x60\x43\xdc\x9d\x9d\xd9\x19\xb1\xaa\xf0\xdc\xb8\x88\x68\x82\xeb\x14\x81\xb5\x0f\x3e\x42\x1b\x86\x91\xcd\xca\x79\xb6\x58\x51\x4b\xde\x30\xa4\xce\xef\xff\x7c\xa7\x4d\xd7\xf2\xb3\xbc\xb1\xc7\xdf\xd4\xb7\x1a\x8b\xaa\x02\x79\x90\x31\xd2\x7b\x45\x54\x09\x1c\x41\x0a\xa7\x11\x51\xc9\x5b\x0a\x76\xa8\x45\x47\xda\x80\xbc\x4d\xc7\x09\x2e\x76\x6c\x5c\xed\xd8\xe6\x93\x58\x16\x55\x95\xe0\x96\x03\x56\x84\x21\xff\xa3\x62\xc5\x70\xca\x81\x94\x2d\x9c\x87\x26\xce\x5b\x0e\xd1\x89\x4f\xbc\xfe\x20\xcb\xde\xf0\x4d\xea\x8c\x02\xf2\x7b\x90\xb5\x4e\x9d\x78\x6a\x4f\x06\x47\x34\xb4\x65\xa8\x24\xc0\x8e\x62\x64\x0b\x7e\xef\x5a\x67\x9c\xb6\xfb\x34\x1b\x0f\xb9\x4c\x1b\x52\x50\x60\xf0\xa6\xd3\x3d\x24\x0c\xa2\x10\x65\xc3\xda\x38\xbf\x86\x68\xc3\x01\xda\x24\xdd\x17\x76\x92\x9b\xe3\x9b\xeb\x3a\xb6\x99\x56\x92\x9c\xf5\x4e\xeb\x48\x72\xe1\x22\xc4\xb7\x7b\x64\xb1\x6c\x21\xde\x70\x59\x14\x6e\xd3\x49\x50\xdc\xf7\x7e\xed\x56\x13\x6e\x1d\x64\x83\x6f\x65\x59\x95\x65\x65\xc4\x6b\x20\xa3\xb1\x3a\xab\x29\x8d\x35\xdf\xa6\xee\x33\x52\x97\x9b\x4f\x4b\x86\xde\xa2\xeb\x57\xa8\x7b\x8f\x0d\x39\x7f\x3d\x3a\x3a\xc7\xd2\xda\xc0\x31\xde\x0c\x5b\x9c\xe3\xe5\x49\x25\xd0\x9a\x1f\x48\x9b\xd7\xd9\x1c\x7f\xdd\xbb\xf7\x5f\x7e\xc6\x47\x01\x00\x2d\x6b\xca\x82\x62\x81\x35\xeb\xb2\xd7\x66\x74\x73\xc2\x9b\x15\xb9\x6e\x4b\x01\x2a\x4a\x2d\x16\xb8\x2d\x6f\xf3\x3b\x57\xe7\xf6\x6d\xda\xe1\x23\xd7\x58\x64\xa4\x72\x25\x21\xc8\xee\xd7\xab\x33\xc2\xd9\xd7\xdf\xae\x93\xb4\xf9\x99\xda\xe1\xe4\x84\xe2\x6c\x64\x96\x9e\x69\xe2\xf0\xfb\xd3\x71\x54\x39\xe6\x3c\x17\x1e\x8a\xa3\x92\xd1\x9a\x39\x3e\x9e\x34\x38\xbf\x9e\xe3\x4e\xa4\x3d\x60\x81\x8f\xff\x9d\x59\xaa\x0c\x0d\xd7\xb3\x39\x34\xf4\x7c\xc8\x90\xb5\x84\xd1\x7a\x3f\x46\xf2\x5f\x6a\xae\x9e\xa6\xbd\x74\xe7\x08\xaf\xf8\x61\x01\xef\xda\x13\x1d\xe9\x49\x4e\x3a\xdf\xf3\xf1\xe5\xe1\xf8\xef\x3f\x81\x16\x99\x4c\x71\x2c\xac\x2a\xdc\xe5\xed\xa6\x54\xef\x9c\x36\xf9\x2b\xcf\x1b\x84\xee\x3b\xc6\x4e\xfa\xd6\x82\x56\x29\x53\x89\x3d\x65\xde\x29\xfa\xd3\xa7\x7e\x8a\x45\xb0\xae\xae\x39\xb0\x1f\xda\x6f\x10\x05\x83\x7d\x63\xfe\x25\xb0\x45\xe0\x28\x7d\x30\x9c\xaf\x02\x2b\x3b\x6f\x28\xa6\xfb\xe2\x08\x35\x06\x61\xaa\xfb\x9a\x05\xea\xb5\xc1\xd5\xd2\xef\x1f\xc7\x92\x29\x09\xdd\x27\xc3\x2f\xc7\xea\x14\x98\xe2\xef\xb8\x10\xad\x4f\x18\xdf\x1d\x9e\xaf\x66\x1c\x4e\x43\x35\x5c\xb2\x50\x51\x6a\x8b\x43\xf1\xcf\x00\x49\x6d\x94\xf5\x83\x05\x00\x00"
+
+func scriptsGet_aggregate_balanceCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsGet_aggregate_balanceCdc,
+		"scripts/get_aggregate_balance.cdc",
+	)
+}
+
+func scriptsGet_aggregate_balanceCdc() (*asset, error) {
+	bytes, err := scriptsGet_aggregate_balanceCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/get_aggregate_balance.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x54, 0x28, 0x4d, 0x26, 0x98, 0xd2, 0x4a, 0x7f, 0x82, 0x18, 0x7e, 0xc4, 0xf5, 0xb9, 0x16, 0xea, 0xde, 0x5c, 0xbd, 0xfd, 0xb1, 0xd2, 0x19, 0x8d, 0xff, 0xe8, 0x66, 0xcb, 0xc0, 0x66, 0xd4, 0x74}}
+	return a, nil
+}
+
+var _scriptsGet_balanceCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x41\x6f\xe2\x30\x10\x85\xef\xf9\x15\x4f\x39\xec\x86\x8b\x73\x59\xed\x01\x95\x22\x8a\xca\x19\x55\xb4\xf7\x89\x33\x01\xab\x8e\x1d\xd9\xe3\x42\x85\xf8\xef\x55\x12\xa0\xcd\xa5\xf2\xd1\xef\xfb\x34\xf3\xa6\x2c\xb1\x3b\x98\x88\xa8\x83\xe9\x04\x81\xa9\x8e\x90\x03\xa3\x22\x4b\x4e\x33\x1a\xc3\xb6\x86\x6f\x40\x0e\xa4\xb5\x4f\x4e\xfe\x46\x6c\xac\x3f\xee\xfc\x3b\x3b\x3c\x8d\xb9\x2c\x33\x6d\xe7\x83\x60\x93\xdc\xde\x54\x96\xc7\xdf\x26\xf8\x16\xb9\x52\xa5\x52\xa5\xf6\x4e\x02\x69\x89\xe5\x24\xa3\x74\xad\xf3\x1b\xfd\x7c\xa2\xb6\xfb\x1d\xfe\x19\x19\xd9\xac\x4b\x15\x9a\xe4\xd0\x92\x71\xc5\x75\xc8\x39\x56\x75\x1d\x38\xc6\xd9\x1c\xaf\x1b\x73\xfa\xff\x0f\xe7\x0c\x00\x2c\x4b\xbf\x88\x60\x81\x3d\xcb\x6a\x4c\xdf\xa8\xd9\x3d\xf2\x41\xc9\xca\x0b\x37\x58\x0c\x69\xb5\x67\x59\x53\x47\x95\xb1\x46\x3e\x8b\xc9\x10\xd7\x0a\xb6\xa9\xb2\x46\x6f\x49\x0e\xa3\xa5\x7f\xaa\xf2\x21\xf8\xe3\xc3\x9f\x09\xf0\xd6\xbb\xcf\xd3\x16\xae\x92\xcb\x63\xf1\x4d\x2f\x97\xe8\xc8\x19\x5d\xe4\x6b\x9f\x6c\x0d\xe7\x05\xa3\xf0\x56\x3b\x02\x37\x1c\xb8\x3f\x94\xf8\xe1\x6e\x83\x3b\x9f\x65\x83\x24\xb0\xa4\xe0\xee\xbb\xa8\x8a\x2c\x39\xcd\xd9\x25\xfb\x1a\x00\xc9\xee\xfc\xac\xf8\x01\x00\x00"
 
 func scriptsGet_balanceCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -262,7 +547,107 @@ func scriptsGet_balanceCdc() (*asset, error) {
 	return a, nil
 }
 
-var _scriptsGet_supplyCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xce\x4d\x4a\xc0\x30\x10\x05\xe0\xfd\x9c\xe2\xd1\x55\xbb\x49\x36\xe2\x42\x70\xa9\x17\xb0\x1e\x20\xa6\x89\x0d\xe6\x8f\xc9\x04\x2a\xe2\xdd\x85\xfe\x80\xdd\xce\xfb\x86\xf7\xb4\xc6\xbc\x86\x86\x66\x39\x54\x01\x3b\xb3\x34\xc8\xea\x20\x45\x4c\x44\xeb\xb5\xc6\x6f\xf8\xe0\xe2\x42\x5a\xa3\xf8\x3d\x7c\xd9\x4c\xaa\xd1\xcd\xe5\xcb\x65\xb4\x64\x58\x60\x4b\x16\x36\x56\x88\x42\xaa\x85\xe5\x6e\x3c\x97\x84\x41\x29\xad\x94\xbe\x64\xd3\xff\x89\xb2\x8b\x1d\x88\x6a\xff\x80\xef\x19\xc9\x84\x3c\x4e\x4f\x78\x7f\x0d\xdb\xe3\x03\x7e\x88\x00\x20\x3a\xb9\x26\x3d\xdf\x0a\xd4\x3e\xf7\x6d\x8f\x4e\x5a\x3e\xc7\x83\x4e\xc7\x81\x9d\x74\xce\xe7\x3b\xfd\xfe\x05\x00\x00\xff\xff\xa2\xfe\xee\xae\xf9\x00\x00\x00"
+var _scriptsGet_contract_existsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\x41\x6a\x85\x40\x0c\x86\xf7\x73\x8a\xbf\xbb\x27\x3c\x74\x2f\x74\xf1\x7a\x85\xf6\x02\xe3\x18\x67\x42\x35\x91\x4c\x6c\x17\xa5\x77\x2f\xea\x13\xba\x09\x09\xe1\xfb\x92\xbf\xeb\xf0\x51\xb8\xa2\x26\xe3\xd5\x61\xe4\x9b\x49\xc5\x77\x21\x2f\x64\xf0\x42\x88\x29\xe9\x26\x8e\xe8\xc7\x98\xf9\x8b\x04\x71\x1c\x8d\x6a\x0d\x5d\x87\xa2\xd5\xeb\xb1\x92\xb8\xd0\x88\xa4\xe2\x16\x93\xdf\x41\x6d\x6e\xe1\x8a\x54\x28\x7d\x22\x0a\x78\x59\xd5\xfc\x3f\x3c\xd0\xa4\x46\x18\x36\x9e\x47\x96\x0c\xb7\x28\x35\x26\x67\x95\xdd\x19\xfd\x62\x76\xff\x65\xc6\x64\xba\x80\xbd\x0d\x61\xdd\x06\x4c\x9b\x60\x89\x2c\xb7\xa7\xb7\xc7\xe3\x6c\xee\xc7\x47\x3d\xde\xdd\x58\x72\xd3\xe3\x4d\x75\xc6\x4f\x00\xf0\x4c\x8a\x4c\xfe\x38\xf3\x5d\x74\xd3\x5e\x67\x6a\x9b\xc9\x6f\xa7\x62\xaf\x0d\x5e\x5e\x21\x3c\x87\xdf\xf0\x37\x00\xb4\x7a\xb4\x5a\x37\x01\x00\x00"
+
+func scriptsGet_contract_existsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsGet_contract_existsCdc,
+		"scripts/get_contract_exists.cdc",
+	)
+}
+
+func scriptsGet_contract_existsCdc() (*asset, error) {
+	bytes, err := scriptsGet_contract_existsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/get_contract_exists.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf8, 0x53, 0x26, 0xc4, 0x60, 0x34, 0x3f, 0x4f, 0x6b, 0x58, 0x65, 0x95, 0x65, 0xf6, 0x58, 0xbd, 0x2, 0xb1, 0x92, 0x81, 0x44, 0x32, 0xa7, 0xef, 0x91, 0xbc, 0x2a, 0x94, 0x67, 0x1f, 0xc7, 0x22}}
+	return a, nil
+}
+
+var _scriptsGet_display_decimalsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x93\xcf\x6e\xdb\x3c\x10\xc4\xef\x7a\x8a\x81\x0f\x89\x0c\xf8\x93\x2e\x1f\x8a\x22\xa8\x13\xa4\x49\x73\x2b\x10\xb4\x6e\xee\x14\xb5\xb2\x16\xa5\x48\x81\x5c\xc6\x31\x02\xbf\x7b\x41\x49\x76\xac\xc2\x05\x78\xf0\x9f\xf9\x8d\x66\xb8\xab\xb2\xc4\xa6\xe5\x80\xa0\x3d\xf7\x02\x4f\x12\xbd\x0d\x90\x96\xf0\xc8\xa1\x37\x6a\xff\x48\x9a\x3b\x65\x02\x5e\x99\x76\x70\x0d\x94\x85\xd2\xda\x45\x2b\xd7\x01\xdf\xde\x54\xd7\x1b\xda\xb8\xdf\x64\xf1\xa2\xa2\x91\x55\x56\x96\x70\x1e\x96\x0d\xb8\x19\x9c\x64\xf8\xb7\x76\x14\xec\xb5\xa0\x26\x6d\x94\x27\x38\x4b\x2b\xb0\xc5\xae\x65\xdd\x42\xab\x40\x50\x5d\xb2\x0d\x08\xad\x8b\xa6\x46\x45\xc9\xab\x1e\x73\x50\x8d\x1d\x4b\x0b\x65\x0c\x3e\x27\x93\x94\x0a\xbd\x51\x9a\x42\x8a\xf5\xeb\x89\xdf\x3e\xfd\x5f\x64\x65\x99\xa0\x4d\x4b\x63\x60\x0e\x68\x5c\xb4\x35\xaa\x3d\xac\xea\x68\x85\xe0\xc0\x02\x0e\xf0\x14\x9c\x79\xa5\x1a\x8d\xf3\x50\x76\x7f\xcc\x39\xe4\x63\xbb\x05\x4b\x91\x65\xdc\xf5\xce\x0b\x9e\xa2\xdd\x72\x75\x6c\xda\x78\xd7\x61\x51\x14\x65\x51\x94\xda\x59\xf1\x4a\x4b\x28\x67\x9a\x42\xd7\x7a\x71\xa4\xbf\x93\xa8\x5a\x89\x7a\x61\xda\x85\x7f\xd0\x33\xcd\x8c\x9e\x5d\xf2\x65\xf8\x5c\x32\xb2\x59\x1f\x2b\x34\xd1\xa2\x53\x6c\xf3\x69\x62\x37\xb8\xaf\x6b\x4f\x21\x2c\x6f\x70\x6f\xf7\x3f\xc5\x47\x2d\x77\x78\xcf\x00\xc0\x90\xe0\x35\x8d\x10\x6b\x6c\x49\xee\x47\xe4\x88\x2e\x07\x4d\x3a\xc5\x96\xe4\x41\xf5\xaa\x62\xc3\xb2\xcf\x67\x8f\xfe\xaa\x8c\xb2\x9a\x9e\x63\x65\x58\x3f\x2b\x69\xcf\xb0\xca\x79\xef\x76\x5f\xae\xde\xe7\x4d\x7f\x8c\x63\xf0\x87\xdb\xfc\x43\x7c\x77\x87\x5e\x59\xd6\xf9\xe2\x61\x58\x05\xeb\x04\x23\x0f\x05\x4f\x0d\x79\xb2\x9a\x20\x6e\x58\xb0\x21\xf5\x62\x99\x9d\x6a\x84\xd8\x34\xfc\x86\x35\x16\xc5\x5f\x6b\xbc\x18\x34\x69\xe2\x69\x3d\x36\xfb\x9e\xd2\x0e\x0e\x06\xa9\xd7\x10\x29\x5f\x4e\x37\x72\xb4\xe3\x9a\xac\x70\xc3\xe4\xb1\x3e\x71\xc5\xc7\xaf\x27\x31\x37\x67\xda\xc2\x90\xdd\x4a\x8b\xdb\x29\xce\xf4\xfd\x24\x4e\xe7\xea\xea\x1c\x08\x86\x35\xe5\x69\xc2\x37\x17\x7c\xfe\x9b\xfb\xac\x10\xfb\x8d\xbb\x20\x5c\x62\xbd\x9e\xa4\x67\x3d\xd2\x19\x5f\xef\xa9\xed\xb4\xfe\xa9\x71\x7e\xec\xf4\x31\x80\xc3\xf0\xe9\x90\x65\x67\x9c\x65\x93\x1d\xb2\x3f\x03\x00\xe6\x43\xb5\x72\x33\x04\x00\x00"
+
+func scriptsGet_display_decimalsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsGet_display_decimalsCdc,
+		"scripts/get_display_decimals.cdc",
+	)
+}
+
+func scriptsGet_display_decimalsCdc() (*asset, error) {
+	bytes, err := scriptsGet_display_decimalsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/get_display_decimals.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2c, 0xbd, 0x12, 0xc2, 0x21, 0xc1, 0xc1, 0x57, 0x5f, 0x51, 0xb0, 0xa6, 0x63, 0xda, 0x5a, 0xe0, 0x7, 0xc2, 0xe1, 0xf7, 0x6d, 0xa3, 0xd7, 0x20, 0x10, 0x18, 0xfc, 0xf6, 0x90, 0xb2, 0x85, 0x67}}
+	return a, nil
+}
+
+var _scriptsGet_display_metadataCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x4b\x6b\xdb\x4a\x14\xde\xeb\x57\x7c\xf1\xe2\x5e\x09\x8c\xb4\x37\x71\x4c\x6e\x6e\x03\x85\x04\x42\xea\xa4\xeb\x63\xe9\xd8\x1a\x3a\x9a\x51\x67\x8e\xe2\x9a\xe0\xff\x5e\xf4\xb2\x35\x26\x69\xc1\x1b\xe9\x7b\x9d\x97\x9c\x65\x58\x97\xca\xc3\xe7\x4e\xd5\x02\xc7\xd2\x38\xe3\x21\x25\xa3\x50\xbe\xd6\x74\x40\xc5\x42\x05\x09\xc1\x6e\x41\x06\x94\xe7\xb6\x31\xf2\xaf\xc7\x97\x5f\x54\xd5\x9a\xd7\xf6\x07\x1b\xbc\x52\xa3\x25\xca\x32\x6c\xad\x03\x41\xba\x97\xb9\x63\x12\x2e\xb0\x57\x52\x76\x9e\xdf\x95\x94\x8f\x8d\xd0\x46\xf3\xff\x83\xbd\xad\x45\x59\x93\x46\x59\xd6\xaa\xd7\x25\xc3\x50\xc5\x73\x14\xdc\xd7\xa4\xac\x01\x99\x02\xda\xee\x2c\x5e\x9e\x1f\x40\x8e\xe1\x98\x0a\x6c\x9d\xad\x3a\xd7\xfb\x75\x97\x3e\x3a\xbe\x29\xde\xcf\x5b\xb3\x56\xd6\xe2\xfe\x50\x6d\xac\x3e\xf3\x03\x22\xf6\xa5\xca\x4b\x28\x0f\xa3\x34\x1a\x23\x4a\x77\xa6\x54\x54\xca\xc0\xb3\x78\x28\x49\xa3\x48\x55\xb5\x75\x82\xfb\xc6\xec\xd4\x66\xec\xba\xb3\x9c\xa5\x69\x96\xa6\x59\x6e\x8d\x38\xca\xc5\x67\x01\x27\xcd\x8b\x7c\x36\xaa\x1f\x87\x59\xbe\x2a\xde\xfb\x4f\xd4\x01\x27\x50\x07\x03\xff\x58\x3c\xa5\xf4\xda\xa8\x6e\x36\xf0\xe2\x9a\x5c\xc6\xc6\xc7\x04\xbc\x47\x00\xd0\x12\x34\x4b\x37\xf7\x05\xbe\x89\x53\x66\x17\x00\xfd\xfc\x46\x68\x15\x60\x93\x35\x7d\xa8\x6d\xd7\xf6\xf2\xfc\x70\xc2\x3a\x50\x19\x25\xf1\x34\x6e\x3e\xec\x68\x7c\x5e\x05\xfb\x1f\xdf\xce\x2f\xdd\x92\xa1\x83\xf6\xe7\x59\x6f\xd3\xd6\x13\xcb\xae\x93\x10\x18\x4e\x60\x39\xe4\x84\xe0\x24\x0a\xcb\x69\x70\x48\x1b\xc2\xb1\x1c\xcb\xe8\xe0\x63\x74\xec\x67\xbc\x6d\x0c\x2a\x52\x26\x1e\xbe\x90\x05\x6e\x8b\xc2\xb1\xf7\xc9\xe2\x93\xc1\xb7\x43\x7f\x6b\x2f\x17\x4b\xec\x58\x6e\x7b\xdd\xa8\x4f\x4e\xe9\xe9\x8e\xe5\x8e\x6a\xda\x28\xad\xe4\x10\x07\x3b\xfe\x8f\x34\x99\x9c\x9f\x9a\x8d\x56\xf9\x13\x49\x39\x91\x6d\xac\x73\x76\x7f\xfd\xcf\xfb\x98\xdb\x9f\xd4\x33\x7b\xab\xdf\xd8\x1d\x6f\xe2\x33\x79\xb5\x42\x4d\x46\xe5\xf1\xec\xce\x36\xba\x80\xb1\x82\x5e\x0f\x82\xe3\x2d\x3b\x36\x39\x43\x6c\xf7\x71\x74\x55\xcf\x92\x28\x6c\x63\xe8\x12\xcb\xfe\x31\x75\x7d\x50\x9b\x1a\xaf\x0f\x35\x5f\x87\x75\x84\x9f\xed\x4d\x9c\x24\x57\x20\x7f\x85\x3f\xb1\x4e\x81\xc5\xdf\xb2\x82\x29\x4d\x32\xba\x88\x8f\xc0\x55\xdf\x4d\xff\x17\x78\xb9\xb1\xf8\x34\xa8\xf6\xb6\x16\x41\xbf\xdd\xd5\xcd\x4f\x84\xf1\x92\x87\x0a\x57\xc3\xed\x9d\x09\x93\x03\xbb\x30\x9a\x20\x67\xfa\x70\x6c\x17\x54\xff\xb3\x21\xc7\x5f\x2b\xda\x71\xba\x55\x9a\xd3\xc6\xa9\x38\x89\x00\x20\x89\x8e\xd1\xef\x01\x00\x74\x00\x38\x04\xd8\x05\x00\x00"
+
+func scriptsGet_display_metadataCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsGet_display_metadataCdc,
+		"scripts/get_display_metadata.cdc",
+	)
+}
+
+func scriptsGet_display_metadataCdc() (*asset, error) {
+	bytes, err := scriptsGet_display_metadataCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/get_display_metadata.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9, 0x3e, 0x33, 0x2d, 0xb7, 0x5c, 0xe6, 0xb8, 0xc8, 0xb7, 0x8e, 0xf7, 0xa8, 0x66, 0x8b, 0xc7, 0xe6, 0xc8, 0xda, 0xd8, 0xcb, 0xe4, 0xf2, 0x1e, 0x5b, 0x77, 0x46, 0x85, 0xaf, 0x27, 0x6e, 0xc9}}
+	return a, nil
+}
+
+var _scriptsGet_dust_vaultsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x54\x4d\x6f\xeb\x36\x10\xbc\xeb\x57\x4c\x73\x48\x6d\xc0\x90\x2e\x45\x0f\x46\xdc\xc2\x29\x10\xa0\xb7\x20\x49\x7b\x09\x72\xa0\xc9\x95\x45\x58\x26\x05\xee\x2a\x8a\x61\xf8\xbf\x3f\x90\x94\x94\x8f\x97\x07\xf9\x20\xd3\xb3\xc3\xd9\x9d\x59\x57\x15\x9e\x1a\xcb\x60\x1d\x6c\x27\x08\x24\x7d\x70\x0c\x69\x08\x2c\x3e\xa8\x3d\xa1\x53\xd2\x30\x7c\x9d\x0e\xeb\xde\xed\xed\xae\x25\x88\x3f\x90\xc3\xff\xaa\x6f\x85\x8b\xaa\x82\x72\x50\x5a\xfb\xde\x49\x2a\x24\xc6\xd0\x78\x26\xec\x54\xab\x9c\x26\x58\xc6\x8e\x5a\x3f\x40\x41\x9a\x40\xdc\xf8\xd6\xac\x62\x21\x95\xfb\x12\xb5\x0f\x89\xa1\xeb\x20\x8d\x12\xf0\x40\xd4\x31\x4c\xcf\x32\x31\x70\x59\x54\x55\x2c\xf8\x57\x30\xf8\x70\x60\x0c\x56\x9a\x51\x41\x94\xa7\xdc\x69\x54\x25\xa7\x8e\x4a\x6c\xb3\x1c\x86\x56\xee\x77\xc1\x8e\x60\x85\x82\x12\x32\x91\xc5\x3a\x48\x6c\xfc\x95\x02\x5b\xef\x22\xc1\x3f\xca\x90\xd3\xb4\x02\xfb\xd4\x6b\x6e\x5c\x3c\x74\x43\xfa\x80\x46\xbd\xc6\xb6\x23\x51\xa7\x98\xc9\x80\xde\xba\xd6\x6a\x2b\xed\x29\x6a\xc3\x7d\xc6\x47\xfd\x2a\x10\xe8\xd8\xc9\x09\x3e\xe4\x79\x80\xfd\x91\xa4\xb1\x6e\x0f\x2f\x0d\x85\x88\x73\x50\x59\x7f\xc2\xf3\xc1\x76\x1d\xe5\xa1\x28\x67\x40\x4a\x37\x69\xf8\x71\x76\xde\xb5\xa7\xd1\x1d\x32\xf0\x4e\x53\x59\x14\xf6\xd8\xf9\x20\xb8\x1b\x3d\x79\x4a\xcd\xd7\xc1\x1f\x71\x55\x96\x55\x59\x56\xda\x3b\x09\x4a\x0b\x57\x9f\x30\xa5\x36\xfa\xaa\x28\xba\x7e\x87\xba\x77\x38\x2a\xeb\x16\xa3\x79\x6b\x6c\x8d\x09\xc4\xbc\x4a\x57\xf3\x1a\xcf\x8f\x39\x07\xb1\xbb\x97\xd5\xbb\x79\x6b\xfc\x77\x67\xdf\xfe\xfc\x63\xf9\x05\x82\x73\x01\x00\x2d\x49\x0c\x84\x60\x83\x3d\xc9\xb6\x97\x66\xf4\x63\xba\x69\x59\xcc\xb8\x68\xf3\xfd\x37\xb7\x61\x83\xe7\x97\x19\x95\x5c\x20\xb3\xc6\xf9\x51\x82\x75\xfb\x35\x6e\xbd\x6f\x2f\xd8\xe0\x7c\x49\xa0\x18\xa2\x28\x1a\xd6\x8d\xa1\xcd\x52\xe2\x63\xeb\xa9\xfe\x39\xfe\x54\x8a\xcf\x24\x8b\xe5\x0b\x7e\xdb\xc0\xd9\x76\xd4\x3d\x3d\x71\x74\xd6\xf5\x34\x1f\x5e\xe6\xb7\x5f\x12\x6d\x20\xa1\xa7\x62\x06\x56\x15\x6e\x7d\x08\x7e\x88\xae\xa7\xb4\x4e\x7e\xc7\x84\x62\xf0\x7d\x6b\xa0\x76\xd1\xc3\xa8\x5d\x25\xd5\xe3\x02\xc4\xcc\xf0\x47\x26\x05\x63\xeb\x9a\x02\xb9\x5c\x9e\x62\xba\x4b\xf4\xf3\xba\x92\x41\x20\xf6\x7d\xd0\x94\x22\x64\xfc\xe0\xb4\x62\x81\x95\x99\xca\xd6\x69\x9a\x13\xee\x81\x6a\x6c\x92\x53\x65\x26\xbb\x51\xbd\x34\xb8\xde\xba\xd3\xc3\x08\xf9\x6b\x11\x33\xb5\x4e\xea\x96\x5f\xc6\x34\xb2\xbd\xc6\x14\x67\xaa\x8f\xc4\x8a\xff\xc6\xf5\xf9\x73\xf6\x6e\xf3\x3a\x5f\xbe\x10\x8d\x64\x13\x51\x39\xfd\x6f\xdc\xbc\x27\xee\x9b\x8a\xf8\x99\xd3\x53\xaa\xae\x23\x67\x16\x49\xe7\x4f\xd0\x4b\xf1\xfd\xb7\xfc\x76\xc9\xae\xe5\x0d\x7b\xa7\x2c\x2e\xc5\x8f\x01\x00\xd3\x5f\xdd\x36\x22\x05\x00\x00"
+
+func scriptsGet_dust_vaultsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsGet_dust_vaultsCdc,
+		"scripts/get_dust_vaults.cdc",
+	)
+}
+
+func scriptsGet_dust_vaultsCdc() (*asset, error) {
+	bytes, err := scriptsGet_dust_vaultsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/get_dust_vaults.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x24, 0xfc, 0x9d, 0xd5, 0x34, 0x6b, 0x58, 0x51, 0x42, 0xbd, 0xd3, 0x27, 0xd5, 0x38, 0x26, 0x5d, 0x82, 0xd6, 0x6f, 0xb1, 0x3a, 0x79, 0x93, 0xbc, 0xce, 0xe2, 0x4f, 0xd4, 0x89, 0xc0, 0x13, 0xff}}
+	return a, nil
+}
+
+var _scriptsGet_fungible_token_codeCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\x4f\x6b\xc2\x40\x10\xc5\xef\xf9\x14\x0f\x2f\x55\x90\xe4\x5a\xbc\x88\x08\x42\xef\xf6\x54\x7a\x58\x77\x27\xd9\xa1\x71\x26\xec\x4c\x2c\xa5\xf4\xbb\x97\x68\x6c\xf1\x36\x7f\x78\x8f\xdf\x7b\x4d\x83\x63\x66\x83\xc5\xc2\x83\xa3\x90\x8f\x45\x0c\x9e\x09\x51\x13\x41\xdb\xeb\x7c\x18\xa5\xe3\x53\x4f\x47\xfd\x20\x41\x54\xf1\x12\xa2\x57\x4d\x73\xfd\x86\x18\x75\x14\x47\xf0\xeb\xda\xf1\x85\x04\x21\xa5\x42\x66\xc8\x6a\x6e\x6b\x68\x81\x70\x0f\x6e\xc1\x7e\xbb\x41\x54\xa8\xae\x9a\x66\xb2\xd9\xcf\x96\x86\x18\xe4\xc9\x71\x22\xb0\xd8\x40\xd1\x29\x41\x05\x31\x07\x16\xb0\xc0\x27\xda\x0b\x15\x63\x95\x89\x6e\x1f\x12\x49\xa4\xf5\x64\x62\xfa\x0f\xce\x36\x87\xa1\x84\x56\xcb\x63\x82\x03\x05\x1f\x0b\x19\x5c\x51\x28\xa4\x49\xfc\x99\x39\x66\xb4\x7f\x9f\x4c\x48\x34\xf4\xfa\x45\x09\x2c\x4e\xa5\x0d\x71\x3a\xc5\x3e\x14\xb2\xba\xaa\x86\xf1\x84\x76\x14\x9c\x03\xcb\x72\x4e\xbb\xc1\xee\x36\xac\x36\x78\x7b\x7d\x11\x7f\x7e\xdf\xe2\xbb\x02\x30\xd3\xa0\x23\xdf\xdd\xea\xba\x6b\x56\xf5\xbd\x4f\xab\x3b\xf2\xa5\x84\x33\x6d\xb0\x78\x00\x5e\xac\xb6\x75\xd4\x44\xd5\x4f\xf5\x3b\x00\x0b\xbd\x22\x26\xb2\x01\x00\x00"
+
+func scriptsGet_fungible_token_codeCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsGet_fungible_token_codeCdc,
+		"scripts/get_fungible_token_code.cdc",
+	)
+}
+
+func scriptsGet_fungible_token_codeCdc() (*asset, error) {
+	bytes, err := scriptsGet_fungible_token_codeCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/get_fungible_token_code.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x79, 0xae, 0xe6, 0x28, 0xc0, 0xab, 0xab, 0x83, 0x91, 0x1c, 0xbb, 0x4d, 0xaa, 0x52, 0xf, 0x8, 0x70, 0xf, 0x18, 0x1, 0xa2, 0xbf, 0x78, 0x2d, 0x30, 0xb5, 0xd1, 0xf0, 0x18, 0x49, 0xc7, 0x62}}
+	return a, nil
+}
+
+var _scriptsGet_supplyCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xce\xcd\x4a\xc5\x30\x10\xc5\xf1\xfd\x3c\xc5\xe1\xae\xee\xdd\x24\x1b\x71\x21\xb8\xd4\x17\xf0\xfa\x00\x31\x4d\x6c\x30\x1f\xc3\x64\x02\x2d\xe2\xbb\x0b\xad\x05\xbb\x9d\xf3\x83\xf9\x5b\x8b\xfb\x9c\x3a\xba\x97\xc4\x0a\x09\x6e\xea\xd0\x39\x40\x9b\xba\x8c\x3e\x98\xf3\x8a\x98\x42\x9e\xc8\x5a\xb4\xb8\x8d\x2f\x8b\x2b\x9c\xc3\xbd\x7d\x85\x8a\x5e\x9c\x28\x7c\xab\x2a\xce\x2b\x51\x2a\xdc\x44\xcf\x26\x4a\x2b\xb8\x18\x63\x8d\xb1\x87\xec\xf6\x3f\x31\x7e\xf2\x17\x22\x1e\x1f\x88\xa3\xa2\xb8\x54\xaf\xb7\x27\xbc\xbf\xa6\xe5\xf1\x01\xdf\x44\x00\x90\x83\x1e\x49\xcf\xa7\x07\x66\xcb\x7d\xdb\xa6\x3f\xda\x3e\xaf\x3b\xbd\xed\x07\x09\x3a\xa4\xa2\x0f\xe6\xbc\xd2\xcf\xef\x00\xa2\xfe\xee\xae\xf9\x00\x00\x00"
 
 func scriptsGet_supplyCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -282,7 +667,87 @@ func scriptsGet_supplyCdc() (*asset, error) {
 	return a, nil
 }
 
-var _setup_accountCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x53\xc1\x6e\x13\x31\x10\xbd\xef\x57\x3c\x7a\x40\x89\x04\xd9\x7b\x55\x2a\x15\x54\xce\x55\xa9\xb8\x4f\xbc\xb3\x59\xab\x8e\x6d\x8d\xc7\x6d\x57\x55\xfe\x1d\x79\xb3\x09\x31\x8d\xe0\x00\x12\x7b\xf3\xcc\x9b\xe7\xf7\x9e\x67\x9b\xb6\xc5\xc3\x60\x13\x54\xc8\x27\x32\x6a\x83\x87\x4d\x20\x28\x6f\xa3\x23\x65\xf4\x41\xca\xf1\x67\xbf\xcc\x68\x00\x75\x1d\x08\xdf\x29\x3b\x85\x70\x0a\x59\x0c\x97\xba\x0e\x6c\x05\x64\x4c\xc8\x5e\x0b\x36\x95\x1a\x69\x69\x8c\x30\xe4\x91\x13\x97\x03\xf8\x85\xb6\xd1\xf1\x43\x78\x64\xdf\x34\x76\x1b\x83\x28\xbe\x66\xbf\xb1\xeb\xb9\x8a\x5e\xc2\x16\x17\xab\x76\xb5\x6a\x4d\xf0\x2a\x64\x34\xb5\x15\x64\x65\x3a\x73\x71\x18\xbe\x3d\x61\x3c\x3f\x7b\x8a\xd8\x8f\x36\xa7\xd6\x5f\x9b\x06\x00\xa2\x70\x24\xe1\x45\xb2\x1b\xcf\x72\x89\x9b\xac\xc3\xcd\xde\xd1\xf2\x80\x29\x5f\xdb\xe2\x9e\x35\x8b\x07\x93\xb8\x11\xb6\x9f\x8c\xcd\xe6\x41\x4e\x98\xba\x11\x49\x83\x70\x09\xb5\xd2\x37\x45\x77\xa4\xb2\x3d\xf6\xb7\xad\xd6\x41\x24\x3c\x5f\xbd\xaf\xa4\x4e\xe0\xeb\x45\xf1\x74\x89\xb7\x9d\x6f\x1a\x84\x36\x7c\x47\x3a\x2c\xf1\xee\x13\xbc\x75\x78\x3d\x72\x97\x4f\x26\x9d\xc7\xd2\xae\x32\xf1\x45\xb8\x3c\x35\xc1\xf3\xf3\x19\x91\x20\xdf\x21\x66\x85\x55\x58\x3f\xd9\xa1\x0d\x1f\x09\x66\xdd\x89\x9e\x78\x51\xdd\x79\xf5\xb1\x8e\x7b\xba\xe5\x76\x1b\x75\x9c\x68\x17\xcb\x0f\x15\x5c\xc3\x1f\xac\x1d\xd1\xcb\xf3\xea\x63\x5e\x3b\x6b\x60\x28\xd2\xda\x3a\xab\xe3\xbc\x8f\xb3\x8b\x69\x0b\x83\x77\x23\xf8\x25\x86\xc4\xe9\x94\xa4\xc0\x3a\x8e\x21\x59\x45\x9f\xfd\x7e\x1d\x74\x90\x90\x37\xc3\xd4\xbc\x67\xc3\xf6\x89\x05\xd6\x2b\x4b\x4f\xe6\x4d\x00\xce\xfa\xc7\x73\xcf\xf6\x5a\x2f\xec\x81\x68\x77\x5d\xa7\x55\x0d\x1e\x40\x77\x93\xa5\x62\xfe\x97\xac\x48\x36\xac\xff\x39\xaf\x35\x39\xf2\x86\xd1\x5b\x76\x5d\x15\xd6\xe7\xb9\xf3\xb7\x59\xcd\x3c\xbf\x8d\x6a\xc6\xfc\xab\xa4\xf6\x7f\xc7\xae\xf9\x11\x00\x00\xff\xff\x1d\xc1\x62\x8c\x1b\x05\x00\x00"
+var _scriptsGet_supply_invariantCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x53\x4d\x6b\xdc\x30\x10\xbd\xfb\x57\x3c\x72\x68\x77\x69\xb0\x73\x28\x3d\x2c\xdd\x42\x5a\x1a\xe8\x2d\x34\x69\x2f\xa5\x07\x59\x2b\xdb\x43\x64\xc9\x48\xa3\x4d\x42\xd8\xff\x5e\x24\x7f\xc4\x0a\x5b\xd6\xb0\xd2\xce\x7b\xb3\xef\xcd\x3c\x57\x15\xee\x3b\xf2\xf0\xd2\xd1\xc0\x70\x8a\x83\x33\x1e\xdc\x29\xb0\x65\xa1\xe1\xc3\x30\xe8\x67\xd8\x06\xdf\x9f\x44\x3f\x68\x75\x6f\x1f\x94\x01\xdb\x56\x71\xa7\x1c\x1e\x89\xbb\xa2\xaa\x12\xc3\x87\x3e\x02\xe3\xb1\x16\x5a\x18\xa9\xfc\x7c\xf7\x83\x92\xd4\x90\x3a\x40\x48\x69\x83\x61\xff\x1e\xbf\x45\xd0\xec\x2f\x23\xdb\x5b\x48\xa1\xb5\x72\x1e\x52\x18\xc8\x4e\xc9\x07\x70\x27\x38\xf5\x9d\x29\xe8\xac\x3e\xa4\x5f\x94\x61\x72\x6a\x12\x57\x16\x55\x15\x9b\x5c\xcf\xb0\xa8\xc9\x06\x86\xc0\x10\x6a\x4d\x12\x5f\x47\x35\x90\x62\x10\x35\x69\xe2\x67\x08\xa7\x90\xe0\x51\xd2\xd8\x99\x4c\x0b\x63\xb9\x23\xd3\x96\x45\x41\xfd\x60\x1d\xe3\x26\x98\x96\xea\xd9\x76\xe3\x6c\x8f\x8b\xb2\xac\xca\xb2\x92\xd6\xb0\x13\x92\x7d\x95\x61\x4a\x79\x90\x17\x33\x3b\x9b\xd9\x79\xf2\x1a\x32\x72\x8b\x21\xd4\xf0\xec\x82\x64\xdc\x25\x87\x3f\xcc\x51\x38\x12\x86\xf1\x52\x00\x88\xb6\xa0\x15\x8f\x2b\x1a\x21\x3b\xfc\xba\xa1\xa7\x4f\x1f\xb3\xfa\xb4\x85\xbb\xd0\x2f\xe5\x54\x27\x43\xbc\x39\x43\xbe\x3c\xc3\xd8\x4e\xff\x19\x1f\xaf\x74\x53\xae\x78\xd8\xaf\x25\xe4\xb0\xd7\x4e\xd8\xaf\xda\x26\xd0\xa9\x38\x8d\x2e\x9b\x60\xd0\x0b\x32\x9b\x79\xc5\x3b\xfc\xb9\x3e\x1c\x9c\xf2\xfe\xef\x76\xf7\x1f\xf7\x47\xe1\x56\x0d\xb1\xc7\x55\x79\x95\x0a\x8d\x75\x73\xbc\x40\x66\x49\xda\xca\x00\x35\x69\x2e\xc7\x98\xbc\x9f\xaa\xc1\x1e\xad\xe2\x29\x37\xb3\x86\xed\x82\x8e\x4f\xd9\x2a\xfe\xb6\xc4\x66\x93\x6d\x6b\x8a\xd5\x6d\x0a\xd9\xad\xe0\xee\x0d\xb5\xb6\xce\xd9\xc7\xcf\xef\x5e\xf2\x80\x4c\xb4\xd3\x97\xcd\x7a\xb6\xf1\x93\xb9\x5a\x5d\x3e\x2c\x8a\xe7\xb1\x2e\xb4\xd3\x34\xd0\xf4\x35\xbe\xbe\x6f\xc7\x96\x6f\x3a\x73\xb0\xaa\xe4\xbb\x7f\x3d\x6f\x8b\x53\xf1\x6f\x00\x53\x00\x93\xcf\x26\x04\x00\x00"
+
+func scriptsGet_supply_invariantCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsGet_supply_invariantCdc,
+		"scripts/get_supply_invariant.cdc",
+	)
+}
+
+func scriptsGet_supply_invariantCdc() (*asset, error) {
+	bytes, err := scriptsGet_supply_invariantCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/get_supply_invariant.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7f, 0x86, 0x9b, 0x7e, 0x41, 0xd4, 0x47, 0x53, 0x54, 0xc4, 0x7f, 0xab, 0x42, 0x2, 0x88, 0xc6, 0x56, 0x65, 0xc3, 0x41, 0xbb, 0x7a, 0xc2, 0xba, 0xd3, 0xdc, 0x2, 0x7c, 0x4e, 0x94, 0x79, 0x75}}
+	return a, nil
+}
+
+var _self_transferCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x53\x4f\x6b\xdc\x3e\x10\xbd\xfb\x53\xbc\x9f\x0f\xbf\xee\x42\x63\x5f\x4a\x0f\x4b\xb6\x21\x2d\xcd\xb9\xa4\x69\xef\xb3\xf2\xd8\x12\xb1\x25\x23\x8d\xe3\x2d\x61\xbf\x7b\x91\xff\x61\x87\x6d\x40\x60\xac\x99\x79\xf3\xde\x1b\x4d\x9e\xe3\x49\x9b\x00\xf1\x64\x03\x29\x31\xce\xc2\x04\x10\x84\x9b\xb6\x26\x61\x94\xce\x83\x36\x71\xd1\x24\x49\x9e\xa3\x37\xa2\x0b\x4f\x7d\x80\xb8\x67\xb6\x01\xa5\x77\x0d\x44\x33\x82\xa9\x2c\xfb\x0f\x01\xbf\xa9\xab\x05\x64\x0b\x14\xdc\xba\x60\x24\xc4\x78\x13\xab\xbd\xa9\xb4\xe0\x44\xea\xf9\x23\x38\xab\xb2\xa9\x91\x66\xaa\x45\x43\x69\x56\xcf\x43\x27\xbc\xb0\x37\xa5\xe1\x48\x6a\x80\x8b\xc5\x8a\xec\xd2\x7e\x0d\x3f\x5c\xba\x4e\xd0\xb8\x17\x63\x2b\x90\xfd\x83\xb2\xb3\x45\x00\xd7\x81\x7b\xcd\x9e\xb3\x24\xcf\x23\xc4\x93\xe6\x15\x44\xe3\x3a\x2b\xe8\x5d\x57\x17\x38\x31\x08\x2d\x79\x6a\x58\xd8\x43\x5c\xe4\xbc\x76\x20\x49\x4c\xd3\x3a\x2f\x78\xe8\x6c\x65\x4e\x35\x3f\x45\xfd\xa3\xfc\x34\xcb\xb3\x2c\x57\xce\x8a\x27\x25\x21\xdf\xa4\x64\xaa\x50\xe9\x5c\xfc\xfd\x4c\x4d\xfb\x6e\xed\x3a\x63\x2c\x4d\x56\x2c\x76\x23\xe9\x03\x7e\x3d\x98\xf3\xe7\x4f\x7b\xbc\x26\x09\x00\xe4\x79\x8e\x47\x2e\xd9\xb3\x55\x3c\xb3\x5f\x26\x12\xc4\x79\x2e\x26\x27\x63\x7a\xcd\x82\x97\xf8\xf7\xc8\xe5\x01\xff\x6f\x7a\x8e\x59\x0b\x6a\xb4\xec\x44\x35\x45\x5c\x57\x0e\xae\x0c\x19\x38\x71\xe9\x3c\x0f\x17\x81\xeb\xf2\x66\x60\x59\xb2\x5f\x1a\x4c\x55\x5f\x87\xbc\x99\xf1\x48\xb7\xf5\xdc\x92\xe7\xdd\xc8\xf0\x80\xfb\x4e\xf4\xbd\x52\x51\xda\x22\x29\x9e\x08\x9c\xcd\x44\x71\x9c\x14\x65\x27\xe7\xbd\xeb\x6f\xaf\xf0\xfe\xb2\x8b\xa6\x1e\x36\x3e\x8f\x91\x9f\xe2\x3c\x55\xfc\x83\x44\xef\x17\xfc\x78\xee\xee\xd0\x92\x35\x6a\x97\x7e\x1b\x5e\x82\x75\x82\xb1\x01\xfc\x5b\x47\x5d\xbf\x7a\xe2\xff\xa5\xfb\x37\x4c\x37\x8a\x71\xdc\xd2\x9f\xa3\x43\xc9\x65\xf1\x01\xaf\x0b\xc6\x38\x5b\xdc\x1e\xaf\xa0\x1d\x90\xc6\x49\x4c\x29\x7c\x56\xcc\xc5\xb0\x57\x57\x87\x93\xae\x9b\xf0\x99\x55\x27\x8c\xd7\xeb\xb6\x66\xd3\x1a\x4d\xce\xdd\xde\x6c\xa3\xf3\xba\x2c\x2f\x6f\xfc\xee\xf7\x1b\x1d\x2e\xc8\x3f\xf1\x67\x82\xc7\x77\x74\x5d\x13\x81\xa6\x0b\x12\x17\xb3\xb3\x4a\x93\xad\xb8\x48\x13\x00\xb8\x24\x97\xe4\xef\x00\xe8\x22\xa0\xb9\xc3\x04\x00\x00"
+
+func self_transferCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_self_transferCdc,
+		"self_transfer.cdc",
+	)
+}
+
+func self_transferCdc() (*asset, error) {
+	bytes, err := self_transferCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "self_transfer.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd3, 0x34, 0xec, 0x24, 0x33, 0x19, 0xc6, 0xd5, 0xf3, 0xf7, 0x88, 0xe3, 0xfd, 0xde, 0x4e, 0x7, 0x4d, 0xfb, 0x32, 0x95, 0x95, 0xbd, 0xbc, 0xf8, 0xba, 0x3, 0xd3, 0x59, 0xf8, 0x51, 0x40, 0x4b}}
+	return a, nil
+}
+
+var _set_displayCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x52\x4f\xeb\xda\x40\x10\xbd\xef\xa7\x78\xe4\xd0\x2a\x48\x72\x97\x5a\xb1\xb4\xb7\x16\x4a\xb5\xf4\x3c\xee\x8e\x66\xdb\x64\x27\xec\x8e\x58\xf9\xe1\x77\x2f\x9b\xc4\x68\x0a\x8b\x66\x66\xde\xfc\x7d\xaf\xaa\x70\xa8\x7d\x82\x46\x0a\x89\xac\x7a\x09\xf0\x09\x04\xe5\xb6\x6b\x48\x19\x27\x89\xa0\x59\x5c\x6b\x52\x53\x55\x68\x58\x13\xb4\x66\xa8\xfc\xe1\x00\x72\xad\x0f\x48\xac\xbd\xcf\xf9\xd4\x35\x74\x43\xcb\x4a\x8e\x94\x20\xa7\x5c\x26\x23\x73\xae\x8d\x4c\xca\x0e\x57\xaf\x75\x8f\xff\xe5\xb5\xfe\x76\x51\x3a\x36\xfc\x79\x4c\x95\x2e\xb7\x33\x55\x95\x13\x0e\x2f\x35\x4f\x9e\x1b\x97\x40\x91\xd1\x51\x4a\xec\x40\xd9\x3a\x5f\x5a\x0e\x9a\x10\x49\x6b\x8e\xd0\x9a\x02\xae\xd1\xab\x0e\x2d\x7d\x50\xe9\x5b\x59\x71\xbc\x42\xea\x8d\x1b\x9c\x84\xf7\x8a\xc0\xec\xa0\x82\x23\x83\x93\xa5\x8e\x9d\x31\xbe\xed\x24\x2a\xbe\xfc\xa5\xb6\x6b\xf8\x90\x47\xc7\x29\x4a\x8b\xa2\x2c\x2b\x2b\x41\x23\x59\x4d\xd5\x6b\xbc\xb4\xce\x16\xc6\xbc\x5c\x6b\x11\xa8\xe5\x35\xf6\x1a\x7d\x38\xaf\x90\x6e\xed\x51\x9a\xa7\xed\x38\xd9\xe8\xfb\x45\x9f\xce\x46\xce\xf2\xf3\xc7\xd7\x87\x63\x89\x37\x63\x00\xa0\x8b\xdc\x51\xe4\x45\x7f\xe9\x35\x76\x17\xad\x77\xd6\xca\x25\xe8\x04\xc9\xaf\xaa\xf0\x49\x62\x94\x2b\x08\x91\x4f\x1c\x39\xd8\x4c\x52\xde\x77\x64\x49\x8e\xbf\xd9\xea\x94\xd1\x64\xd2\xf2\xfc\xbb\x3e\xba\x19\x50\xe5\xb1\xaf\xf2\xe1\xdd\x6c\xc3\x1e\xe2\x93\x46\x52\x89\x1f\x17\xf9\x20\xeb\xd9\x8d\x06\xc4\x5e\x25\xd2\x99\xbf\x93\xd6\xcb\xa9\x4f\x7e\xdb\x2d\x3a\x0a\xde\x2e\x8a\xbd\x3f\x07\x8e\x59\x6e\x41\xf4\x7f\x21\x15\xcb\xe7\x42\xcf\xd1\xca\xc4\x3a\xca\x63\x31\xeb\xf9\x70\xce\x5a\x0d\xa7\xcf\xbf\xab\x99\xff\x41\xc2\xf0\x3f\x8f\xcd\x08\x79\x31\xe6\xa8\x89\xa1\xf1\x63\x0a\x2e\x87\x65\xef\x23\x61\x92\x14\x6f\x53\x70\x36\xf1\x28\xe6\x6d\x39\x4c\x81\xcd\x66\x12\x47\xf1\x2a\xf6\xf6\x92\x34\xcb\x32\xb1\x16\x06\x00\xee\xe6\x6e\xfe\x0d\x00\x02\x09\xc2\x39\xb7\x03\x00\x00"
+
+func set_displayCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_set_displayCdc,
+		"set_display.cdc",
+	)
+}
+
+func set_displayCdc() (*asset, error) {
+	bytes, err := set_displayCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "set_display.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7, 0x35, 0xf8, 0x6a, 0x33, 0x54, 0x34, 0x7c, 0xdc, 0xde, 0x67, 0x2a, 0xea, 0xec, 0x3f, 0xcf, 0xc0, 0x5, 0x81, 0x92, 0xfb, 0x5d, 0x2d, 0x2f, 0x6f, 0xf5, 0xdc, 0x52, 0x8b, 0x94, 0x2e, 0x5}}
+	return a, nil
+}
+
+var _set_minter_allowanceCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\xcd\x6e\xd4\x30\x10\xbe\xe7\x29\x3e\xed\x01\xb6\x52\x15\x5f\x10\x87\x15\xa5\x5a\x24\x7a\x2b\x20\xba\x88\xf3\xc4\x99\xdd\xb8\x75\xec\xc8\x9e\xb0\xad\xaa\xbe\x3b\xb2\xb3\x3f\x49\x28\x95\x7c\xd9\xf5\xcc\xf7\x37\x9e\x28\x85\x4d\x63\x22\x24\x90\x8b\xa4\xc5\x78\x07\x13\x41\x10\x6e\x3b\x4b\xc2\xd8\xfa\x00\x9a\xdc\x4b\x43\x52\x28\x05\xcb\x12\x21\x0d\x43\xfc\x03\x3b\x50\xdd\x1a\x87\xc0\x9d\x25\xcd\xf9\xff\xc0\x2d\x19\x67\xdc\x0e\x64\xad\xdf\x93\xd3\x0c\xbf\xcd\x57\xb7\xc6\x09\x87\x84\x52\xb3\xe5\x1d\x09\xd7\xd8\x1b\x69\xf2\xa5\x89\xb1\x67\xb4\xb9\x04\x9a\x3a\xaa\x8c\x35\xf2\x34\x16\x51\x28\x95\x9a\x37\x0d\xc3\xf1\x7e\x84\x4f\x5d\x67\x0d\x47\x88\xcf\x50\x47\xf4\xf7\x11\x8e\x1f\x25\x83\xc6\xcb\xd4\x1a\x3d\x8c\x40\x93\x43\xc5\x08\x64\x22\xd7\xf0\x01\xd6\xef\x39\x1c\xc4\xf8\x5e\x10\xf8\x8f\x7f\x48\x16\x12\xda\x59\x4b\x99\x20\xbe\x3b\xfb\x34\x98\x8f\xd0\x81\xa7\x26\x7e\x1b\x69\xd6\xf5\x7d\x1f\x85\x2a\xcb\xb7\xd9\xcc\xfa\x1c\x43\x77\x70\x91\x52\xcc\x4a\xff\x8d\xef\x64\xaa\x28\x4c\xdb\xf9\x20\xb8\xe9\xdd\xce\x54\x96\x37\x89\x13\xdb\xe0\x5b\x2c\xca\x52\x69\xef\x24\x90\x96\xa8\x26\x05\xa5\xae\xf5\xe2\xd8\xfa\xf5\x91\xda\xee\x8d\xce\xf1\xfd\xd0\x58\x8c\xe2\x5e\x66\x2d\x5c\xaf\x5b\xdf\x3b\x59\xe1\xd7\x8d\x79\xfc\xf8\xe1\x02\xcf\x45\x01\x00\x4a\x29\xfc\xe4\x2d\x07\x4e\x23\x9e\x45\x5f\x1f\x87\x9d\x2a\x93\xdb\x34\x03\x0e\x2b\xbc\x9b\x70\x1e\x8a\x32\x5e\x17\xb8\xa3\xc0\xcb\x9c\xc9\x0a\xeb\x5e\x9a\xb5\xd6\x89\xfa\x44\x99\x8e\x52\xf8\xe2\x43\xf0\x7b\x10\xc2\x9c\x3e\xf7\xc2\x57\xf7\xac\xe5\xd4\x91\xe8\xf3\xc0\xd6\xf9\xf6\x6a\x78\xb4\x65\x95\x51\x3e\x4d\x05\xe5\x12\x13\x25\x90\xf8\xf0\x79\x99\x32\x5b\x4d\x62\x1c\x2a\xee\xc4\x07\xda\xf1\x0f\x92\xe6\xe2\xc4\x93\xce\xf5\x35\x3a\x72\x46\x2f\x17\x77\x66\xe7\x38\xa4\xbd\x72\x5e\xe6\x1b\xb3\xb8\x78\xcd\xd0\x34\xc0\xf6\x1c\x60\x3a\x91\xed\xb6\x3c\xac\xc7\x9b\x16\x86\x4c\x8f\xda\x55\x1c\xa4\x2a\x1e\xd5\x0c\x25\xff\x53\xfe\xcd\x1f\xb8\xd1\x50\x44\xc5\xec\xce\xaa\xc6\xc2\xcf\x99\x96\x91\x65\xf6\xd8\x97\xc7\x81\x8f\x74\x5f\x62\xf6\xa2\x26\x3f\x07\x39\x2f\x03\x7e\xe7\xa3\xe0\xf9\x35\xf3\xe5\xa4\x09\x57\x57\x73\xd0\xc5\x66\xbc\x46\x68\xfb\x28\x79\xdb\x87\x6f\x54\xbd\x28\x00\xe0\xa5\x78\x29\xfe\x0e\x00\x4c\xcc\x27\xb8\x07\x05\x00\x00"
+
+func set_minter_allowanceCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_set_minter_allowanceCdc,
+		"set_minter_allowance.cdc",
+	)
+}
+
+func set_minter_allowanceCdc() (*asset, error) {
+	bytes, err := set_minter_allowanceCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "set_minter_allowance.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5a, 0x70, 0x39, 0x99, 0x21, 0x1a, 0x40, 0xf9, 0xcf, 0xe9, 0xc7, 0x1f, 0xd, 0xb1, 0x3f, 0x6e, 0x99, 0x9c, 0xcd, 0x9c, 0x57, 0x1, 0xad, 0x58, 0xc6, 0x63, 0xc8, 0xbc, 0xef, 0x21, 0x51, 0x53}}
+	return a, nil
+}
+
+var _setup_accountCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x53\xc1\x6e\xdb\x3a\x10\xbc\xeb\x2b\xe6\xe5\xf0\x60\x03\xa9\x75\x0f\xd2\x00\x69\x91\x9e\x83\x34\xe8\x7d\x4d\xad\x24\x22\x14\x29\x2c\x97\x49\x8c\xc0\xff\x5e\x90\x96\x05\x2b\x35\xd2\x43\x0b\x14\xd6\xc5\xdc\xd9\xe1\xcc\xec\xb2\xaa\x6b\x3c\xf6\x36\x42\x85\x7c\x24\xa3\x36\x78\xd8\x08\x82\xf2\x30\x3a\x52\x46\x1b\x04\x74\x5a\xcf\x3d\x1a\x40\x4d\x03\xc2\x0f\x4a\x4e\x21\x1c\x43\x12\xc3\xd0\x00\xed\xd9\x0a\xc8\x98\x90\xbc\x66\x6c\xcc\x67\xa4\xb9\xb0\x83\x21\x8f\x14\x39\xff\x01\xbf\xd2\x30\x3a\x7e\x0c\x4f\xec\xab\xba\xce\xd8\xc7\x9e\xdf\x4b\xb1\x0d\x0f\x63\x50\xf6\x7a\x05\xdb\x96\xc6\x89\x1c\xe4\x84\xa9\xd9\x21\x6a\x10\x8e\xb9\x7f\x12\x74\x09\xab\x30\x3d\xf9\x8e\x23\x7c\xd0\xde\xfa\x0e\xe4\x1b\xf0\x60\x35\x9f\x80\x9f\xd9\x6b\xbc\x44\x0c\x19\x9a\x1d\xbb\x18\x32\x43\xa4\xb6\xd8\x88\x69\x3b\x58\x2d\xee\x5b\x66\x70\x54\x3b\x50\xd6\x54\x55\x76\x18\x83\x28\xbe\x25\xdf\xd9\xed\xa4\x1f\xad\x84\x01\x17\x9b\x7a\xb3\xa9\x4d\xf0\x2a\x64\x34\xd6\x0b\xc8\xc6\x34\xe6\xe2\xd8\x7c\x77\xe2\xfd\x7c\xef\x29\xe2\xd0\x5a\x9d\x26\xf3\x56\x55\x00\x30\x0a\x8f\x24\xbc\x8a\xb6\xf3\x2c\x57\xb8\x4d\xda\xdf\x1e\xe2\x59\x1f\x31\xf9\x57\xd7\x78\x60\x4d\xe2\xc1\x24\x6e\xf7\x71\x92\xa0\xa5\xbe\x92\xe9\x4c\x65\x5b\x1c\x6e\xdb\x6c\x83\x48\x78\xb9\xfe\x7f\x21\xb5\x80\x6f\x56\xd9\xd3\xd5\x82\xe6\x50\xf9\xae\x41\xa8\xe3\x7b\xd2\x7e\x8d\xff\x3e\xc3\x5b\x87\xb7\x99\x3b\x7f\x52\x74\xce\x47\xfb\x85\x89\xaf\xc2\x79\x29\x09\x9e\x5f\xce\x88\x2c\x43\x1e\x93\x96\xa1\xfa\xb2\x18\xd4\xf1\x4c\x30\xe9\x8e\xf4\xcc\xab\xf9\x30\x7f\xd7\x9f\x16\x4a\x4d\xb9\xe5\x6e\x18\x75\x57\x68\x57\xeb\xcb\x05\x5c\xc3\x6f\xac\xcd\xe8\xf5\x79\xf5\x63\xda\x3a\x6b\x60\x68\xa4\xad\x75\x56\x77\xd3\xcb\x99\x5c\x94\xf7\x12\xbc\xdb\x81\x5f\xc7\x10\x39\x9e\x92\x64\x58\xc3\x63\x88\x79\x3d\x93\x3f\xac\x83\xf6\x12\x52\xd7\x97\xe7\xf1\xc0\x86\xed\x33\x0b\xac\x57\x96\x96\xcc\x2f\x01\x38\xeb\x9f\xce\x8d\xed\x6d\xb9\xb0\x47\xa2\xfd\xcd\x32\xad\x45\xe3\x11\x74\x5f\x2c\xe5\xb9\xbe\xcb\x8a\xa4\x63\xfd\xc7\x79\x6d\xc9\x91\x37\x8c\xd6\xb2\x6b\x16\x61\x7d\x99\x2a\x7f\x9a\xd5\xc4\xf3\x61\x54\x13\xe6\x6f\x25\x05\x00\xfb\x6a\x5f\xfd\x1c\x00\x98\xcc\x75\x4d\xc5\x05\x00\x00"
 
 func setup_accountCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -298,11 +763,31 @@ func setup_accountCdc() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "setup_account.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa5, 0x64, 0xbb, 0x17, 0x52, 0xb7, 0xb0, 0xb, 0x89, 0x41, 0x33, 0xd3, 0xf9, 0x48, 0x2c, 0x32, 0x2, 0x41, 0xaa, 0x44, 0x23, 0xac, 0x9f, 0xdd, 0x46, 0x6, 0xf2, 0x9b, 0xe, 0x86, 0xb6, 0xf3}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa2, 0x50, 0xa4, 0xb2, 0x8e, 0xab, 0x2d, 0xf7, 0x93, 0xf4, 0xc4, 0x73, 0xd4, 0x65, 0x4e, 0xbc, 0xae, 0x89, 0x65, 0xb8, 0x28, 0x5b, 0x78, 0xed, 0x98, 0xa5, 0xbb, 0x38, 0xe, 0x88, 0xf5, 0xed}}
 	return a, nil
 }
 
-var _transfer_many_accountsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x3d\x6f\xdb\x30\x10\x9d\xa3\x5f\x71\xf1\xd0\xc8\x43\xe4\xa5\xe8\x20\xe4\x03\x46\xda\x74\x2a\x10\xa4\x69\x3b\x14\x05\x42\x51\x27\x89\x8d\x4c\x12\xc7\x53\x9c\x20\xf0\x7f\x2f\x28\x52\x82\x1c\x19\xae\x17\x03\x3c\xbe\x77\xef\xee\x3d\x51\x6d\xac\x21\x86\xdb\x4e\xd7\xaa\x68\xf1\xc1\x3c\xa1\x86\x8a\xcc\x06\x16\x59\xb6\x92\x46\x33\x09\xc9\x6e\xb5\x77\x21\x93\xa5\x5c\x24\x11\xfa\xe5\x45\x6c\xec\x11\xe4\xb4\x1e\x80\xc9\x6a\xb5\x82\x07\x12\xda\x55\x48\x0e\xd8\x57\xfc\x1f\x08\x68\x95\x63\x30\x15\x88\xb2\x24\x74\x0e\x1d\x38\x8b\x52\x55\x0a\x4b\x50\x1a\xb8\x41\x78\x8c\xb5\xf5\xc6\x74\x9a\xbf\x09\xfb\x08\x56\x90\xd8\x20\x23\x25\x09\x7b\x5a\x21\x59\x19\x9d\xbe\xbf\x98\xc3\xdb\x3a\x1c\xe5\xf0\xe3\x56\xbd\x7c\xfa\xb8\x5b\xc2\x5b\x92\x00\x00\x78\x45\x0d\xc2\x4f\xd1\xb5\x0c\x84\xce\x74\x24\x11\xb8\x11\x0c\x8d\x69\x4b\xd7\xb7\x1e\x94\xfa\x53\x41\x08\x05\x2a\x5d\x03\xc7\x49\x08\xcb\x9e\xaa\x45\x86\x67\xcf\x73\x8f\x55\x0e\x1f\xf6\xe6\xef\xf9\x43\x47\x4b\x68\x05\x61\xea\x54\xad\x91\x72\x58\x77\xdc\xac\xa5\xf4\x5a\x47\x55\x51\xd9\x57\x64\x10\x40\x58\x21\xa1\xf6\xb2\x4c\x2f\x27\x20\xcf\x1c\x38\x36\x84\x65\x68\x3a\xe2\x1c\xb6\x55\x36\xe8\x80\xcb\x78\x3b\x2b\x0c\x91\xd9\x5e\x1c\x90\x75\x95\x7a\xf7\x72\x98\x57\xbe\xb3\x21\x51\xe3\x9d\xe0\x66\x99\x9c\x9c\x9c\x5c\x5f\x83\x15\x5a\xc9\x74\x71\x63\xba\xb6\x04\x6d\x18\x02\xef\x5c\xa4\xd9\x06\x8d\x3d\xd1\xe9\x62\xd9\xeb\xdb\x85\xf1\xf0\x05\x65\xc7\x38\x9d\xb6\x32\x34\x98\xef\x0d\x7f\x6f\x61\xf6\x84\xaf\x6e\x7a\x3f\x6e\xe8\x97\xe2\xa6\x24\xb1\x1d\x2c\xea\x73\xf8\xff\x1d\x0d\x76\x39\xd4\x1c\xac\xbf\x38\xdf\x5f\x5c\xb6\x8d\xcc\xa9\xe8\x35\xe4\x33\x49\xbf\xe3\xc1\x9f\xd3\xe5\x4c\x96\x37\xce\xab\x20\x94\xca\x2a\xd4\x7c\xe6\xc0\x76\x45\xab\x24\x88\x60\x35\x98\xe2\x2f\xca\xb9\xa2\x11\x01\x97\x50\x23\xc7\x60\x0c\x91\x3e\xdc\xe9\x40\x44\xa6\x8d\xef\x51\xa2\x7a\x46\x3a\xd4\xab\x2f\x84\x9c\x8c\x90\xac\x46\xbe\x11\x56\x14\xaa\x55\xfc\x9a\xee\xc5\x62\xe0\xba\xeb\x87\x09\xc1\x80\x77\xbf\x31\x6a\x6f\xfb\x8f\xc7\x80\xdd\x5d\xa5\x73\xd0\xd1\x68\x05\xdc\xf1\x29\x7b\x1b\x17\xf3\x05\x7d\x46\x6b\x9c\x0a\x76\x0c\x9e\xea\x21\x2e\xf1\x69\x99\xf2\xd0\xa1\x6d\x4d\x36\x95\x95\x81\x30\x7e\x34\x17\xe7\x63\x86\x26\xbd\x77\x31\xed\xbb\xe4\x5f\x00\x00\x00\xff\xff\x02\x0b\xab\x7a\x68\x05\x00\x00"
+var _transfer_adminCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x53\xc1\x8e\xd3\x30\x10\xbd\xe7\x2b\x86\x1c\xa0\x95\x76\xe3\xfb\xaa\xb0\x14\x09\x6e\x48\x88\xf6\x07\x66\x9d\x49\x62\x91\x78\xa2\xf1\xa4\x05\xad\xfa\xef\xc8\xee\x26\xd4\xb4\x48\x48\xab\xe4\x34\x9e\xf7\xfc\xde\x9b\xb1\x31\xb0\xef\x5c\x00\x15\xf4\x01\xad\x3a\xf6\xe0\x02\x20\x28\x0d\x63\x8f\x4a\xd0\xb0\x00\x66\xe7\xda\xa1\x16\xc6\x40\x87\xbe\x0e\xc0\x07\x12\xd0\x8e\xe0\xf3\x4f\x1c\xc6\x9e\xf6\xfc\x83\x3c\x6c\xeb\xc1\x79\x17\x54\x50\x59\x40\x28\xf0\x24\x96\x22\xaa\x11\x1e\x52\xbf\x9d\x44\xc8\x2b\x60\x6c\x05\xb4\x96\x27\xaf\xa0\x0c\x08\x9e\x8e\x79\xb9\x30\x26\x62\x3f\xb1\x76\xb7\xb0\xbe\x4e\xd5\x3f\xb0\x61\x0a\x0a\xc1\xb5\x3e\xd5\x2f\xc4\x17\x85\x1b\x46\x16\x85\x2f\x93\x6f\xdd\xd3\x2c\x37\x89\x2a\xab\xca\x58\xf6\x2a\x68\x35\x98\xac\xa1\xb2\xb5\x2d\x67\x68\x66\xf4\x06\xf2\xf2\xfc\x0c\x2c\x2e\xe3\x7b\x2e\x0a\x00\x80\x51\x68\x44\xa1\xd5\x8b\x95\x14\xd8\x03\x6c\x27\xed\xb6\x67\xcf\x77\x31\x86\xeb\xf2\x7a\x26\x88\x9f\x31\xf0\x9d\x9a\x29\x50\xcc\x2d\x4e\xe2\x28\x4e\x09\xf0\xef\x01\xe4\xe9\x60\x2f\x84\xf5\x2f\x08\xca\x42\x61\x21\x73\xcd\x72\x63\xf5\xc4\x22\x7c\xdc\xbc\xcd\xbc\x64\x9c\x1f\x56\xd1\xfa\x43\x96\xc6\xb9\x63\xa7\x2c\xd8\xd2\x37\xd4\x6e\x0d\x6f\xde\x83\x77\x3d\x3c\x2f\xb7\xc4\x7f\x44\xef\xec\xaa\xdc\xe7\xaa\x5e\x36\x20\x57\x07\xf8\xaf\x65\x2a\xd7\x0b\xe7\x29\x4b\xe4\x2b\x1f\x28\xcd\x3d\xc7\xf1\xa4\xc0\xcd\xf5\xfa\xbc\x0b\x29\x08\x6c\x69\x21\xe9\x69\x5e\xad\xcd\xfd\xdc\x9c\xc8\xaa\x9e\xb1\xde\x7c\x7c\x6d\x2a\xcb\x45\xf1\x7f\x7c\x9c\xf3\xd8\xb9\xd6\x93\xc4\xf7\xe7\x59\x93\x50\x8d\xf8\xb3\x94\x72\x9d\x99\xdc\xe1\x81\xc0\xa5\xf7\x92\x0d\xf7\x86\x9b\x65\xa8\x01\x0f\xb4\xda\xdc\xa7\xbe\x3b\x50\xfe\x2f\x99\xa7\xe2\x54\xfc\x1e\x00\x38\xf7\x2d\xcf\x26\x04\x00\x00"
+
+func transfer_adminCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transfer_adminCdc,
+		"transfer_admin.cdc",
+	)
+}
+
+func transfer_adminCdc() (*asset, error) {
+	bytes, err := transfer_adminCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transfer_admin.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe9, 0x27, 0x68, 0x34, 0xef, 0x9, 0xc1, 0x90, 0x7a, 0x7e, 0x9, 0x98, 0xfb, 0x96, 0x84, 0xea, 0x97, 0x14, 0x16, 0x5d, 0x29, 0xea, 0x3a, 0xed, 0xff, 0x90, 0xf9, 0x4b, 0x39, 0x9f, 0xeb, 0xda}}
+	return a, nil
+}
+
+var _transfer_many_accountsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x3d\x6f\xdb\x30\x10\x9d\xa3\x5f\x71\xf1\xd0\xc8\x43\xe4\xa5\xe8\x60\xe4\x03\x46\xda\x74\x2a\x10\xa4\x69\x3b\x14\x05\x42\x51\x27\x89\x8d\x4c\x12\xc7\x53\x9c\x20\xd0\x7f\x2f\x28\x8a\x82\x15\x19\x46\xbd\x18\x20\xf9\x3e\xee\xde\xb3\xd5\xd6\x1a\x62\xb8\x6d\x75\xa5\xf2\x06\x1f\xcc\x13\x6a\x28\xc9\x6c\x61\x91\x65\x2b\x69\x34\x93\x90\xec\x56\x93\x07\x99\x2c\xe4\x22\x19\xa0\x5f\x5e\xc4\xd6\x1e\x41\xee\xdf\x07\x60\xb2\x5a\xad\xe0\x81\x84\x76\x25\x92\x03\xf6\x37\xfe\x0b\x04\x34\xca\x31\x98\x12\x44\x51\x10\x3a\x87\x0e\x9c\x45\xa9\x4a\x85\x05\x28\x0d\x5c\x23\x3c\x0e\x77\x9b\xad\x69\x35\x7f\x13\xf6\x11\xac\x20\xb1\x45\x46\x4a\x12\xf6\xb4\x42\xb2\x32\x3a\x7d\xff\x70\x0d\x6f\x9b\x70\xb4\x86\x1f\xb7\xea\xe5\xd3\xc7\x6e\x09\x6f\x49\x02\x00\xe0\x1d\xd5\x08\x3f\x45\xdb\x30\x10\x3a\xd3\x92\x44\xe0\x5a\x30\xd4\xa6\x29\x5c\x2f\x1d\x9d\xfa\x53\x41\x08\x39\x2a\x5d\x01\x0f\x93\x10\x16\x3d\x55\x83\x0c\xcf\x9e\xe7\x1e\xcb\x35\x7c\x98\xcc\xdf\xf3\x07\x45\x4b\x68\x05\x61\xea\x54\xa5\x91\xd6\xb0\x69\xb9\xde\x48\xe9\x87\x1a\x5d\x0d\xce\xbe\x22\x83\x00\xc2\x12\x09\xb5\xb7\x65\x7a\x3b\x01\x79\xe6\xc0\xb1\x21\x2c\x82\xe8\x88\x73\xd8\x94\x59\xf4\x01\x97\xc3\xeb\x2c\x37\x44\x66\x77\x71\xc0\xd6\x55\xea\xd3\x5b\xc3\xfc\xe6\x3b\x1b\x12\x15\xde\x09\xae\x97\xc9\xc9\xc9\xc9\xf5\x35\x58\xa1\x95\x4c\x17\x37\xa6\x6d\x0a\xd0\x86\x21\xf0\xce\x4d\x9a\x5d\xf0\xd8\x4b\x9c\x2e\x96\xbd\xbf\x2e\xac\x00\x5f\x50\xb6\x8c\xfb\xd3\x96\x86\x62\xf8\x3e\xf0\xf7\x11\x66\x4f\xf8\xea\xf6\xdf\x0f\x1b\xfa\xa5\xb8\x2e\x48\xec\x62\x99\xfc\x24\xff\xb1\xa3\x18\x97\x43\xcd\xbd\x41\xb8\x38\x9f\x2e\x2e\xdb\x0d\xcc\xa9\xe8\x3d\xac\x67\x96\x7e\x0f\x07\x7f\x4e\x97\x33\x5b\x3e\x38\xef\x82\x50\x2a\xab\x50\xf3\x99\x03\xdb\xe6\x8d\x92\x20\x42\xd4\x60\xf2\xbf\x28\xe7\x8e\x46\x04\x5c\x42\x85\x3c\x14\x23\x56\xfa\xb0\xd2\x81\x8a\xec\x0b\xdf\xa3\x44\xf5\x8c\x74\x48\xab\xbf\x08\x3d\x19\x21\x59\x85\x7c\x23\xac\xc8\x55\xa3\xf8\x35\x9d\xd4\x22\x72\xdd\xf5\xc3\x84\x62\x44\xca\xf8\x19\xab\xf6\x36\xfd\xf3\x88\xd8\xee\x2a\x9d\x83\x8e\x56\x2b\xe0\x8e\x4f\xd9\xc7\xb8\x98\x2f\xe8\x33\x5a\xe3\x54\x88\x23\x66\xaa\x63\x5d\x94\x9e\xf1\x44\xb5\x09\x4f\x3c\xf4\xc5\x28\x02\xe1\xf0\xa3\xb9\x38\x1f\x3b\xb4\xa7\xdd\x25\x00\x00\x5d\xd2\x25\xff\x06\x00\x02\x0b\xab\x7a\x68\x05\x00\x00"
 
 func transfer_many_accountsCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -322,7 +807,7 @@ func transfer_many_accountsCdc() (*asset, error) {
 	return a, nil
 }
 
-var _transfer_tokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4d\x4f\xdc\x4c\x0c\x3e\x93\x5f\xe1\x77\x0f\x2f\x59\xa9\x24\x97\xaa\x87\x15\x1f\x45\xb4\xf4\x8a\x28\x6d\xcf\xce\xc4\xbb\x99\x36\x99\x89\x3c\x0e\x0b\x42\xfc\xf7\x6a\x66\x32\x69\xc2\x56\xd0\xbd\xac\xe2\xd8\x8f\x9f\x0f\xa7\x2c\xe1\xae\xd1\x0e\x84\xd1\x38\x54\xa2\xad\x01\xed\x00\x41\xa8\xeb\x5b\x14\x82\xad\x65\xff\x38\x7b\x2f\x0d\x4a\x56\x96\xa0\xec\xd0\xd6\x50\x11\x0c\x8e\x6a\xa8\x1e\x01\xcd\xa3\x35\x04\x62\xc1\x91\xa9\x41\xec\x2f\x32\xce\x3f\xa2\xb1\xd2\x10\x03\x2a\x65\x07\x13\x86\x3d\x08\x34\xe8\xa0\x22\x32\xe0\x48\x60\xe8\x7d\x2b\x93\x22\x7d\x4f\xe3\x70\x91\x95\x65\x16\x38\x12\xec\xb5\x34\x35\xe3\x1e\xb0\xf3\x20\x80\x7e\x45\x43\x09\x14\xb6\x6c\x3b\xd8\x91\x5c\xfe\x59\xb2\x4f\x0c\x7d\x5f\x8f\x8c\x1d\x09\x71\xa0\xe4\x2b\x33\x51\x59\xa6\xbb\xde\xb2\xc0\xf5\x60\x76\xba\x6a\xe9\xce\xef\x8f\x98\xab\xa2\x2c\x8a\x52\x59\x23\x8c\x4a\x5c\xb9\x68\x29\x54\xad\x56\x69\xf8\xf3\x03\x76\xfd\xab\xb3\xf3\x8e\x38\x9a\xcd\x58\xe4\x51\xda\x06\xbe\x5d\xeb\x87\x0f\xef\xdf\x81\xd8\x0d\x5c\xd6\x35\x93\x73\x6b\x78\xca\x32\x00\x80\xd1\x8e\xef\x38\xb4\x02\x4c\xce\x0e\xac\x68\xf4\xd3\xb6\xb5\x8b\xd2\x46\xef\x7d\x15\x99\xa0\x22\x6d\x76\x51\xf0\x96\x98\xa9\x0e\x50\x2d\x89\x8f\x4a\x02\xd6\x06\x3e\x2e\x95\x85\x6a\xdc\xd9\x33\xf5\xc8\x94\x3b\xbd\x33\xc4\x1b\xb8\x1c\xa4\x19\x7d\x9e\x78\x8d\xdc\xbe\x90\x00\x02\xd3\x96\x98\x8c\xa2\xe4\x75\x9c\x3c\x76\xe0\xc4\x32\xd5\x70\x1f\xc0\xd3\x9c\x27\x12\x2a\xb7\xb4\x85\xb3\xb1\xb9\xa8\x2c\xb3\xdd\x9f\xfe\xbf\x30\x2d\xb0\x3a\xcf\xbd\xbb\x1b\x38\x7c\xf3\x55\x2c\xe3\x8e\x6e\x50\x9a\x75\x76\x74\x74\x74\x71\x01\x3d\x1a\xad\xf2\xd5\x55\x38\x06\x63\x05\x22\xee\x21\x47\xbb\x8f\x14\x03\xd0\x7f\xab\xf5\x42\xd7\x8f\x74\x7e\xa3\xb5\x21\xde\xb7\x95\x39\x6a\xb7\xc5\xe4\x31\x9c\x9e\x4c\x3a\x8b\x74\xd0\x53\xea\xf1\x7f\x1d\x66\x9f\xe3\x72\x7a\x20\x35\x08\xfd\xc5\x63\xbf\x9a\x49\xe9\x5e\x93\x91\x63\x07\xfd\x50\xb5\x5a\x4d\x5f\x83\xad\x7e\x92\x5a\x1a\x3c\x75\xc3\xd9\xec\x3b\xc9\xc5\xae\xff\x25\xc0\xf9\xae\xdb\xf8\x91\xf2\x4b\xf8\x50\x8c\x11\x4e\xed\xc5\x8e\xe4\x0a\x7b\xac\x74\xab\xe5\x31\x5f\x24\x96\x70\x6e\x02\xf7\x98\x19\xcc\x7e\xd3\x05\x3c\x2d\x2f\x33\xcd\x3d\x9f\xe7\x6f\x87\x1c\x5b\x5f\x17\x14\xc2\x79\x11\xf8\x27\xea\xad\xd3\xd1\xe8\x14\x95\x49\xe9\x6b\x73\x80\xc1\x2f\x4d\x99\x19\x52\xd4\x11\x6c\x3c\xdb\xd3\x93\xe5\x59\xa4\xc8\x9f\xb3\xdf\x01\x00\x00\xff\xff\xda\x16\xdb\x1d\x90\x05\x00\x00"
+var _transfer_tokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xcd\x6e\xdb\x3c\x10\x3c\x47\x4f\x31\x9f\x0f\x5f\x64\xa0\x91\x2e\x45\x0f\x46\x7e\x1a\xa4\x4d\xaf\x41\x9a\xb6\x67\x8a\x5a\x5b\x6c\x65\x52\x58\xae\xe2\x04\x81\xdf\xbd\x20\x29\xa9\x56\x52\xa4\x81\x0f\x86\x57\x3b\xb3\xb3\x33\x2b\x97\x25\xee\x1a\xe3\x21\xac\xac\x57\x5a\x8c\xb3\x30\x1e\x0a\x42\xdb\xae\x55\x42\x58\x3b\x86\x9a\x3d\x97\x46\x49\x56\x96\xd0\xae\x6f\x6b\x54\x84\xde\x53\x8d\xea\x11\xca\x3e\x3a\x4b\x10\x07\x4f\xb6\x86\xb8\x5f\x64\x7d\xf8\xa9\xac\x93\x86\x18\x4a\x6b\xd7\xdb\x08\x0e\x24\x68\x94\x47\x45\x64\xe1\x49\xd0\x77\xa1\x95\x49\x93\xb9\xa7\x01\x5c\x64\x65\x19\xba\xef\x1a\xc2\xce\x48\x53\xb3\xda\x41\x6d\x03\x09\x54\x18\xd1\xd0\x48\x8a\x35\xbb\x2d\x36\x24\x97\x7f\x86\xec\x46\x85\xd2\x10\x3a\xc5\x6a\x4b\x42\x1c\x25\x85\xca\xc1\x52\x59\x66\xb6\x9d\x63\xc1\x75\x6f\x37\xa6\x6a\xe9\x2e\x88\x4f\x9c\x8b\xa2\x2c\x8a\x52\x3b\x2b\xac\xb4\xf8\x72\xd6\x52\xe8\x5a\x2f\x46\xf0\xe7\x07\xb5\xed\x5e\xc5\x1e\x76\x24\x68\x76\xa0\x22\x4f\xab\xad\xf0\xed\xda\x3c\x7c\x78\xff\x0e\xe2\x56\xb8\xac\x6b\x26\xef\x97\x78\xca\x32\x00\x18\xec\xf8\xae\xfa\x56\xc0\xe4\x5d\xcf\x3a\x6c\x18\xfc\x74\x6d\xed\xa3\x29\xa3\xf7\xc1\x65\xc5\x84\x8a\x8c\xdd\xa4\x14\xd7\xc4\x4c\x75\xa4\x6a\x49\x42\x54\x12\xb9\x56\xf8\x38\xdf\x2c\x56\xd3\xcc\x8e\xa9\x53\x4c\xb9\x37\x1b\x4b\xbc\xc2\x65\x2f\xcd\xe0\xf3\xa4\x6b\xd0\xf6\x85\x04\x0a\x4c\x6b\x62\xb2\x41\x98\x8b\x82\x12\xf2\xd8\xc3\x8b\x63\xaa\x71\x1f\xc9\x47\x5c\x10\x12\x2b\xb7\xb4\xc6\xd9\xd0\x5c\x54\x8e\xd9\xed\x4e\xff\x9f\x99\x16\x55\x9d\xe7\xc1\xdd\xd5\xcc\xf0\xf4\xe4\xab\x38\x56\x1b\xba\x51\xd2\x2c\xb3\xa3\xa3\xa3\x8b\x0b\x74\xca\x1a\x9d\x2f\xae\xe2\x31\x58\x27\x48\xbc\x2f\x35\xba\x5d\x92\x18\x89\xfe\x5b\x2c\x67\x7b\xfd\x18\xcf\x6f\xb0\x36\x08\x78\xc3\x66\x9e\xda\x75\x31\x79\x8c\xd3\x93\x69\xcf\x62\x3c\xe8\x29\xf5\xf4\xbd\x8c\xd8\x7d\x1a\x4e\x0f\xa4\x7b\xa1\xbf\x78\x1c\x46\x33\x69\xd3\x19\xb2\x72\xec\xd1\xf5\x55\x6b\xf4\xf4\x36\xb8\xea\x27\xe9\xb9\xc1\x53\x37\xce\x0e\xde\x93\x5c\xdc\xf2\x2d\x01\x1e\xce\xba\x4d\x2f\x29\x3f\xa7\x8f\xc5\x14\xe1\xd4\x5e\x6c\x48\xae\x54\xa7\x2a\xd3\x1a\x79\xcc\x67\x89\x8d\x3c\x37\x51\x7b\xca\x6c\xa4\x0c\x9f\xe9\x02\x9e\xe6\x97\x39\xe2\xf6\xe7\xf9\xbf\x43\x4e\xad\xaf\x2f\x14\xc3\x79\x16\xf8\x27\xea\x9c\x37\x02\x39\xf8\xef\xb1\x63\xfa\xc6\xbe\xe0\x18\x27\x4d\x1c\x63\x21\x64\x5d\x27\xb2\xe1\x6c\x4f\x4f\xe6\x67\xb1\xcc\x00\x60\x9f\xed\xb3\xdf\x03\x00\xda\x16\xdb\x1d\x90\x05\x00\x00"
 
 func transfer_tokensCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -342,6 +827,46 @@ func transfer_tokensCdc() (*asset, error) {
 	return a, nil
 }
 
+var _transfer_tokens_with_memoCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xd1\x4e\xdb\x30\x14\x7d\xcf\x57\x9c\xf5\x61\xb4\x12\x24\x2f\xd3\x1e\x2a\x06\x43\x6c\xec\x69\x12\x02\xb6\x3d\xdf\x3a\xb7\x89\xb5\xc4\x8e\xec\x1b\x5a\x84\xfa\xef\x93\xed\x24\x90\x31\x21\x26\x55\x69\xeb\xf8\xdc\x7b\xee\x39\xc7\x2e\x0a\xdc\xd5\xda\x43\x1c\x19\x4f\x4a\xb4\x35\xd0\x1e\x04\xe1\xb6\x6b\x48\x18\x5b\xeb\x40\xb3\xf7\x52\x93\x64\x45\x01\x65\xfb\xa6\xc4\x86\xd1\x7b\x2e\xb1\x79\x00\x99\x07\x6b\x18\x62\xe1\xd9\x94\x10\xfb\x9b\x8d\x0f\x7f\xc9\x58\xa9\xd9\x81\x94\xb2\xbd\x89\x60\x6a\xac\xa9\xb0\xd3\x52\x83\xd0\x72\x6b\x8f\xc1\x79\x95\x83\xe0\x78\xcb\x8e\x8d\x62\x90\x01\xef\x55\x4d\xa6\x8a\x3d\x7c\xc0\x89\x85\x63\x65\x8d\xd2\x0d\x43\x6a\x46\xc9\x9d\xf5\x5a\xf2\xac\x28\xc2\xfb\xbb\x9a\x63\xb9\x30\x05\xb7\x5a\x84\x4b\x68\x03\xc2\x5d\x60\xf3\x9d\x5b\x9b\x87\xc7\x97\x84\x02\xdf\x73\xe2\x63\x78\x2f\x81\x6a\x28\x19\x89\x1f\x79\xd8\x9d\x89\x0c\x4b\x47\x3b\x90\x29\xc7\x5e\x09\xe5\xf3\x2c\xd3\x6d\x67\x9d\xe0\xaa\x37\x95\xde\x34\x1c\x7b\x60\xeb\x6c\x8b\x45\x5e\xe4\x79\xa1\xac\x11\x47\x4a\x7c\x31\xdb\x92\xab\x52\x2d\x46\xf0\xd7\x3d\xb5\xdd\xab\xd8\xe7\x3b\x66\xd0\x69\xa6\x7f\xe3\x7a\xd1\x8d\x96\x87\xcb\x69\xe1\x49\x83\x58\x25\x7b\xe6\xea\x92\xda\x60\xcd\x1a\x3f\xae\xf4\xfe\xe3\x87\x63\x88\x5d\xe3\xa2\x2c\x1d\x7b\x7f\x1c\x15\x5d\xe3\x56\x9c\x36\xd5\x0a\x8f\x59\x06\x00\x83\xda\x3f\xa9\x6f\x04\x8e\xbd\xed\x9d\x0a\x9e\x90\xa0\xb6\x4d\xe9\x9f\xb4\x0c\x3f\x49\x40\x8e\xb1\x61\x6d\xaa\x14\xa7\x2d\x3b\xc7\x65\x2c\xd5\xb0\x84\xcc\x48\xac\xb5\xc6\xe7\xb9\x5a\x71\x35\xf5\xec\x1c\x77\xe4\x78\xe9\x75\x65\xd8\xad\x71\xd1\x4b\x7d\x91\x52\x35\xf1\x1a\xb8\x7d\x63\x99\xa5\x69\x30\x37\x21\x8f\x3c\xbc\x58\xc7\x25\xee\x63\xf1\x11\x17\x88\xc4\x95\x1b\xde\xe2\xd3\xb0\x39\xdf\x58\xe7\xec\xee\xf4\xfd\xcc\x88\xc8\xea\x6c\x19\x94\x5f\xcf\x4c\x4c\x6f\x6e\xc5\x3a\xaa\xf8\x9a\xa4\x5e\x4d\xb4\xc2\xe7\xfc\x1c\x1d\x19\xad\x96\x8b\xcb\x78\x80\x8c\x15\xa4\x06\x2f\xc9\xda\x5d\xe2\x1a\x2b\xbe\x5b\xac\x66\x03\xfe\x1a\xa3\x39\x68\x1c\x98\xbc\x61\x44\xcf\xcd\x36\x9f\xc4\xc6\xe9\xc9\x34\x70\x3e\x86\x7d\x0a\x43\xfa\x4e\xfc\x0f\xa9\x39\xef\x59\xf5\xc2\x6f\x13\xdb\xb1\xd2\x9d\x66\x23\x47\x1e\x37\xac\x58\xdf\xb3\x9b\x60\x41\x6b\x37\x2c\x26\xb9\x2b\x96\xc1\xcc\xa5\xd8\x55\x5e\xb1\x5c\x52\x47\x9b\x98\xe2\xe5\x4c\xe2\xb1\xd8\x75\xbf\x69\xb4\x7a\x29\xf2\x64\xd9\xe3\x3c\x4a\x23\xee\x70\xb6\xfc\x0f\x57\x12\xe6\xf5\xf1\xa2\x9a\x7f\x39\x34\xde\x32\x61\xef\xa8\xad\x19\xed\xd2\xe6\x45\x8d\xa9\x53\xb8\x6c\xc2\xed\x15\x77\x84\xc3\x37\x55\x7d\x3a\xc2\xc3\x65\x14\x52\x10\x6e\xb4\x21\x88\xa7\x27\x73\x7f\xd3\x41\x1e\x0b\xdf\xf0\x76\x3c\xcc\xe1\xb9\xca\x00\xe0\x90\x1d\xb2\x3f\x03\x00\xd6\xf0\x39\x45\x08\x06\x00\x00"
+
+func transfer_tokens_with_memoCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transfer_tokens_with_memoCdc,
+		"transfer_tokens_with_memo.cdc",
+	)
+}
+
+func transfer_tokens_with_memoCdc() (*asset, error) {
+	bytes, err := transfer_tokens_with_memoCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transfer_tokens_with_memo.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xae, 0x50, 0x26, 0xb4, 0xc0, 0x8c, 0xce, 0xed, 0x83, 0x65, 0x30, 0x9a, 0x59, 0x25, 0x70, 0x13, 0xb1, 0x86, 0xa6, 0xb4, 0x83, 0xf0, 0x12, 0x6e, 0x66, 0xcb, 0xff, 0xed, 0x5, 0xbd, 0x67, 0xc3}}
+	return a, nil
+}
+
+var _update_contractCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\x3d\x6f\xf3\x30\x0c\x84\x77\xfd\x8a\xdb\xde\x04\xc8\x2b\xef\xd9\xb2\x75\x6f\xf6\x80\x31\x99\x58\x80\x4d\x19\x22\x9d\x26\x28\xf2\xdf\x0b\xf9\xa3\xa8\x06\xea\xeb\xc8\x7b\xae\x69\x70\xee\x92\xc1\x0b\xa9\x51\xeb\x29\x2b\xa6\x91\xc9\xc5\xe0\x9d\xa0\xcd\x2c\xc8\xb7\xf9\xac\x34\x08\xa3\xcd\xea\x85\x5a\x0f\x4d\x03\x96\xb1\xcf\x2f\x61\x78\x9e\x15\x96\xee\x2a\xe5\x9f\x81\xda\x36\x4f\xea\x31\x34\x4d\xd5\x9d\x6b\xb7\x7c\x2d\xd3\x92\x61\x24\x33\x61\x74\xf2\xfc\x2f\x5a\x1f\xf9\x00\x52\xc6\x30\x99\xe3\x2a\x20\x3c\xa8\x4f\xbc\x92\xd4\x09\x2b\xc2\xaf\xe1\x46\x71\x80\xc4\x7b\x44\x72\x0c\xf4\x02\x31\xe3\x36\xe9\x1c\xc3\x70\x9d\x1c\x9a\x1d\x45\x86\xfc\x10\xdc\x92\xf4\x6c\x31\x84\x3f\x59\x77\x35\xd3\x11\x9f\x5e\x92\xde\x0f\x33\xdf\x76\xdb\xe3\x3b\x04\x00\x18\x8b\x8c\x54\x64\xb7\x84\x3b\xe2\x34\x79\x77\x5a\xf2\x55\x0d\xd6\xb5\x7c\xc7\x0d\xcc\xe2\x02\x7f\xb9\xc8\x73\x94\x92\x06\x51\xa7\x7e\xf5\xab\x75\x73\xab\x35\xb2\xd4\xed\x43\x9e\xbb\xfd\x3e\x00\xc0\x3b\xbc\xc3\xcf\x00\x9b\x4d\x22\x8a\x9c\x01\x00\x00"
+
+func update_contractCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_update_contractCdc,
+		"update_contract.cdc",
+	)
+}
+
+func update_contractCdc() (*asset, error) {
+	bytes, err := update_contractCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "update_contract.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x90, 0xe7, 0xab, 0x5e, 0x78, 0x4c, 0x33, 0xfc, 0xf4, 0x5, 0x8a, 0x97, 0x34, 0xe1, 0xaa, 0xec, 0xae, 0x59, 0x3d, 0x6f, 0xe6, 0x15, 0xf7, 0x57, 0x9, 0x58, 0x98, 0xa2, 0xbb, 0x61, 0x23, 0xd4}}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -433,19 +958,44 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"burn_tokens.cdc":      burn_tokensCdc,
-	"create_forwarder.cdc": create_forwarderCdc,
-	"mint_tokens.cdc":      mint_tokensCdc,
+	"burn_tokens.cdc":                                       burn_tokensCdc,
+	"burn_tokens_with_reason.cdc":                           burn_tokens_with_reasonCdc,
+	"consolidate_vaults.cdc":                                consolidate_vaultsCdc,
+	"create_forwarder.cdc":                                  create_forwarderCdc,
+	"create_secondary_vault.cdc":                            create_secondary_vaultCdc,
+	"delegated_mint.cdc":                                    delegated_mintCdc,
+	"deploy_contract.cdc":                                   deploy_contractCdc,
+	"destroy_vault_at_path.cdc":                             destroy_vault_at_pathCdc,
+	"distribute_initial_supply.cdc":                         distribute_initial_supplyCdc,
+	"issue_minter_capability.cdc":                           issue_minter_capabilityCdc,
+	"mint_tokens.cdc":                                       mint_tokensCdc,
 	"privateForwarder/create_account_private_forwarder.cdc": privateforwarderCreate_account_private_forwarderCdc,
 	"privateForwarder/create_private_forwarder.cdc":         privateforwarderCreate_private_forwarderCdc,
 	"privateForwarder/deploy_forwarder_contract.cdc":        privateforwarderDeploy_forwarder_contractCdc,
 	"privateForwarder/setup_and_create_forwarder.cdc":       privateforwarderSetup_and_create_forwarderCdc,
 	"privateForwarder/transfer_private_many_accounts.cdc":   privateforwarderTransfer_private_many_accountsCdc,
+	"publish_receiver.cdc":                                  publish_receiverCdc,
+	"revoke_minter_capability.cdc":                          revoke_minter_capabilityCdc,
+	"scripts/get_FT.cdc":                                    scriptsGet_ftCdc,
+	"scripts/get_account_is_setup.cdc":                      scriptsGet_account_is_setupCdc,
+	"scripts/get_aggregate_balance.cdc":                     scriptsGet_aggregate_balanceCdc,
 	"scripts/get_balance.cdc":                               scriptsGet_balanceCdc,
+	"scripts/get_contract_exists.cdc":                       scriptsGet_contract_existsCdc,
+	"scripts/get_display_decimals.cdc":                      scriptsGet_display_decimalsCdc,
+	"scripts/get_display_metadata.cdc":                      scriptsGet_display_metadataCdc,
+	"scripts/get_dust_vaults.cdc":                           scriptsGet_dust_vaultsCdc,
+	"scripts/get_fungible_token_code.cdc":                   scriptsGet_fungible_token_codeCdc,
 	"scripts/get_supply.cdc":                                scriptsGet_supplyCdc,
+	"scripts/get_supply_invariant.cdc":                      scriptsGet_supply_invariantCdc,
+	"self_transfer.cdc":                                     self_transferCdc,
+	"set_display.cdc":                                       set_displayCdc,
+	"set_minter_allowance.cdc":                              set_minter_allowanceCdc,
 	"setup_account.cdc":                                     setup_accountCdc,
+	"transfer_admin.cdc":                                    transfer_adminCdc,
 	"transfer_many_accounts.cdc":                            transfer_many_accountsCdc,
 	"transfer_tokens.cdc":                                   transfer_tokensCdc,
+	"transfer_tokens_with_memo.cdc":                         transfer_tokens_with_memoCdc,
+	"update_contract.cdc":                                   update_contractCdc,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -493,7 +1043,15 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"burn_tokens.cdc": {burn_tokensCdc, map[string]*bintree{}},
+	"burn_tokens_with_reason.cdc": {burn_tokens_with_reasonCdc, map[string]*bintree{}},
+	"consolidate_vaults.cdc": {consolidate_vaultsCdc, map[string]*bintree{}},
 	"create_forwarder.cdc": {create_forwarderCdc, map[string]*bintree{}},
+	"create_secondary_vault.cdc": {create_secondary_vaultCdc, map[string]*bintree{}},
+	"delegated_mint.cdc": {delegated_mintCdc, map[string]*bintree{}},
+	"deploy_contract.cdc": {deploy_contractCdc, map[string]*bintree{}},
+	"destroy_vault_at_path.cdc": {destroy_vault_at_pathCdc, map[string]*bintree{}},
+	"distribute_initial_supply.cdc": {distribute_initial_supplyCdc, map[string]*bintree{}},
+	"issue_minter_capability.cdc": {issue_minter_capabilityCdc, map[string]*bintree{}},
 	"mint_tokens.cdc": {mint_tokensCdc, map[string]*bintree{}},
 	"privateForwarder": {nil, map[string]*bintree{
 		"create_account_private_forwarder.cdc": {privateforwarderCreate_account_private_forwarderCdc, map[string]*bintree{}},
@@ -502,13 +1060,30 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"setup_and_create_forwarder.cdc": {privateforwarderSetup_and_create_forwarderCdc, map[string]*bintree{}},
 		"transfer_private_many_accounts.cdc": {privateforwarderTransfer_private_many_accountsCdc, map[string]*bintree{}},
 	}},
+	"publish_receiver.cdc": {publish_receiverCdc, map[string]*bintree{}},
+	"revoke_minter_capability.cdc": {revoke_minter_capabilityCdc, map[string]*bintree{}},
 	"scripts": {nil, map[string]*bintree{
+		"get_FT.cdc": {scriptsGet_ftCdc, map[string]*bintree{}},
+		"get_account_is_setup.cdc": {scriptsGet_account_is_setupCdc, map[string]*bintree{}},
+		"get_aggregate_balance.cdc": {scriptsGet_aggregate_balanceCdc, map[string]*bintree{}},
 		"get_balance.cdc": {scriptsGet_balanceCdc, map[string]*bintree{}},
+		"get_contract_exists.cdc": {scriptsGet_contract_existsCdc, map[string]*bintree{}},
+		"get_display_decimals.cdc": {scriptsGet_display_decimalsCdc, map[string]*bintree{}},
+		"get_display_metadata.cdc": {scriptsGet_display_metadataCdc, map[string]*bintree{}},
+		"get_dust_vaults.cdc": {scriptsGet_dust_vaultsCdc, map[string]*bintree{}},
+		"get_fungible_token_code.cdc": {scriptsGet_fungible_token_codeCdc, map[string]*bintree{}},
 		"get_supply.cdc": {scriptsGet_supplyCdc, map[string]*bintree{}},
+		"get_supply_invariant.cdc": {scriptsGet_supply_invariantCdc, map[string]*bintree{}},
 	}},
+	"self_transfer.cdc": {self_transferCdc, map[string]*bintree{}},
+	"set_display.cdc": {set_displayCdc, map[string]*bintree{}},
+	"set_minter_allowance.cdc": {set_minter_allowanceCdc, map[string]*bintree{}},
 	"setup_account.cdc": {setup_accountCdc, map[string]*bintree{}},
+	"transfer_admin.cdc": {transfer_adminCdc, map[string]*bintree{}},
 	"transfer_many_accounts.cdc": {transfer_many_accountsCdc, map[string]*bintree{}},
 	"transfer_tokens.cdc": {transfer_tokensCdc, map[string]*bintree{}},
+	"transfer_tokens_with_memo.cdc": {transfer_tokens_with_memoCdc, map[string]*bintree{}},
+	"update_contract.cdc": {update_contractCdc, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
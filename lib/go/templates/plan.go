@@ -0,0 +1,104 @@
+package templates
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-ft/lib/go/templates/internal/assets"
+)
+
+// ContractConfig describes the deployment of a custom token
+// for GenerateCustomTokenDeploymentPlan.
+type ContractConfig struct {
+	// FungibleTokenAddr is the address of the FungibleToken interface
+	FungibleTokenAddr flow.Address
+	// TokenAddr is the address of the account the token is deployed to,
+	// which is the authorizer of every transaction in the plan
+	TokenAddr flow.Address
+	// TokenName is the name of the token contract
+	TokenName string
+	// Code is the token contract, e.g. as returned by contracts.CustomToken
+	Code []byte
+	// Distribution lists the recipients of the initial supply, if any.
+	// Their accounts must be set up to receive the token before it is distributed
+	Distribution []Recipient
+}
+
+// PlannedTx is a transaction in a deployment plan,
+// to be authorized by the token account.
+type PlannedTx struct {
+	// Description says what the transaction does
+	Description string
+	// Script is the resolved Cadence code of the transaction
+	Script []byte
+	// Arguments are the arguments to submit the transaction with
+	Arguments []cadence.Value
+}
+
+// administratorDeclaration is how the token contract declares the resource
+// the distribution transaction mints with
+const administratorDeclaration = "pub resource Administrator"
+
+// GenerateCustomTokenDeploymentPlan returns the transactions that deploy a custom token,
+// in the order they must be submitted in: the contract deployment,
+// the setup of the token account, and the distribution of the initial supply, if any.
+//
+// An error is returned if the config is incomplete, if a recipient is listed twice,
+// or if there is a distribution but the contract has no Administrator to mint it with,
+// e.g. because it was created with FixedSupply.
+func GenerateCustomTokenDeploymentPlan(cfg ContractConfig) ([]PlannedTx, error) {
+	if cfg.TokenName == "" {
+		return nil, errors.New("token name is required")
+	}
+
+	if len(cfg.Code) == 0 {
+		return nil, errors.New("contract code is required")
+	}
+
+	plan := []PlannedTx{
+		{
+			Description: fmt.Sprintf("Deploy the %s contract", cfg.TokenName),
+			Script:      assets.MustAsset(deployContractFilename),
+			Arguments: []cadence.Value{
+				cadence.String(cfg.TokenName),
+				cadence.String(hex.EncodeToString(cfg.Code)),
+			},
+		},
+		{
+			Description: fmt.Sprintf("Set up the token account to hold %s, if the contract didn't", cfg.TokenName),
+			Script:      GenerateCreateTokenScript(cfg.FungibleTokenAddr, cfg.TokenAddr, cfg.TokenName),
+		},
+	}
+
+	if len(cfg.Distribution) == 0 {
+		return plan, nil
+	}
+
+	if !strings.Contains(string(cfg.Code), administratorDeclaration) {
+		return nil, fmt.Errorf("contract %s has no Administrator to distribute the initial supply with", cfg.TokenName)
+	}
+
+	amounts, err := TransferManyAccountsArgument(cfg.Distribution)
+	if err != nil {
+		return nil, err
+	}
+
+	var total cadence.UFix64
+	for _, recipient := range cfg.Distribution {
+		if total+recipient.Amount < total {
+			return nil, errors.New("initial distribution overflows UFix64")
+		}
+		total += recipient.Amount
+	}
+
+	return append(plan, PlannedTx{
+		Description: fmt.Sprintf("Distribute the initial supply of %s to %d recipients", cfg.TokenName, len(cfg.Distribution)),
+		Script:      GenerateDistributeInitialSupplyTransaction(cfg.FungibleTokenAddr, cfg.TokenAddr, cfg.TokenName),
+		Arguments:   []cadence.Value{amounts, total},
+	}), nil
+}
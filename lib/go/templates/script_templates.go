@@ -1,14 +1,26 @@
 package templates
 
 import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
 	"github.com/onflow/flow-ft/lib/go/templates/internal/assets"
 	"github.com/onflow/flow-go-sdk"
 )
 
 const (
-	scriptsPath         = "scripts/"
-	readBalanceFilename = "get_balance.cdc"
-	readSupplyFilename  = "get_supply.cdc"
+	scriptsPath               = "scripts/"
+	readBalanceFilename       = "get_balance.cdc"
+	readSupplyFilename        = "get_supply.cdc"
+	accountIsSetupFilename    = "get_account_is_setup.cdc"
+	aggregateBalanceFilename  = "get_aggregate_balance.cdc"
+	displayDecimalsFilename   = "get_display_decimals.cdc"
+	supplyInvariantFilename   = "get_supply_invariant.cdc"
+	dustVaultsFilename        = "get_dust_vaults.cdc"
+	contractExistsFilename    = "get_contract_exists.cdc"
+	displayMetadataFilename   = "get_display_metadata.cdc"
+	fungibleTokenCodeFilename = "get_fungible_token_code.cdc"
 )
 
 // GenerateInspectVaultScript creates a script that retrieves a
@@ -29,3 +41,87 @@ func GenerateInspectSupplyScript(fungibleAddr, tokenAddr flow.Address, tokenName
 
 	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
 }
+
+// UFix64ToString returns the decimal representation of a UFix64 value,
+// e.g. of the balance or supply returned by the scripts created by
+// GenerateInspectVaultScript and GenerateInspectSupplyScript.
+//
+// The value is formatted as Cadence formats UFix64 values, with all 8 decimal places.
+func UFix64ToString(value cadence.Value) (string, error) {
+	fix, ok := value.(cadence.UFix64)
+	if !ok {
+		return "", fmt.Errorf("expected a UFix64 value, got %T", value)
+	}
+
+	return fix.String(), nil
+}
+
+// GenerateAccountIsSetupScript creates a script that returns
+// whether an account has a public Receiver capability for the token
+// that can be borrowed, i.e. whether it can receive the token
+func GenerateAccountIsSetupScript(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(scriptsPath + accountIsSetupFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateGetAggregateBalanceScript creates a script that returns
+// the combined balance of the token Vaults an account stores
+// at its standard Vault path and at the storage paths passed as an argument
+func GenerateGetAggregateBalanceScript(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(scriptsPath + aggregateBalanceFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateGetDisplayDecimalsScript creates a script that returns
+// the DisplayDecimals view of an account's Vault, or nil if the token doesn't declare one.
+// DisplayDecimals decodes the result
+func GenerateGetDisplayDecimalsScript(fungibleAddr, tokenAddr, metadataViewsAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(scriptsPath + displayDecimalsFilename)
+
+	code = placeholderMetadataViews.ReplaceAllString(code, "0x"+metadataViewsAddr.String())
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateGetDisplayMetadataScript creates a script that returns the name, symbol,
+// description and logo URL an account's Vault resolves for a token created with WithMutableDisplay
+func GenerateGetDisplayMetadataScript(fungibleAddr, tokenAddr, metadataViewsAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(scriptsPath + displayMetadataFilename)
+
+	code = placeholderMetadataViews.ReplaceAllString(code, "0x"+metadataViewsAddr.String())
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateSupplyInvariantScript creates a script that returns
+// the total supply of tokens and the sum of the balances
+// of the accounts passed as an argument, for the caller to compare
+func GenerateSupplyInvariantScript(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(scriptsPath + supplyInvariantFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateGetDustVaultsScript creates a script that returns which of the storage paths
+// passed as an argument store a Vault of any fungible token
+// with a balance below the threshold passed as an argument
+func GenerateGetDustVaultsScript(fungibleAddr flow.Address) []byte {
+	code := assets.MustAssetString(scriptsPath + dustVaultsFilename)
+
+	return []byte(placeholderFungibleToken.ReplaceAllString(code, "0x"+fungibleAddr.String()))
+}
+
+// GenerateContractExistsScript creates a script that returns whether the account
+// at the address passed as an argument hosts the contract named by the second argument
+func GenerateContractExistsScript() []byte {
+	return assets.MustAsset(scriptsPath + contractExistsFilename)
+}
+
+// GenerateGetFungibleTokenCodeScript creates a script that returns the code
+// of the FungibleToken contract hosted by the account at the address passed as an argument.
+// ParseFungibleTokenFeatures reads which features it declares
+func GenerateGetFungibleTokenCodeScript() []byte {
+	return assets.MustAsset(scriptsPath + fungibleTokenCodeFilename)
+}
@@ -0,0 +1,47 @@
+package templates_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-ft/lib/go/templates"
+)
+
+func TestUFix64ToString(t *testing.T) {
+	t.Run("Should round-trip amounts", func(t *testing.T) {
+		amounts := map[string]string{
+			"0.0":                   "0.00000000",
+			"1.0":                   "1.00000000",
+			"1000.0":                "1000.00000000",
+			"20.5":                  "20.50000000",
+			"0.00000001":            "0.00000001",
+			"123.45600000":          "123.45600000",
+			"184467440737.09551615": "184467440737.09551615",
+		}
+
+		for amount, expected := range amounts {
+			value, err := cadence.NewUFix64(amount)
+			require.NoError(t, err)
+
+			formatted, err := templates.UFix64ToString(value)
+			require.NoError(t, err)
+			assert.Equal(t, expected, formatted)
+
+			// The formatted amount must parse back to the same value
+			parsed, err := cadence.NewUFix64(formatted)
+			require.NoError(t, err)
+			assert.Equal(t, value, parsed)
+		}
+	})
+
+	t.Run("Should reject other values", func(t *testing.T) {
+		_, err := templates.UFix64ToString(cadence.NewUInt64(1))
+		assert.Error(t, err)
+
+		_, err = templates.UFix64ToString(cadence.NewOptional(nil))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,53 @@
+package templates
+
+import (
+	"regexp"
+)
+
+// SetupAccountOption changes which public capabilities
+// the transaction created by GenerateCreateTokenScript publishes.
+type SetupAccountOption func(*setupAccountConfig)
+
+type setupAccountConfig struct {
+	omitReceiver bool
+	omitBalance  bool
+}
+
+// WithoutPublicReceiver leaves out the public Receiver capability,
+// so other accounts can't deposit to the Vault.
+func WithoutPublicReceiver() SetupAccountOption {
+	return func(config *setupAccountConfig) {
+		config.omitReceiver = true
+	}
+}
+
+// WithoutPublicBalance leaves out the public Balance capability,
+// so other accounts can't read the balance of the Vault.
+func WithoutPublicBalance() SetupAccountOption {
+	return func(config *setupAccountConfig) {
+		config.omitBalance = true
+	}
+}
+
+var (
+	// the link statements, with the blank line and comment lines above them
+	receiverLink = regexp.MustCompile(`\n[ \t]*\n(?:[ \t]*//.*\n)*[ \t]*signer\.link<&ExampleToken\.Vault\{FungibleToken\.Receiver\}>\([^)]*\)\n`)
+	balanceLink  = regexp.MustCompile(`\n[ \t]*\n(?:[ \t]*//.*\n)*[ \t]*signer\.link<&ExampleToken\.Vault\{FungibleToken\.Balance\}>\([^)]*\)\n`)
+)
+
+func applySetupAccountOptions(code string, opts []SetupAccountOption) string {
+	config := &setupAccountConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	if config.omitReceiver {
+		code = receiverLink.ReplaceAllString(code, "\n")
+	}
+
+	if config.omitBalance {
+		code = balanceLink.ReplaceAllString(code, "\n")
+	}
+
+	return code
+}
@@ -19,6 +19,8 @@ var (
 	placeholderFungibleToken = regexp.MustCompile(`"[^"\s].*/FungibleToken.cdc"`)
 	placeholderExampleToken  = regexp.MustCompile(`"[^"\s].*/ExampleToken.cdc"`)
 	placeholderForwarding    = regexp.MustCompile(`"[^"\s].*/TokenForwarding.cdc"`)
+	placeholderMetadataViews = regexp.MustCompile(`"[^"\s].*/MetadataViews.cdc"`)
+	placeholderTokenMemo     = regexp.MustCompile(`"[^"\s].*/TokenMemo.cdc"`)
 )
 
 func replaceAddresses(code string, ftAddress, tokenAddress, forwardingAddress flow.Address, tokenName string) []byte {
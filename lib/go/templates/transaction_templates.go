@@ -14,21 +14,73 @@ import (
 
 const (
 	transferTokensFilename       = "transfer_tokens.cdc"
+	transferWithMemoFilename     = "transfer_tokens_with_memo.cdc"
+	selfTransferFilename         = "self_transfer.cdc"
 	transferManyAccountsFilename = "transfer_many_accounts.cdc"
 	setupAccountFilename         = "setup_account.cdc"
 	mintTokensFilename           = "mint_tokens.cdc"
 	createForwarderFilename      = "create_forwarder.cdc"
 	burnTokensFilename           = "burn_tokens.cdc"
+	burnWithReasonFilename       = "burn_tokens_with_reason.cdc"
+	transferAdminFilename        = "transfer_admin.cdc"
+	updateContractFilename       = "update_contract.cdc"
+	deployContractFilename       = "deploy_contract.cdc"
+
+	distributeInitialSupplyFilename = "distribute_initial_supply.cdc"
+	destroyVaultAtPathFilename      = "destroy_vault_at_path.cdc"
+	consolidateVaultsFilename       = "consolidate_vaults.cdc"
+	createSecondaryVaultFilename    = "create_secondary_vault.cdc"
+	publishReceiverFilename         = "publish_receiver.cdc"
+	setDisplayFilename              = "set_display.cdc"
+
+	issueMinterCapabilityFilename  = "issue_minter_capability.cdc"
+	delegatedMintFilename          = "delegated_mint.cdc"
+	revokeMinterCapabilityFilename = "revoke_minter_capability.cdc"
+	setMinterAllowanceFilename     = "set_minter_allowance.cdc"
 )
 
 // GenerateCreateTokenScript creates a script that instantiates
 // a new Vault instance and stores it in storage.
 // balance is an argument to the Vault constructor.
 // The Vault must have been deployed already.
-func GenerateCreateTokenScript(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+//
+// The transaction does nothing for an account that already stores a Vault,
+// so it can be submitted repeatedly, e.g. for fee estimation.
+//
+// By default the Receiver and Balance capabilities are both published.
+// SetupAccountOptions can leave either of them out.
+func GenerateCreateTokenScript(fungibleAddr, tokenAddr flow.Address, tokenName string, opts ...SetupAccountOption) []byte {
 
 	code := assets.MustAssetString(setupAccountFilename)
 
+	code = applySetupAccountOptions(code, opts)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GeneratePublishReceiverTransaction creates a transaction that publishes
+// a Receiver and Balance capability to the signer's Vault
+// at the public path passed as an argument.
+// Because the argument is a PublicPath, a path in any other domain is rejected
+func GeneratePublishReceiverTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(publishReceiverFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateUpdateContractTransaction creates a transaction that updates
+// the code of a contract on the signer's account.
+// The name of the contract and the new code, hex-encoded, are passed as string arguments
+func GenerateUpdateContractTransaction() []byte {
+	return assets.MustAsset(updateContractFilename)
+}
+
+// GenerateCreateSecondaryVaultTransaction creates a transaction that stores
+// an additional empty Vault at the storage path passed as an argument.
+// The path must not be the token's standard Vault storage path
+func GenerateCreateSecondaryVaultTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(createSecondaryVaultFilename)
+
 	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
 }
 
@@ -58,6 +110,26 @@ func GenerateDestroyVaultScript(fungibleAddr, tokenAddr flow.Address, tokenName
 	return []byte(fmt.Sprintf(template, fungibleAddr, tokenAddr, tokenName, storageName, withdrawAmount))
 }
 
+// GenerateDestroyVaultAtPathTransaction creates a transaction that loads
+// the Vault stored at the storage path passed as an argument and destroys it,
+// subtracting any remaining balance from the total supply.
+// Nothing happens if there is no Vault at the path
+func GenerateDestroyVaultAtPathTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(destroyVaultAtPathFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateConsolidateVaultsTransaction creates a transaction that deposits
+// the Vaults stored at the storage paths passed as an argument
+// into the signer's standard Vault, destroying them once they are empty.
+// Paths without a Vault and the standard Vault path are skipped
+func GenerateConsolidateVaultsTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(consolidateVaultsFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
 // GenerateTransferVaultScript creates a script that withdraws an tokens from an account
 // and deposits it to another account's vault
 func GenerateTransferVaultScript(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
@@ -67,6 +139,24 @@ func GenerateTransferVaultScript(fungibleAddr, tokenAddr flow.Address, tokenName
 	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
 }
 
+// GenerateTransferWithMemoTransaction creates a transaction that transfers tokens
+// to another account's vault and emits a TokenMemo.MemoDeposit event with the memo passed as an argument
+func GenerateTransferWithMemoTransaction(fungibleAddr, tokenAddr, memoAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(transferWithMemoFilename)
+
+	code = placeholderTokenMemo.ReplaceAllString(code, "0x"+memoAddr.String())
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateSelfTransferTransaction creates a transaction that withdraws tokens
+// from the signer's Vault and deposits them back to it, leaving its balance unchanged
+func GenerateSelfTransferTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(selfTransferFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
 // GenerateTransferManyAccountsScript creates a script that transfers the same number of tokens
 // to a list of accounts
 func GenerateTransferManyAccountsScript(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
@@ -85,6 +175,15 @@ func GenerateMintTokensScript(fungibleAddr, tokenAddr flow.Address, tokenName st
 	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
 }
 
+// GenerateDistributeInitialSupplyTransaction creates a transaction that uses the admin resource
+// to mint tokens for a list of recipients after the token is deployed.
+// The amounts minted for the recipients must add up to the total passed as an argument
+func GenerateDistributeInitialSupplyTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(distributeInitialSupplyFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
 // GenerateBurnTokensScript creates a script that uses the admin resource
 // to destroy tokens and deposit them in a Vault
 func GenerateBurnTokensScript(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
@@ -93,6 +192,70 @@ func GenerateBurnTokensScript(fungibleAddr, tokenAddr flow.Address, tokenName st
 	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
 }
 
+// GenerateBurnWithReasonTransaction creates a transaction that uses the admin resource
+// to burn tokens from the Vault at the storage path passed as an argument
+// and emits the reason passed as an argument in a TokensBurnedWithReason event,
+// for a token created with the WithBurnReason option
+func GenerateBurnWithReasonTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(burnWithReasonFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateSetDisplayTransaction creates a transaction that uses the admin resource
+// to set the display metadata of a token created with WithMutableDisplay
+// to the name, symbol, description and logo URL passed as arguments
+func GenerateSetDisplayTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(setDisplayFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateTransferAdminTransaction creates a transaction that moves the
+// Administrator resource from the current admin account to a new admin account.
+// The current admin and the new admin must both authorize the transaction
+func GenerateTransferAdminTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(transferAdminFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateIssueMinterCapabilityTransaction creates a transaction that uses the admin resource
+// to create a Minter and issue a private capability to it to a delegate account.
+// The admin and the delegate must both authorize the transaction
+func GenerateIssueMinterCapabilityTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(issueMinterCapabilityFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateDelegatedMintTransaction creates a transaction that mints tokens
+// through a Minter capability issued by GenerateIssueMinterCapabilityTransaction
+// and deposits them in a Vault
+func GenerateDelegatedMintTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(delegatedMintFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateSetMinterAllowanceTransaction creates a transaction that uses the admin resource
+// to replace the remaining allowance of the Minter
+// delegated with GenerateIssueMinterCapabilityTransaction,
+// for a token created with the WithAdjustableMinterAllowance option
+func GenerateSetMinterAllowanceTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(setMinterAllowanceFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
+// GenerateRevokeMinterCapabilityTransaction creates a transaction that revokes
+// the Minter capability issued by GenerateIssueMinterCapabilityTransaction
+func GenerateRevokeMinterCapabilityTransaction(fungibleAddr, tokenAddr flow.Address, tokenName string) []byte {
+	code := assets.MustAssetString(revokeMinterCapabilityFilename)
+
+	return replaceAddresses(code, fungibleAddr, tokenAddr, flow.EmptyAddress, tokenName)
+}
+
 // GenerateTransferInvalidVaultScript creates a script that withdraws an tokens from an account
 // and tries to deposit it into a vault of the wrong type. Should fail
 func GenerateTransferInvalidVaultScript(fungibleAddr, tokenAddr, otherTokenAddr, receiverAddr flow.Address, tokenName, otherTokenName string, amount int) []byte {
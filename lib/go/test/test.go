@@ -8,6 +8,7 @@ import (
 	"github.com/onflow/cadence"
 	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-emulator/types"
 	"github.com/onflow/flow-go-sdk"
 	"github.com/onflow/flow-go-sdk/crypto"
 	sdktemplates "github.com/onflow/flow-go-sdk/templates"
@@ -103,7 +104,8 @@ func createTxWithTemplateAndAuthorizer(
 //
 // The shouldRevert parameter indicates whether the transaction should fail or not.
 //
-// This function asserts the correct result and commits the block if it passed.
+// This function asserts the correct result, commits the block if it passed,
+// and returns the transaction result.
 func signAndSubmit(
 	t *testing.T,
 	b *emulator.Blockchain,
@@ -111,7 +113,7 @@ func signAndSubmit(
 	signerAddresses []flow.Address,
 	signers []crypto.Signer,
 	shouldRevert bool,
-) {
+) *types.TransactionResult {
 	// sign transaction with each signer
 	for i := len(signerAddresses) - 1; i >= 0; i-- {
 		signerAddress := signerAddresses[i]
@@ -126,16 +128,16 @@ func signAndSubmit(
 		}
 	}
 
-	Submit(t, b, tx, shouldRevert)
+	return Submit(t, b, tx, shouldRevert)
 }
 
-// Submit submits a transaction and checks if it fails or not.
+// Submit submits a transaction, checks if it fails or not, and returns the result.
 func Submit(
 	t *testing.T,
 	b *emulator.Blockchain,
 	tx *flow.Transaction,
 	shouldRevert bool,
-) {
+) *types.TransactionResult {
 	// submit the signed transaction
 	err := b.AddTransaction(*tx)
 	require.NoError(t, err)
@@ -153,6 +155,8 @@ func Submit(
 
 	_, err = b.CommitBlock()
 	assert.NoError(t, err)
+
+	return result
 }
 
 // executeScriptAndCheck executes a script and checks to make sure that it succeeded.
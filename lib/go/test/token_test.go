@@ -1,6 +1,9 @@
 package test
 
 import (
+	"encoding/hex"
+	"fmt"
+	"strings"
 	"testing"
 
 	sdktemplates "github.com/onflow/flow-go-sdk/templates"
@@ -643,7 +646,11 @@ func TestCreateCustomToken(t *testing.T) {
 	_, err = b.CommitBlock()
 	assert.NoError(t, err)
 
-	customTokenCode := contracts.CustomToken(fungibleAddr.String(), "UtilityCoin", "utilityCoin", "1000.0")
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+	customTokenCode, err := contracts.CustomTokenWithMetadataViews(fungibleAddr.String(), metadataViewsAddr.String(), "UtilityCoin", "utilityCoin", "1000.0")
+	require.NoError(t, err)
+
 	tokenAddr, err := b.CreateAccount(
 		[]*flow.AccountKey{exampleTokenAccountKey},
 		[]sdktemplates.Contract{
@@ -658,7 +665,9 @@ func TestCreateCustomToken(t *testing.T) {
 	_, err = b.CommitBlock()
 	assert.NoError(t, err)
 
-	badTokenCode := contracts.CustomToken(fungibleAddr.String(), "BadCoin", "badCoin", "1000.0")
+	badTokenCode, err := contracts.CustomTokenWithMetadataViews(fungibleAddr.String(), metadataViewsAddr.String(), "BadCoin", "badCoin", "1000.0")
+	require.NoError(t, err)
+
 	badTokenAccountKey, _ := accountKeys.NewWithSigner()
 	badTokenAddr, err := b.CreateAccount(
 		[]*flow.AccountKey{badTokenAccountKey},
@@ -678,6 +687,10 @@ func TestCreateCustomToken(t *testing.T) {
 	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
 
 	t.Run("Should be able to create empty Vault that doesn't affect supply", func(t *testing.T) {
+		isSetupScript := templates.GenerateAccountIsSetupScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		isSetup := executeScriptAndCheck(t, b, isSetupScript, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+		assert.Equal(t, cadence.NewBool(false), isSetup)
+
 		script := templates.GenerateCreateTokenScript(fungibleAddr, tokenAddr, "UtilityCoin")
 		tx := createTxWithTemplateAndAuthorizer(
 			b, script, joshAddress)
@@ -695,6 +708,9 @@ func TestCreateCustomToken(t *testing.T) {
 			false,
 		)
 
+		isSetup = executeScriptAndCheck(t, b, isSetupScript, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+		assert.Equal(t, cadence.NewBool(true), isSetup)
+
 		script = templates.GenerateInspectVaultScript(fungibleAddr, tokenAddr, "UtilityCoin")
 		result := executeScriptAndCheck(t, b,
 			script,
@@ -814,3 +830,3044 @@ func TestCreateCustomToken(t *testing.T) {
 		assert.Equal(t, CadenceUFix64("1000.0"), supply)
 	})
 }
+
+func TestTransferAdmin(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	script := templates.GenerateCreateTokenScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			joshAddress,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			joshSigner,
+		},
+		false,
+	)
+
+	t.Run("Shouldn't be able to transfer admin to an account that already stores one", func(t *testing.T) {
+		// The contract only ever creates one Administrator, so the account that
+		// already stores one is the admin, and the source is an account without one.
+		// The guard must refuse the transfer before the source is checked
+		script := templates.GenerateTransferAdminTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress).
+			AddAuthorizer(exampleTokenAddr)
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+				exampleTokenSigner,
+			},
+			true,
+		)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "The new admin account already stores an Administrator resource")
+		assert.NotContains(t, result.Error.Error(), "Signer is not the token admin")
+	})
+
+	t.Run("Should transfer admin to another account", func(t *testing.T) {
+		script := templates.GenerateTransferAdminTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr).
+			AddAuthorizer(joshAddress)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+				joshSigner,
+			},
+			false,
+		)
+
+		// The new admin should be able to mint
+		script = templates.GenerateMintTokensScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx = createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+		_ = tx.AddArgument(CadenceUFix64("50.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			false,
+		)
+
+		// The old admin should no longer be able to mint
+		tx = createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+		_ = tx.AddArgument(CadenceUFix64("50.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			true,
+		)
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b,
+			script,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(joshAddress)),
+			},
+		)
+
+		assert.Equal(t, CadenceUFix64("50.0"), result)
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("1050.0"), supply)
+	})
+}
+
+func TestCustomTokenDepositEventField(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	tokenAccountKey, tokenSigner := accountKeys.NewWithSigner()
+
+	fungibleAddr := deploy(t, b, "FungibleToken", contracts.FungibleToken())
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+	customTokenCode, err := contracts.CustomTokenWithMetadataViews(
+		fungibleAddr.String(),
+		metadataViewsAddr.String(),
+		"UtilityCoin",
+		"utilityCoin",
+		"1000.0",
+		contracts.WithDepositEventField("memo"),
+	)
+	require.NoError(t, err)
+
+	tokenAddr := deploy(t, b, "UtilityCoin", customTokenCode, tokenAccountKey)
+
+	t.Run("Should emit the deposit field value on deposit", func(t *testing.T) {
+		script := []byte(fmt.Sprintf(`
+			import UtilityCoin from 0x%s
+
+			transaction(amount: UFix64, memo: String) {
+				prepare(signer: AuthAccount) {
+					let vaultRef = signer.borrow<&UtilityCoin.Vault>(from: UtilityCoin.VaultStoragePath)
+						?? panic("Could not borrow reference to the owner's Vault!")
+
+					vaultRef.depositWithMemo(from: <-vaultRef.withdraw(amount: amount), memo: memo)
+				}
+			}
+		`, tokenAddr))
+
+		tx := createTxWithTemplateAndAuthorizer(b, script, tokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64("10.0"))
+		_ = tx.AddArgument(cadence.String("invoice-42"))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+			},
+			false,
+		)
+
+		eventTypes, err := templates.EventTypeIdentifiers(tokenAddr.String(), "UtilityCoin", "TokensDepositedWithMemo")
+		require.NoError(t, err)
+		memoEventType := eventTypes["TokensDepositedWithMemo"]
+		depositEventType := eventTypes["TokensDeposited"]
+
+		var memoEvents, depositEvents []flow.Event
+		for _, event := range result.Events {
+			switch event.Type {
+			case memoEventType:
+				memoEvents = append(memoEvents, event)
+			case depositEventType:
+				depositEvents = append(depositEvents, event)
+			}
+		}
+
+		// The standard event is still emitted with its canonical fields
+		require.Len(t, depositEvents, 1)
+		assert.Len(t, depositEvents[0].Value.Fields, 2)
+
+		require.Len(t, memoEvents, 1)
+
+		fields := memoEvents[0].Value.Fields
+		require.Len(t, fields, 3)
+		assert.Equal(t, CadenceUFix64("10.0"), fields[0])
+		assert.Equal(t, cadence.NewOptional(cadence.NewAddress(tokenAddr)), fields[1])
+		assert.Equal(t, cadence.String("invoice-42"), fields[2])
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		balance := executeScriptAndCheck(t, b,
+			script,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(tokenAddr)),
+			},
+		)
+		assert.Equal(t, CadenceUFix64("1000.0"), balance)
+	})
+}
+
+func TestCustomTokenTransferEvent(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	tokenAccountKey, tokenSigner := accountKeys.NewWithSigner()
+
+	fungibleAddr := deploy(t, b, "FungibleToken", contracts.FungibleToken())
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+	customTokenCode, err := contracts.CustomTokenWithMetadataViews(
+		fungibleAddr.String(),
+		metadataViewsAddr.String(),
+		"UtilityCoin",
+		"utilityCoin",
+		"1000.0",
+		contracts.WithTransferEvent(),
+	)
+	require.NoError(t, err)
+
+	tokenAddr := deploy(t, b, "UtilityCoin", customTokenCode, tokenAccountKey)
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	script := templates.GenerateCreateTokenScript(fungibleAddr, tokenAddr, "UtilityCoin")
+	tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			joshAddress,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			joshSigner,
+		},
+		false,
+	)
+
+	eventTypes, err := templates.EventTypeIdentifiers(tokenAddr.String(), "UtilityCoin", "Transfer")
+	require.NoError(t, err)
+	transferEventType := eventTypes["Transfer"]
+
+	transferEvents := func(events []flow.Event) []flow.Event {
+		var transfers []flow.Event
+		for _, event := range events {
+			if event.Type == transferEventType {
+				transfers = append(transfers, event)
+			}
+		}
+
+		return transfers
+	}
+
+	t.Run("Should emit a Transfer event on a transfer", func(t *testing.T) {
+		script := templates.GenerateTransferVaultScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		tx := createTxWithTemplateAndAuthorizer(b, script, tokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64("30.0"))
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+			},
+			false,
+		)
+
+		transfers := transferEvents(result.Events)
+		require.Len(t, transfers, 1)
+
+		fields := transfers[0].Value.Fields
+		require.Len(t, fields, 3)
+		assert.Equal(t, cadence.NewOptional(cadence.NewAddress(tokenAddr)), fields[0])
+		assert.Equal(t, cadence.NewOptional(cadence.NewAddress(joshAddress)), fields[1])
+		assert.Equal(t, CadenceUFix64("30.0"), fields[2])
+
+		// The standard events are still emitted
+		events := FilterFTEvents(result, tokenAddr, "UtilityCoin")
+		require.Len(t, events, 2)
+		assert.Equal(t, "TokensWithdrawn", events[0].Name)
+		assert.Equal(t, "TokensDeposited", events[1].Name)
+	})
+
+	t.Run("Should emit a Transfer event without a sender for minted tokens", func(t *testing.T) {
+		script := templates.GenerateMintTokensScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		tx := createTxWithTemplateAndAuthorizer(b, script, tokenAddr)
+
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+		_ = tx.AddArgument(CadenceUFix64("50.0"))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+			},
+			false,
+		)
+
+		transfers := transferEvents(result.Events)
+		require.Len(t, transfers, 1)
+
+		fields := transfers[0].Value.Fields
+		assert.Equal(t, cadence.NewOptional(nil), fields[0])
+		assert.Equal(t, cadence.NewOptional(cadence.NewAddress(joshAddress)), fields[1])
+		assert.Equal(t, CadenceUFix64("50.0"), fields[2])
+	})
+
+	t.Run("Should emit a Transfer event for each source of merged withdrawals", func(t *testing.T) {
+		script := []byte(fmt.Sprintf(`
+			import FungibleToken from 0x%s
+			import UtilityCoin from 0x%s
+
+			transaction(recipient: Address) {
+				prepare(first: AuthAccount, second: AuthAccount) {
+					let firstVault = first.borrow<&UtilityCoin.Vault>(from: UtilityCoin.VaultStoragePath)
+						?? panic("Could not borrow reference to the first Vault!")
+					let secondVault = second.borrow<&UtilityCoin.Vault>(from: UtilityCoin.VaultStoragePath)
+						?? panic("Could not borrow reference to the second Vault!")
+
+					let merged <- firstVault.withdraw(amount: 10.0)
+					merged.deposit(from: <-secondVault.withdraw(amount: 5.0))
+
+					getAccount(recipient)
+						.getCapability(UtilityCoin.ReceiverPublicPath)
+						.borrow<&{FungibleToken.Receiver}>()!
+						.deposit(from: <-merged)
+				}
+			}
+		`, fungibleAddr, tokenAddr))
+		tx := createTxWithTemplateAndAuthorizer(b, script, tokenAddr).
+			AddAuthorizer(joshAddress)
+
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+				joshSigner,
+			},
+			false,
+		)
+
+		// Merging the withdrawn Vaults emits no Transfer event,
+		// and the deposit emits one for each account the tokens were withdrawn from
+		transfers := transferEvents(result.Events)
+		require.Len(t, transfers, 2)
+
+		amounts := make(map[cadence.Value]cadence.Value)
+		for _, transfer := range transfers {
+			fields := transfer.Value.Fields
+			require.Len(t, fields, 3)
+			assert.Equal(t, cadence.NewOptional(cadence.NewAddress(joshAddress)), fields[1])
+			amounts[fields[0]] = fields[2]
+		}
+
+		assert.Equal(
+			t,
+			map[cadence.Value]cadence.Value{
+				cadence.NewOptional(cadence.NewAddress(tokenAddr)):   CadenceUFix64("10.0"),
+				cadence.NewOptional(cadence.NewAddress(joshAddress)): CadenceUFix64("5.0"),
+			},
+			amounts,
+		)
+	})
+
+	t.Run("Should keep the sources of merged withdrawals when they are split", func(t *testing.T) {
+		script := []byte(fmt.Sprintf(`
+			import FungibleToken from 0x%s
+			import UtilityCoin from 0x%s
+
+			transaction(recipient: Address) {
+				prepare(first: AuthAccount, second: AuthAccount) {
+					let firstVault = first.borrow<&UtilityCoin.Vault>(from: UtilityCoin.VaultStoragePath)
+						?? panic("Could not borrow reference to the first Vault!")
+					let secondVault = second.borrow<&UtilityCoin.Vault>(from: UtilityCoin.VaultStoragePath)
+						?? panic("Could not borrow reference to the second Vault!")
+
+					let merged <- firstVault.withdraw(amount: 10.0)
+					merged.deposit(from: <-secondVault.withdraw(amount: 5.0))
+					let split <- merged.withdraw(amount: 12.0)
+
+					let receiver = getAccount(recipient)
+						.getCapability(UtilityCoin.ReceiverPublicPath)
+						.borrow<&{FungibleToken.Receiver}>()!
+					receiver.deposit(from: <-split)
+					receiver.deposit(from: <-merged)
+				}
+			}
+		`, fungibleAddr, tokenAddr))
+		tx := createTxWithTemplateAndAuthorizer(b, script, tokenAddr).
+			AddAuthorizer(joshAddress)
+
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+				joshSigner,
+			},
+			false,
+		)
+
+		// However the split divides them, the deposits attribute
+		// the whole amount withdrawn from each account to it
+		totals := make(map[cadence.Value]uint64)
+		for _, transfer := range transferEvents(result.Events) {
+			fields := transfer.Value.Fields
+			require.Len(t, fields, 3)
+			assert.Equal(t, cadence.NewOptional(cadence.NewAddress(joshAddress)), fields[1])
+			totals[fields[0]] += uint64(fields[2].(cadence.UFix64))
+		}
+
+		assert.Equal(
+			t,
+			map[cadence.Value]uint64{
+				cadence.NewOptional(cadence.NewAddress(tokenAddr)):   uint64(CadenceUFix64("10.0").(cadence.UFix64)),
+				cadence.NewOptional(cadence.NewAddress(joshAddress)): uint64(CadenceUFix64("5.0").(cadence.UFix64)),
+			},
+			totals,
+		)
+	})
+}
+
+func TestDelegatedMinting(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	script := templates.GenerateCreateTokenScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			joshAddress,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			joshSigner,
+		},
+		false,
+	)
+
+	t.Run("Should issue a minter capability to a delegate", func(t *testing.T) {
+		script := templates.GenerateIssueMinterCapabilityTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr).
+			AddAuthorizer(joshAddress)
+
+		_ = tx.AddArgument(CadenceUFix64("100.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+				joshSigner,
+			},
+			false,
+		)
+	})
+
+	t.Run("Should mint through the delegated capability", func(t *testing.T) {
+		script := templates.GenerateDelegatedMintTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+		_ = tx.AddArgument(CadenceUFix64("30.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			false,
+		)
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b,
+			script,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(joshAddress)),
+			},
+		)
+
+		assert.Equal(t, CadenceUFix64("30.0"), result)
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("1030.0"), supply)
+	})
+
+	t.Run("Shouldn't be able to mint after the capability is revoked", func(t *testing.T) {
+		script := templates.GenerateRevokeMinterCapabilityTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		script = templates.GenerateDelegatedMintTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx = createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+		_ = tx.AddArgument(CadenceUFix64("10.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			true,
+		)
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("1030.0"), supply)
+	})
+}
+
+func TestDistributeInitialSupply(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	tokenAccountKey, tokenSigner := accountKeys.NewWithSigner()
+
+	fungibleAddr := deploy(t, b, "FungibleToken", contracts.FungibleToken())
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+	customTokenCode, err := contracts.CustomTokenWithMetadataViews(fungibleAddr.String(), metadataViewsAddr.String(), "UtilityCoin", "utilityCoin", "0.0")
+	require.NoError(t, err)
+
+	tokenAddr := deploy(t, b, "UtilityCoin", customTokenCode, tokenAccountKey)
+
+	amounts := []string{"10.0", "20.0", "30.0"}
+	recipients := make([]flow.Address, len(amounts))
+	recipientPairs := make([]cadence.KeyValuePair, len(amounts))
+
+	for i, amount := range amounts {
+		recipientAddress, _, recipientSigner := newAccountWithAddress(b, accountKeys)
+
+		script := templates.GenerateCreateTokenScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		tx := createTxWithTemplateAndAuthorizer(b, script, recipientAddress)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				recipientAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				recipientSigner,
+			},
+			false,
+		)
+
+		recipients[i] = recipientAddress
+		recipientPairs[i] = cadence.KeyValuePair{
+			Key:   cadence.NewAddress(recipientAddress),
+			Value: CadenceUFix64(amount),
+		}
+	}
+
+	t.Run("Shouldn't be able to distribute amounts that don't add up to the total", func(t *testing.T) {
+		script := templates.GenerateDistributeInitialSupplyTransaction(fungibleAddr, tokenAddr, "UtilityCoin")
+		tx := createTxWithTemplateAndAuthorizer(b, script, tokenAddr)
+
+		_ = tx.AddArgument(cadence.NewDictionary(recipientPairs))
+		_ = tx.AddArgument(CadenceUFix64("50.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+			},
+			true,
+		)
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("0.0"), supply)
+	})
+
+	t.Run("Should distribute the supply to multiple accounts", func(t *testing.T) {
+		script := templates.GenerateDistributeInitialSupplyTransaction(fungibleAddr, tokenAddr, "UtilityCoin")
+		tx := createTxWithTemplateAndAuthorizer(b, script, tokenAddr)
+
+		_ = tx.AddArgument(cadence.NewDictionary(recipientPairs))
+		_ = tx.AddArgument(CadenceUFix64("60.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+			},
+			false,
+		)
+
+		// Assert that the vaults' balances are correct
+		for i, recipient := range recipients {
+			script = templates.GenerateInspectVaultScript(fungibleAddr, tokenAddr, "UtilityCoin")
+			result := executeScriptAndCheck(t, b,
+				script,
+				[][]byte{
+					jsoncdc.MustEncode(cadence.Address(recipient)),
+				},
+			)
+
+			assert.Equal(t, CadenceUFix64(amounts[i]), result)
+		}
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("60.0"), supply)
+	})
+}
+
+func TestVaultTypeIdentifier(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, _ := accountKeys.NewWithSigner()
+	_, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	script := []byte(fmt.Sprintf(`
+		import ExampleToken from 0x%s
+
+		pub fun main(): String {
+			return Type<@ExampleToken.Vault>().identifier
+		}
+	`, exampleTokenAddr))
+	identifier := executeScriptAndCheck(t, b, script, nil)
+
+	computed, err := templates.VaultTypeIdentifier(exampleTokenAddr.String(), "ExampleToken")
+	require.NoError(t, err)
+
+	expected := cadence.String(computed)
+	assert.Equal(t, expected, identifier)
+
+	t.Run("Should normalize the address", func(t *testing.T) {
+		prefixed, err := templates.VaultTypeIdentifier("0x"+strings.ToUpper(exampleTokenAddr.String()), "ExampleToken")
+		require.NoError(t, err)
+		assert.Equal(t, expected, cadence.String(prefixed))
+	})
+
+	t.Run("Should reject invalid addresses", func(t *testing.T) {
+		for _, address := range []string{"", "0x", "0x0", "0000000000000000", "not an address", "0xf8d6e0586b0a20c7ff", "0xf8d6e0586b0a20cz"} {
+			_, err := templates.VaultTypeIdentifier(address, "ExampleToken")
+			assert.Error(t, err, address)
+		}
+	})
+}
+
+func TestUFix64ToString(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, _ := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	script := templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(exampleTokenAddr))})
+
+	formatted, err := templates.UFix64ToString(result)
+	require.NoError(t, err)
+	assert.Equal(t, "1000.00000000", formatted)
+}
+
+func TestEventTypeIdentifiers(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	eventTypes, err := templates.EventTypeIdentifiers("0x"+exampleTokenAddr.String(), "ExampleToken")
+	require.NoError(t, err)
+	assert.Len(t, eventTypes, 7)
+
+	_, err = templates.EventTypeIdentifiers("0xnotanaddress", "ExampleToken")
+	assert.Error(t, err)
+
+	script := templates.GenerateMintTokensScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+	_ = tx.AddArgument(cadence.NewAddress(exampleTokenAddr))
+	_ = tx.AddArgument(CadenceUFix64("50.0"))
+
+	result := signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			exampleTokenAddr,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			exampleTokenSigner,
+		},
+		false,
+	)
+
+	emitted := make([]string, 0, len(result.Events))
+	for _, event := range result.Events {
+		emitted = append(emitted, event.Type)
+	}
+
+	assert.Equal(t,
+		[]string{
+			eventTypes["MinterCreated"],
+			eventTypes["TokensMinted"],
+			eventTypes["TokensDeposited"],
+		},
+		emitted,
+	)
+}
+
+func TestCreateTokenBalanceOnly(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	script := templates.GenerateCreateTokenScript(
+		fungibleAddr,
+		exampleTokenAddr,
+		"ExampleToken",
+		templates.WithoutPublicReceiver(),
+	)
+	tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			joshAddress,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			joshSigner,
+		},
+		false,
+	)
+
+	t.Run("Should report the balance of a balance-only Vault", func(t *testing.T) {
+		script := templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b,
+			script,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(joshAddress)),
+			},
+		)
+
+		assert.Equal(t, CadenceUFix64("0.0"), result)
+	})
+
+	t.Run("Shouldn't be able to deposit to a balance-only Vault", func(t *testing.T) {
+		script := templates.GenerateTransferVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64("300.0"))
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			true,
+		)
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b,
+			script,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(exampleTokenAddr)),
+			},
+		)
+
+		assert.Equal(t, CadenceUFix64("1000.0"), result)
+	})
+}
+
+func TestDestroyVaultAtPath(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	strayPath := cadence.Path{Domain: "storage", Identifier: "strayExampleTokenVault"}
+
+	t.Run("Should do nothing if there is no Vault at the path", func(t *testing.T) {
+		script := templates.GenerateDestroyVaultAtPathTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(strayPath)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("1000.0"), supply)
+	})
+
+	t.Run("Should burn the remaining balance of a non-empty Vault", func(t *testing.T) {
+		// Move some tokens to a Vault at a non-standard path
+		script := []byte(fmt.Sprintf(`
+			import ExampleToken from 0x%s
+
+			transaction(amount: UFix64, path: StoragePath) {
+				prepare(signer: AuthAccount) {
+					let vaultRef = signer.borrow<&ExampleToken.Vault>(from: ExampleToken.VaultStoragePath)
+						?? panic("Could not borrow reference to the owner's Vault!")
+
+					signer.save(<-vaultRef.withdraw(amount: amount), to: path)
+				}
+			}
+		`, exampleTokenAddr))
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64("100.0"))
+		_ = tx.AddArgument(strayPath)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		script = templates.GenerateDestroyVaultAtPathTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx = createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(strayPath)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		// Assert that the primary Vault is untouched and the supply is reduced
+		script = templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b,
+			script,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(exampleTokenAddr)),
+			},
+		)
+
+		assert.Equal(t, CadenceUFix64("900.0"), result)
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("900.0"), supply)
+	})
+
+	t.Run("Shouldn't be able to destroy the Vault at the standard path", func(t *testing.T) {
+		script := templates.GenerateDestroyVaultAtPathTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(cadence.Path{Domain: "storage", Identifier: "exampleTokenVault"})
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			true,
+		)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "Cannot destroy the Vault at the standard storage path")
+
+		// Assert that the primary Vault survived
+		script = templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		balance := executeScriptAndCheck(t, b,
+			script,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(exampleTokenAddr)),
+			},
+		)
+		assert.Equal(t, CadenceUFix64("900.0"), balance)
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("900.0"), supply)
+	})
+
+	t.Run("Should destroy a Vault at a custom path of an account without a standard Vault", func(t *testing.T) {
+		joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+		joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+		createSecondaryVault(t, b, fungibleAddr, exampleTokenAddr, joshAddress, joshSigner, strayPath)
+
+		hasVaultAtPath := func() cadence.Value {
+			script := []byte(fmt.Sprintf(`
+				import ExampleToken from 0x%s
+
+				pub fun main(account: Address, path: StoragePath): Bool {
+					return getAuthAccount(account).borrow<&ExampleToken.Vault>(from: path) != nil
+				}
+			`, exampleTokenAddr))
+
+			return executeScriptAndCheck(t, b,
+				script,
+				[][]byte{
+					jsoncdc.MustEncode(cadence.Address(joshAddress)),
+					jsoncdc.MustEncode(strayPath),
+				},
+			)
+		}
+		require.Equal(t, cadence.NewBool(true), hasVaultAtPath())
+
+		script := templates.GenerateDestroyVaultAtPathTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		_ = tx.AddArgument(strayPath)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			false,
+		)
+
+		assert.Equal(t, cadence.NewBool(false), hasVaultAtPath())
+	})
+}
+
+func TestCreateTokenIdempotent(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	setupAccount := func() {
+		script := templates.GenerateCreateTokenScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			false,
+		)
+	}
+
+	// vaultState returns the targets of the public capabilities to the Vault,
+	// whether they can be borrowed, and the balance read through them
+	vaultState := func() cadence.Value {
+		script := []byte(fmt.Sprintf(`
+			import FungibleToken from 0x%s
+			import ExampleToken from 0x%s
+
+			pub fun main(account: Address): [AnyStruct] {
+				let publicAccount = getAccount(account)
+
+				return [
+					publicAccount.getLinkTarget(ExampleToken.ReceiverPublicPath),
+					publicAccount.getLinkTarget(ExampleToken.BalancePublicPath),
+					publicAccount.getCapability(ExampleToken.ReceiverPublicPath)
+						.check<&ExampleToken.Vault{FungibleToken.Receiver}>(),
+					publicAccount.getCapability(ExampleToken.BalancePublicPath)
+						.borrow<&ExampleToken.Vault{FungibleToken.Balance}>()?.balance
+				]
+			}
+		`, fungibleAddr, exampleTokenAddr))
+
+		return executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+	}
+
+	isSetupScript := templates.GenerateAccountIsSetupScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	isSetup := func() cadence.Value {
+		return executeScriptAndCheck(t, b, isSetupScript, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+	}
+
+	t.Run("Should create the Vault on the first run", func(t *testing.T) {
+		require.Equal(t, cadence.NewBool(false), isSetup())
+
+		setupAccount()
+
+		assert.Equal(t, cadence.NewBool(true), isSetup())
+
+		script := templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+		assert.Equal(t, CadenceUFix64("0.0"), result)
+	})
+
+	t.Run("Should leave the existing Vault and its capabilities unchanged on the next run", func(t *testing.T) {
+		script := templates.GenerateMintTokensScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+		_ = tx.AddArgument(CadenceUFix64("50.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		before := vaultState()
+
+		setupAccount()
+
+		assert.Equal(t, before, vaultState())
+		assert.Equal(t, cadence.NewBool(true), isSetup())
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+		assert.Equal(t, CadenceUFix64("50.0"), result)
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("1050.0"), supply)
+	})
+}
+
+func TestWrapperToken(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	wrapperTokenCode := contracts.WrapperToken(fungibleAddr.String(), exampleTokenAddr.String(), "ExampleToken")
+	wrapperTokenAddr := deploy(t, b, "WrapperToken", wrapperTokenCode)
+
+	t.Run("Should wrap the underlying token", func(t *testing.T) {
+		script := []byte(fmt.Sprintf(`
+			import ExampleToken from 0x%s
+			import WrapperToken from 0x%s
+
+			transaction(amount: UFix64) {
+				prepare(signer: AuthAccount) {
+					let vaultRef = signer.borrow<&ExampleToken.Vault>(from: ExampleToken.VaultStoragePath)
+						?? panic("Could not borrow reference to the owner's Vault!")
+
+					let underlying <- vaultRef.withdraw(amount: amount) as! @ExampleToken.Vault
+
+					signer.save(<-WrapperToken.wrap(from: <-underlying), to: WrapperToken.VaultStoragePath)
+				}
+			}
+		`, exampleTokenAddr, wrapperTokenAddr))
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64("100.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, wrapperTokenAddr, "WrapperToken")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("100.0"), supply)
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b,
+			script,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(exampleTokenAddr)),
+			},
+		)
+		assert.Equal(t, CadenceUFix64("900.0"), result)
+	})
+
+	t.Run("Should wrap a custom underlying token", func(t *testing.T) {
+		metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+		customTokenCode, err := contracts.CustomTokenWithMetadataViews(fungibleAddr.String(), metadataViewsAddr.String(), "UtilityCoin", "utilityCoin", "1000.0")
+		require.NoError(t, err)
+
+		customTokenAddr := deploy(t, b, "UtilityCoin", customTokenCode)
+
+		wrapperTokenCode := contracts.WrapperToken(fungibleAddr.String(), customTokenAddr.String(), "UtilityCoin")
+		deploy(t, b, "WrapperToken", wrapperTokenCode)
+	})
+}
+
+func TestCreateSecondaryVault(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	secondaryPath := cadence.Path{Domain: "storage", Identifier: "exampleTokenSecondaryVault"}
+
+	t.Run("Shouldn't be able to create a secondary Vault at the standard path", func(t *testing.T) {
+		script := templates.GenerateCreateSecondaryVaultTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(cadence.Path{Domain: "storage", Identifier: "exampleTokenVault"})
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			true,
+		)
+	})
+
+	t.Run("Should create and fund a secondary Vault", func(t *testing.T) {
+		script := templates.GenerateCreateSecondaryVaultTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(secondaryPath)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		assert.Equal(t, CadenceUFix64("0.0"), vaultBalanceAtPath(t, b, exampleTokenAddr, exampleTokenAddr, secondaryPath))
+
+		fundVaultAtPath(t, b, exampleTokenAddr, exampleTokenAddr, exampleTokenSigner, secondaryPath, "250.0")
+
+		assert.Equal(t, CadenceUFix64("250.0"), vaultBalanceAtPath(t, b, exampleTokenAddr, exampleTokenAddr, secondaryPath))
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b,
+			script,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(exampleTokenAddr)),
+			},
+		)
+		assert.Equal(t, CadenceUFix64("750.0"), result)
+	})
+}
+
+func TestFilterFTEvents(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	// Another token with the same contract name, whose events must not be captured
+	otherTokenAddr := deploy(t, b, "ExampleToken", contracts.ExampleTokenLegacy(fungibleAddr.String()))
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	script := templates.GenerateCreateTokenScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			joshAddress,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			joshSigner,
+		},
+		false,
+	)
+
+	t.Run("Should capture the mint and deposit events", func(t *testing.T) {
+		script := templates.GenerateMintTokensScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+		_ = tx.AddArgument(CadenceUFix64("50.0"))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		events := FilterFTEvents(result, exampleTokenAddr, "ExampleToken")
+		require.Len(t, events, 2)
+
+		assert.Equal(t, "TokensMinted", events[0].Name)
+		assert.Equal(t, CadenceUFix64("50.0"), events[0].Amount)
+		assert.Nil(t, events[0].Address)
+
+		assert.Equal(t, "TokensDeposited", events[1].Name)
+		assert.Equal(t, CadenceUFix64("50.0"), events[1].Amount)
+		require.NotNil(t, events[1].Address)
+		assert.Equal(t, joshAddress, *events[1].Address)
+	})
+
+	t.Run("Should capture the withdraw and deposit events of a transfer", func(t *testing.T) {
+		script := templates.GenerateTransferVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		_ = tx.AddArgument(CadenceUFix64("20.0"))
+		_ = tx.AddArgument(cadence.NewAddress(exampleTokenAddr))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			false,
+		)
+
+		events := FilterFTEvents(result, exampleTokenAddr, "ExampleToken")
+		require.Len(t, events, 2)
+
+		assert.Equal(t, "TokensWithdrawn", events[0].Name)
+		assert.Equal(t, CadenceUFix64("20.0"), events[0].Amount)
+		require.NotNil(t, events[0].Address)
+		assert.Equal(t, joshAddress, *events[0].Address)
+
+		assert.Equal(t, "TokensDeposited", events[1].Name)
+		assert.Equal(t, CadenceUFix64("20.0"), events[1].Amount)
+		require.NotNil(t, events[1].Address)
+		assert.Equal(t, exampleTokenAddr, *events[1].Address)
+
+		assert.Empty(t, FilterFTEvents(result, otherTokenAddr, "ExampleToken"))
+	})
+}
+
+func TestAccountIsSetup(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, _ := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	isSetupScript := templates.GenerateAccountIsSetupScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+
+	t.Run("Should return false before the account is set up", func(t *testing.T) {
+		result := executeScriptAndCheck(t, b, isSetupScript, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+		assert.Equal(t, cadence.NewBool(false), result)
+	})
+
+	t.Run("Should return true after the account is set up", func(t *testing.T) {
+		script := templates.GenerateCreateTokenScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			false,
+		)
+
+		result := executeScriptAndCheck(t, b, isSetupScript, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+		assert.Equal(t, cadence.NewBool(true), result)
+	})
+
+	t.Run("Should return false if only the Balance capability is published", func(t *testing.T) {
+		maxAccountKey, maxSigner := accountKeys.NewWithSigner()
+		maxAddress, _ := b.CreateAccount([]*flow.AccountKey{maxAccountKey}, nil)
+
+		script := templates.GenerateCreateTokenScript(fungibleAddr, exampleTokenAddr, "ExampleToken", templates.WithoutPublicReceiver())
+		tx := createTxWithTemplateAndAuthorizer(b, script, maxAddress)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				maxAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				maxSigner,
+			},
+			false,
+		)
+
+		result := executeScriptAndCheck(t, b, isSetupScript, [][]byte{jsoncdc.MustEncode(cadence.Address(maxAddress))})
+		assert.Equal(t, cadence.NewBool(false), result)
+	})
+}
+
+func TestUpdateContract(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	fungibleAddr, err := b.CreateAccount(
+		nil,
+		[]sdktemplates.Contract{
+			{
+				Name:   "FungibleToken",
+				Source: string(contracts.FungibleToken()),
+			},
+		},
+	)
+	assert.NoError(t, err)
+
+	_, err = b.CommitBlock()
+	assert.NoError(t, err)
+
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+	tokenAccountKey, tokenSigner := accountKeys.NewWithSigner()
+	customTokenCode, err := contracts.CustomTokenWithMetadataViews(fungibleAddr.String(), metadataViewsAddr.String(), "UtilityCoin", "utilityCoin", "1000.0")
+	require.NoError(t, err)
+
+	tokenAddr, err := b.CreateAccount(
+		[]*flow.AccountKey{tokenAccountKey},
+		[]sdktemplates.Contract{
+			{
+				Name:   "UtilityCoin",
+				Source: string(customTokenCode),
+			},
+		},
+	)
+	assert.NoError(t, err)
+
+	_, err = b.CommitBlock()
+	assert.NoError(t, err)
+
+	versionScript := []byte(fmt.Sprintf(`
+		import UtilityCoin from 0x%s
+
+		pub fun main(): String {
+			return UtilityCoin.version()
+		}
+	`, tokenAddr))
+
+	t.Run("Shouldn't be able to call a function before it is added", func(t *testing.T) {
+		result, err := b.ExecuteScript(versionScript, nil)
+		require.NoError(t, err)
+		assert.Error(t, result.Error)
+	})
+
+	t.Run("Should update the contract with an added function", func(t *testing.T) {
+		updatedCode := strings.Replace(
+			string(customTokenCode),
+			"\n    init() {",
+			"\n    pub fun version(): String {\n        return \"2\"\n    }\n\n    init() {",
+			1,
+		)
+		require.NotEqual(t, string(customTokenCode), updatedCode)
+
+		script := templates.GenerateUpdateContractTransaction()
+		tx := createTxWithTemplateAndAuthorizer(b, script, tokenAddr)
+
+		_ = tx.AddArgument(cadence.String("UtilityCoin"))
+		_ = tx.AddArgument(cadence.String(hex.EncodeToString([]byte(updatedCode))))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+			},
+			false,
+		)
+
+		result := executeScriptAndCheck(t, b, versionScript, nil)
+		assert.Equal(t, cadence.String("2"), result)
+
+		// State is preserved across the update
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("1000.0"), supply)
+	})
+
+	t.Run("Should pass the contract name as data", func(t *testing.T) {
+		script := templates.GenerateUpdateContractTransaction()
+		tx := createTxWithTemplateAndAuthorizer(b, script, tokenAddr)
+
+		_ = tx.AddArgument(cadence.String(`UtilityCoin", code: []) panic("injected`))
+		_ = tx.AddArgument(cadence.String(hex.EncodeToString(customTokenCode)))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+			},
+			true,
+		)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "cannot update non-existing contract")
+
+		version := executeScriptAndCheck(t, b, versionScript, nil)
+		assert.Equal(t, cadence.String("2"), version)
+	})
+}
+
+func TestMockToken(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	fungibleAddr, err := b.CreateAccount(
+		nil,
+		[]sdktemplates.Contract{
+			{
+				Name:   "FungibleToken",
+				Source: string(contracts.FungibleToken()),
+			},
+		},
+	)
+	assert.NoError(t, err)
+
+	_, err = b.CommitBlock()
+	assert.NoError(t, err)
+
+	mockTokenAccountKey, mockTokenSigner := accountKeys.NewWithSigner()
+	mockTokenAddr, err := b.CreateAccount(
+		[]*flow.AccountKey{mockTokenAccountKey},
+		[]sdktemplates.Contract{
+			{
+				Name:   "MockToken",
+				Source: string(contracts.MockToken(fungibleAddr.String())),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = b.CommitBlock()
+	assert.NoError(t, err)
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	t.Run("Should set up an account", func(t *testing.T) {
+		script := templates.GenerateCreateTokenScript(fungibleAddr, mockTokenAddr, "MockToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			false,
+		)
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, mockTokenAddr, "MockToken")
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+		assert.Equal(t, CadenceUFix64("0.0"), result)
+	})
+
+	t.Run("Should mint and transfer tokens", func(t *testing.T) {
+		script := templates.GenerateMintTokensScript(fungibleAddr, mockTokenAddr, "MockToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, mockTokenAddr)
+
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+		_ = tx.AddArgument(CadenceUFix64("50.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				mockTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				mockTokenSigner,
+			},
+			false,
+		)
+
+		script = templates.GenerateTransferVaultScript(fungibleAddr, mockTokenAddr, "MockToken")
+		tx = createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		_ = tx.AddArgument(CadenceUFix64("20.0"))
+		_ = tx.AddArgument(cadence.NewAddress(mockTokenAddr))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			false,
+		)
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, mockTokenAddr, "MockToken")
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+		assert.Equal(t, CadenceUFix64("30.0"), result)
+
+		result = executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(mockTokenAddr))})
+		assert.Equal(t, CadenceUFix64("1020.0"), result)
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, mockTokenAddr, "MockToken")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("1050.0"), supply)
+	})
+
+	t.Run("Shouldn't resolve metadata views", func(t *testing.T) {
+		metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+		script := []byte(fmt.Sprintf(`
+			import MetadataViews from 0x%s
+			import MockToken from 0x%s
+
+			pub fun main(account: Address): Bool {
+				return getAccount(account)
+					.getCapability(MockToken.BalancePublicPath)
+					.borrow<&{MetadataViews.Resolver}>() == nil
+			}
+		`, metadataViewsAddr, mockTokenAddr))
+
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(mockTokenAddr))})
+		assert.Equal(t, cadence.NewBool(true), result)
+	})
+}
+
+func TestPublishReceiver(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	customPath := cadence.Path{Domain: "public", Identifier: "myAppExampleTokenReceiver"}
+
+	publish := func(path cadence.Path, shouldRevert bool) {
+		script := templates.GeneratePublishReceiverTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(path)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			shouldRevert,
+		)
+	}
+
+	t.Run("Shouldn't be able to publish at a storage path", func(t *testing.T) {
+		publish(cadence.Path{Domain: "storage", Identifier: "myAppExampleTokenReceiver"}, true)
+	})
+
+	t.Run("Should publish at a custom public path", func(t *testing.T) {
+		publish(customPath, false)
+
+		script := []byte(fmt.Sprintf(`
+			import FungibleToken from 0x%s
+
+			pub fun main(account: Address, path: PublicPath): UFix64 {
+				return getAccount(account)
+					.getCapability(path)
+					.borrow<&{FungibleToken.Balance}>()!
+					.balance
+			}
+		`, fungibleAddr))
+
+		result := executeScriptAndCheck(t, b,
+			script,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(exampleTokenAddr)),
+				jsoncdc.MustEncode(customPath),
+			},
+		)
+		assert.Equal(t, CadenceUFix64("1000.0"), result)
+	})
+
+	t.Run("Shouldn't be able to publish twice at the same path", func(t *testing.T) {
+		publish(customPath, true)
+	})
+}
+
+func TestGetAggregateBalance(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	secondaryPath := cadence.Path{Domain: "storage", Identifier: "exampleTokenSecondaryVault"}
+
+	createSecondaryVault(t, b, fungibleAddr, exampleTokenAddr, exampleTokenAddr, exampleTokenSigner, secondaryPath)
+	fundVaultAtPath(t, b, exampleTokenAddr, exampleTokenAddr, exampleTokenSigner, secondaryPath, "300.0")
+
+	aggregateScript := templates.GenerateGetAggregateBalanceScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+
+	t.Run("Should sum the balances of the Vaults at all paths", func(t *testing.T) {
+		result := executeScriptAndCheck(t, b,
+			aggregateScript,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(exampleTokenAddr)),
+				jsoncdc.MustEncode(cadence.NewArray([]cadence.Value{secondaryPath})),
+			},
+		)
+		assert.Equal(t, CadenceUFix64("1000.0"), result)
+	})
+
+	t.Run("Should count each Vault once and skip paths without a Vault", func(t *testing.T) {
+		result := executeScriptAndCheck(t, b,
+			aggregateScript,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(exampleTokenAddr)),
+				jsoncdc.MustEncode(cadence.NewArray([]cadence.Value{
+					secondaryPath,
+					secondaryPath,
+					cadence.Path{Domain: "storage", Identifier: "exampleTokenVault"},
+					cadence.Path{Domain: "storage", Identifier: "exampleTokenAdmin"},
+					cadence.Path{Domain: "storage", Identifier: "empty"},
+				})),
+			},
+		)
+		assert.Equal(t, CadenceUFix64("1000.0"), result)
+	})
+
+	t.Run("Should only count the standard Vault without additional paths", func(t *testing.T) {
+		result := executeScriptAndCheck(t, b,
+			aggregateScript,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(exampleTokenAddr)),
+				jsoncdc.MustEncode(cadence.NewArray([]cadence.Value{})),
+			},
+		)
+		assert.Equal(t, CadenceUFix64("700.0"), result)
+	})
+}
+
+func TestCustomTokenFixedSupply(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	tokenAccountKey, tokenSigner := accountKeys.NewWithSigner()
+
+	fungibleAddr := deploy(t, b, "FungibleToken", contracts.FungibleToken())
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+	customTokenCode, err := contracts.CustomTokenWithMetadataViews(
+		fungibleAddr.String(),
+		metadataViewsAddr.String(),
+		"UtilityCoin",
+		"utilityCoin",
+		"500.0",
+		contracts.FixedSupply(),
+	)
+	require.NoError(t, err)
+
+	tokenAddr := deploy(t, b, "UtilityCoin", customTokenCode, tokenAccountKey)
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	supplyScript := templates.GenerateInspectSupplyScript(fungibleAddr, tokenAddr, "UtilityCoin")
+
+	t.Run("Should fund only the initial balance", func(t *testing.T) {
+		supply := executeScriptAndCheck(t, b, supplyScript, nil)
+		assert.Equal(t, CadenceUFix64("500.0"), supply)
+
+		script := templates.GenerateInspectVaultScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(tokenAddr))})
+		assert.Equal(t, CadenceUFix64("500.0"), result)
+	})
+
+	t.Run("Shouldn't be able to mint", func(t *testing.T) {
+		script := templates.GenerateMintTokensScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		tx := createTxWithTemplateAndAuthorizer(b, script, tokenAddr)
+
+		_ = tx.AddArgument(cadence.NewAddress(tokenAddr))
+		_ = tx.AddArgument(CadenceUFix64("50.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+			},
+			true,
+		)
+
+		supply := executeScriptAndCheck(t, b, supplyScript, nil)
+		assert.Equal(t, CadenceUFix64("500.0"), supply)
+	})
+
+	t.Run("Should transfer without changing the supply", func(t *testing.T) {
+		script := templates.GenerateCreateTokenScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			false,
+		)
+
+		script = templates.GenerateTransferVaultScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		tx = createTxWithTemplateAndAuthorizer(b, script, tokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64("200.0"))
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+			},
+			false,
+		)
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+		assert.Equal(t, CadenceUFix64("200.0"), result)
+
+		supply := executeScriptAndCheck(t, b, supplyScript, nil)
+		assert.Equal(t, CadenceUFix64("500.0"), supply)
+	})
+}
+
+func TestConsolidateVaults(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	firstPath := cadence.Path{Domain: "storage", Identifier: "exampleTokenFirstVault"}
+	secondPath := cadence.Path{Domain: "storage", Identifier: "exampleTokenSecondVault"}
+
+	createSecondaryVault(t, b, fungibleAddr, exampleTokenAddr, exampleTokenAddr, exampleTokenSigner, firstPath)
+	createSecondaryVault(t, b, fungibleAddr, exampleTokenAddr, exampleTokenAddr, exampleTokenSigner, secondPath)
+
+	fundVaultAtPath(t, b, exampleTokenAddr, exampleTokenAddr, exampleTokenSigner, firstPath, "100.0")
+	fundVaultAtPath(t, b, exampleTokenAddr, exampleTokenAddr, exampleTokenSigner, secondPath, "200.0")
+
+	t.Run("Should consolidate the Vaults into the standard Vault", func(t *testing.T) {
+		script := templates.GenerateConsolidateVaultsTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		// The standard Vault, an empty path and a path without a Vault are skipped
+		_ = tx.AddArgument(cadence.NewArray([]cadence.Value{
+			firstPath,
+			cadence.Path{Domain: "storage", Identifier: "exampleTokenVault"},
+			cadence.Path{Domain: "storage", Identifier: "empty"},
+			cadence.Path{Domain: "storage", Identifier: "exampleTokenAdmin"},
+			secondPath,
+		}))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(exampleTokenAddr))})
+		assert.Equal(t, CadenceUFix64("1000.0"), result)
+
+		script = templates.GenerateInspectSupplyScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		supply := executeScriptAndCheck(t, b, script, nil)
+		assert.Equal(t, CadenceUFix64("1000.0"), supply)
+
+		// The emptied Vaults are destroyed
+		script = []byte(fmt.Sprintf(`
+			import ExampleToken from 0x%s
+
+			pub fun main(account: Address, paths: [StoragePath]): Bool {
+				let acct = getAuthAccount(account)
+				for path in paths {
+					if acct.borrow<&ExampleToken.Vault>(from: path) != nil {
+						return false
+					}
+				}
+				return true
+			}
+		`, exampleTokenAddr))
+		result = executeScriptAndCheck(t, b,
+			script,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(exampleTokenAddr)),
+				jsoncdc.MustEncode(cadence.NewArray([]cadence.Value{firstPath, secondPath})),
+			},
+		)
+		assert.Equal(t, cadence.NewBool(true), result)
+	})
+}
+
+func TestExampleTokenLegacyDeployment(t *testing.T) {
+	b, _ := newTestSetup(t)
+
+	fungibleAddr := deploy(t, b, "FungibleToken", contracts.FungibleToken())
+	tokenAddr := deploy(t, b, "ExampleToken", contracts.ExampleTokenLegacy(fungibleAddr.String()))
+
+	script := templates.GenerateInspectSupplyScript(fungibleAddr, tokenAddr, "ExampleToken")
+	supply := executeScriptAndCheck(t, b, script, nil)
+	assert.Equal(t, CadenceUFix64("1000.0"), supply)
+}
+
+func TestDisplayDecimals(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	fungibleAddr := deploy(t, b, "FungibleToken", contracts.FungibleToken())
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+	exampleTokenAccountKey, _ := accountKeys.NewWithSigner()
+	exampleTokenAddr := deploy(t, b, "ExampleToken",
+		contracts.ExampleTokenWithMetadataViews(fungibleAddr.String(), metadataViewsAddr.String()),
+		exampleTokenAccountKey,
+	)
+
+	tokenAccountKey, _ := accountKeys.NewWithSigner()
+	customTokenCode, err := contracts.CustomTokenWithMetadataViews(
+		fungibleAddr.String(),
+		metadataViewsAddr.String(),
+		"UtilityCoin",
+		"utilityCoin",
+		"1000.0",
+		contracts.WithDisplayDecimals(2),
+	)
+	require.NoError(t, err)
+
+	tokenAddr := deploy(t, b, "UtilityCoin", customTokenCode, tokenAccountKey)
+
+	t.Run("Should default to 8 decimals", func(t *testing.T) {
+		script := templates.GenerateGetDisplayDecimalsScript(fungibleAddr, exampleTokenAddr, metadataViewsAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(exampleTokenAddr))})
+
+		decimals, err := templates.DisplayDecimals(result)
+		require.NoError(t, err)
+		assert.Equal(t, templates.DefaultDisplayDecimals, decimals)
+	})
+
+	t.Run("Should read the declared display decimals", func(t *testing.T) {
+		script := templates.GenerateGetDisplayDecimalsScript(fungibleAddr, tokenAddr, metadataViewsAddr, "UtilityCoin")
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(tokenAddr))})
+
+		decimals, err := templates.DisplayDecimals(result)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(2), decimals)
+	})
+}
+
+func TestChunkRecipients(t *testing.T) {
+	t.Run("Should split recipients into size-bounded chunks", func(t *testing.T) {
+		recipients := make([]templates.Recipient, 1000)
+		for i := range recipients {
+			recipients[i] = templates.Recipient{
+				Address: flow.BytesToAddress([]byte{byte(i >> 8), byte(i)}),
+				Amount:  CadenceUFix64("1.0").(cadence.UFix64),
+			}
+		}
+
+		chunks, err := templates.ChunkRecipients(recipients, 100)
+		require.NoError(t, err)
+		require.Len(t, chunks, 10)
+
+		seen := make(map[flow.Address]bool, len(recipients))
+		for _, chunk := range chunks {
+			assert.Len(t, chunk, 100)
+
+			for _, recipient := range chunk {
+				assert.False(t, seen[recipient.Address], "recipient %s is duplicated", recipient.Address)
+				seen[recipient.Address] = true
+			}
+		}
+		assert.Len(t, seen, len(recipients))
+	})
+
+	t.Run("Should put the remainder in a smaller last chunk", func(t *testing.T) {
+		recipients := make([]templates.Recipient, 7)
+
+		chunks, err := templates.ChunkRecipients(recipients, 3)
+		require.NoError(t, err)
+		require.Len(t, chunks, 3)
+		assert.Len(t, chunks[0], 3)
+		assert.Len(t, chunks[1], 3)
+		assert.Len(t, chunks[2], 1)
+
+		chunks, err = templates.ChunkRecipients(nil, 3)
+		require.NoError(t, err)
+		assert.Empty(t, chunks)
+	})
+
+	t.Run("Should return an error for a chunk size that isn't positive", func(t *testing.T) {
+		recipients := make([]templates.Recipient, 7)
+
+		for _, maxPerTx := range []int{0, -1} {
+			chunks, err := templates.ChunkRecipients(recipients, maxPerTx)
+			assert.Error(t, err, maxPerTx)
+			assert.Nil(t, chunks)
+		}
+	})
+
+	t.Run("Should transfer each chunk in its own transaction", func(t *testing.T) {
+		b, accountKeys := newTestSetup(t)
+
+		exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+		fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+		var recipients []templates.Recipient
+		for i := 0; i < 5; i++ {
+			accountKey, signer := accountKeys.NewWithSigner()
+			address, _ := b.CreateAccount([]*flow.AccountKey{accountKey}, nil)
+
+			script := templates.GenerateCreateTokenScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+			tx := createTxWithTemplateAndAuthorizer(b, script, address)
+
+			signAndSubmit(
+				t, b, tx,
+				[]flow.Address{
+					b.ServiceKey().Address,
+					address,
+				},
+				[]crypto.Signer{
+					b.ServiceKey().Signer(),
+					signer,
+				},
+				false,
+			)
+
+			recipients = append(recipients, templates.Recipient{
+				Address: address,
+				Amount:  CadenceUFix64("10.0").(cadence.UFix64),
+			})
+		}
+
+		chunks, err := templates.ChunkRecipients(recipients, 2)
+		require.NoError(t, err)
+
+		for _, chunk := range chunks {
+			argument, err := templates.TransferManyAccountsArgument(chunk)
+			require.NoError(t, err)
+
+			script := templates.GenerateTransferManyAccountsScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+			tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+			_ = tx.AddArgument(argument)
+
+			signAndSubmit(
+				t, b, tx,
+				[]flow.Address{
+					b.ServiceKey().Address,
+					exampleTokenAddr,
+				},
+				[]crypto.Signer{
+					b.ServiceKey().Signer(),
+					exampleTokenSigner,
+				},
+				false,
+			)
+		}
+
+		script := templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		for _, recipient := range recipients {
+			result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(recipient.Address))})
+			assert.Equal(t, CadenceUFix64("10.0"), result)
+		}
+
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(exampleTokenAddr))})
+		assert.Equal(t, CadenceUFix64("950.0"), result)
+	})
+
+	t.Run("Shouldn't build an argument with a duplicate recipient", func(t *testing.T) {
+		recipient := templates.Recipient{
+			Address: flow.HexToAddress("01"),
+			Amount:  CadenceUFix64("1.0").(cadence.UFix64),
+		}
+
+		_, err := templates.TransferManyAccountsArgument([]templates.Recipient{recipient, recipient})
+		assert.Error(t, err)
+	})
+}
+
+func TestSupplyInvariant(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	script := templates.GenerateCreateTokenScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			joshAddress,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			joshSigner,
+		},
+		false,
+	)
+
+	script = templates.GenerateMintTokensScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	tx = createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+	_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+	_ = tx.AddArgument(CadenceUFix64("50.0"))
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			exampleTokenAddr,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			exampleTokenSigner,
+		},
+		false,
+	)
+
+	script = templates.GenerateTransferVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	tx = createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+	_ = tx.AddArgument(CadenceUFix64("20.0"))
+	_ = tx.AddArgument(cadence.NewAddress(exampleTokenAddr))
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			joshAddress,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			joshSigner,
+		},
+		false,
+	)
+
+	invariantScript := templates.GenerateSupplyInvariantScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+
+	checkInvariant := func(accounts ...flow.Address) (totalSupply, balanceSum cadence.Value) {
+		values := make([]cadence.Value, len(accounts))
+		for i, account := range accounts {
+			values[i] = cadence.NewAddress(account)
+		}
+
+		result := executeScriptAndCheck(t, b, invariantScript, [][]byte{jsoncdc.MustEncode(cadence.NewArray(values))})
+
+		fields := result.(cadence.Struct).Fields
+		require.Len(t, fields, 2)
+
+		return fields[0], fields[1]
+	}
+
+	t.Run("Should hold for the known account set", func(t *testing.T) {
+		totalSupply, balanceSum := checkInvariant(exampleTokenAddr, joshAddress)
+		assert.Equal(t, CadenceUFix64("1050.0"), totalSupply)
+		assert.Equal(t, totalSupply, balanceSum)
+	})
+
+	t.Run("Shouldn't hold for a partial account set", func(t *testing.T) {
+		totalSupply, balanceSum := checkInvariant(joshAddress)
+		assert.Equal(t, CadenceUFix64("1050.0"), totalSupply)
+		assert.Equal(t, CadenceUFix64("30.0"), balanceSum)
+	})
+}
+
+func TestSetMinterAllowance(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+
+	fungibleAddr := deploy(t, b, "FungibleToken", contracts.FungibleToken())
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+	exampleTokenCode, err := contracts.CustomTokenWithMetadataViews(
+		fungibleAddr.String(),
+		metadataViewsAddr.String(),
+		"ExampleToken",
+		"exampleToken",
+		"1000.0",
+		contracts.WithAdjustableMinterAllowance(),
+	)
+	require.NoError(t, err)
+
+	exampleTokenAddr := deploy(t, b, "ExampleToken", exampleTokenCode, exampleTokenAccountKey)
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	script := templates.GenerateCreateTokenScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			joshAddress,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			joshSigner,
+		},
+		false,
+	)
+
+	script = templates.GenerateIssueMinterCapabilityTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	tx = createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr).
+		AddAuthorizer(joshAddress)
+
+	_ = tx.AddArgument(CadenceUFix64("100.0"))
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			exampleTokenAddr,
+			joshAddress,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			exampleTokenSigner,
+			joshSigner,
+		},
+		false,
+	)
+
+	setAllowance := func(allowedAmount string) {
+		script := templates.GenerateSetMinterAllowanceTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64(allowedAmount))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+	}
+
+	mint := func(amount string, shouldRevert bool) {
+		script := templates.GenerateDelegatedMintTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+		_ = tx.AddArgument(CadenceUFix64(amount))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			shouldRevert,
+		)
+	}
+
+	t.Run("Should bound mints by a lowered allowance", func(t *testing.T) {
+		setAllowance("10.0")
+
+		mint("20.0", true)
+		mint("10.0", false)
+		mint("1.0", true)
+	})
+
+	t.Run("Should allow mints up to a raised allowance", func(t *testing.T) {
+		setAllowance("500.0")
+
+		mint("300.0", false)
+		mint("200.0", false)
+		mint("1.0", true)
+
+		script := templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		result := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+		assert.Equal(t, CadenceUFix64("510.0"), result)
+	})
+
+	t.Run("Shouldn't let a non-admin set the allowance", func(t *testing.T) {
+		script := templates.GenerateSetMinterAllowanceTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		_ = tx.AddArgument(CadenceUFix64("1000.0"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			true,
+		)
+	})
+}
+
+func TestTransferWithMemo(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	memoAddr, err := b.CreateAccount(
+		nil,
+		[]sdktemplates.Contract{
+			{
+				Name:   "TokenMemo",
+				Source: string(contracts.TokenMemo(fungibleAddr.String())),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = b.CommitBlock()
+	assert.NoError(t, err)
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	script := templates.GenerateCreateTokenScript(fungibleAddr, exampleTokenAddr, "ExampleToken")
+	tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			joshAddress,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			joshSigner,
+		},
+		false,
+	)
+
+	t.Run("Should emit the memo with a successful transfer", func(t *testing.T) {
+		script := templates.GenerateTransferWithMemoTransaction(fungibleAddr, exampleTokenAddr, memoAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64("30.0"))
+		_ = tx.AddArgument(cadence.NewAddress(joshAddress))
+		_ = tx.AddArgument(cadence.String("invoice-1234"))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		memoEventType := fmt.Sprintf("A.%s.TokenMemo.MemoDeposit", memoAddr)
+
+		var memoEvents []cadence.Event
+		for _, event := range result.Events {
+			if string(event.Type) == memoEventType {
+				memoEvents = append(memoEvents, event.Value)
+			}
+		}
+		require.Len(t, memoEvents, 1)
+
+		fields := memoEvents[0].Fields
+		assert.Equal(t, cadence.String("invoice-1234"), fields[0])
+		assert.Equal(t, CadenceUFix64("30.0"), fields[1])
+		assert.Equal(t, cadence.NewOptional(cadence.NewAddress(joshAddress)), fields[2])
+		assert.Equal(t, cadence.String(fmt.Sprintf("A.%s.ExampleToken.Vault", exampleTokenAddr)), fields[3])
+
+		events := FilterFTEvents(result, exampleTokenAddr, "ExampleToken")
+		require.Len(t, events, 2)
+		assert.Equal(t, "TokensDeposited", events[1].Name)
+		assert.Equal(t, CadenceUFix64("30.0"), events[1].Amount)
+
+		balance := executeScriptAndCheck(t, b,
+			templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken"),
+			[][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))},
+		)
+		assert.Equal(t, CadenceUFix64("30.0"), balance)
+	})
+
+	t.Run("Should not emit a memo when the transfer fails", func(t *testing.T) {
+		script := templates.GenerateTransferWithMemoTransaction(fungibleAddr, exampleTokenAddr, memoAddr, "ExampleToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		_ = tx.AddArgument(CadenceUFix64("100.0"))
+		_ = tx.AddArgument(cadence.NewAddress(exampleTokenAddr))
+		_ = tx.AddArgument(cadence.String("invoice-5678"))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			true,
+		)
+
+		assert.Empty(t, result.Events)
+	})
+}
+
+func TestBurnWithReason(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+
+	fungibleAddr := deploy(t, b, "FungibleToken", contracts.FungibleToken())
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+	exampleTokenCode, err := contracts.CustomTokenWithMetadataViews(
+		fungibleAddr.String(),
+		metadataViewsAddr.String(),
+		"ExampleToken",
+		"exampleToken",
+		"1000.0",
+		contracts.WithBurnReason(),
+	)
+	require.NoError(t, err)
+
+	exampleTokenAddr := deploy(t, b, "ExampleToken", exampleTokenCode, exampleTokenAccountKey)
+
+	reservePath := cadence.Path{Domain: "storage", Identifier: "exampleTokenReserveVault"}
+
+	createSecondaryVault(t, b, fungibleAddr, exampleTokenAddr, exampleTokenAddr, exampleTokenSigner, reservePath)
+	fundVaultAtPath(t, b, exampleTokenAddr, exampleTokenAddr, exampleTokenSigner, reservePath, "300.0")
+
+	script := templates.GenerateBurnWithReasonTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+
+	t.Run("Should not burn more than the Vault holds", func(t *testing.T) {
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64("300.00000001"))
+		_ = tx.AddArgument(reservePath)
+		_ = tx.AddArgument(cadence.String("court order 17"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			true,
+		)
+
+		balance := vaultBalanceAtPath(t, b, exampleTokenAddr, exampleTokenAddr, reservePath)
+		assert.Equal(t, CadenceUFix64("300.0"), balance)
+	})
+
+	t.Run("Should burn from the Vault at the path and emit the reason", func(t *testing.T) {
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64("120.0"))
+		_ = tx.AddArgument(reservePath)
+		_ = tx.AddArgument(cadence.String("court order 17"))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		eventTypes, err := templates.EventTypeIdentifiers(exampleTokenAddr.String(), "ExampleToken", "TokensBurnedWithReason")
+		require.NoError(t, err)
+		reasonEventType := eventTypes["TokensBurnedWithReason"]
+
+		var reasonEvents []flow.Event
+		for _, event := range result.Events {
+			if event.Type == reasonEventType {
+				reasonEvents = append(reasonEvents, event)
+			}
+		}
+		require.Len(t, reasonEvents, 1)
+
+		fields := reasonEvents[0].Value.Fields
+		require.Len(t, fields, 2)
+		assert.Equal(t, CadenceUFix64("120.0"), fields[0])
+		assert.Equal(t, cadence.String("court order 17"), fields[1])
+
+		supply := executeScriptAndCheck(t, b,
+			templates.GenerateInspectSupplyScript(fungibleAddr, exampleTokenAddr, "ExampleToken"),
+			nil,
+		)
+		assert.Equal(t, CadenceUFix64("880.0"), supply)
+
+		balance := vaultBalanceAtPath(t, b, exampleTokenAddr, exampleTokenAddr, reservePath)
+		assert.Equal(t, CadenceUFix64("180.0"), balance)
+
+		balance = executeScriptAndCheck(t, b,
+			templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken"),
+			[][]byte{jsoncdc.MustEncode(cadence.Address(exampleTokenAddr))},
+		)
+		assert.Equal(t, CadenceUFix64("700.0"), balance)
+	})
+}
+
+func TestCustomTokenDeploymentPlan(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	fungibleAddr := deploy(t, b, "FungibleToken", contracts.FungibleToken())
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+	tokenAccountKey, tokenSigner := accountKeys.NewWithSigner()
+	tokenAddr, err := b.CreateAccount([]*flow.AccountKey{tokenAccountKey}, nil)
+	require.NoError(t, err)
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	code, err := contracts.CustomTokenWithMetadataViews(fungibleAddr.String(), metadataViewsAddr.String(), "UtilityCoin", "utilityCoin", "1000.0")
+	require.NoError(t, err)
+
+	plan, err := templates.GenerateCustomTokenDeploymentPlan(templates.ContractConfig{
+		FungibleTokenAddr: fungibleAddr,
+		TokenAddr:         tokenAddr,
+		TokenName:         "UtilityCoin",
+		Code:              code,
+		Distribution: []templates.Recipient{
+			{Address: joshAddress, Amount: CadenceUFix64("150.0").(cadence.UFix64)},
+			{Address: tokenAddr, Amount: CadenceUFix64("50.0").(cadence.UFix64)},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, plan, 3)
+
+	distribution := plan[len(plan)-1]
+	assert.Equal(t, templates.GenerateDistributeInitialSupplyTransaction(fungibleAddr, tokenAddr, "UtilityCoin"), distribution.Script)
+	require.Len(t, distribution.Arguments, 2)
+	assert.Equal(t, CadenceUFix64("200.0"), distribution.Arguments[1])
+
+	submitPlanned := func(planned templates.PlannedTx) {
+		tx := createTxWithTemplateAndAuthorizer(b, planned.Script, tokenAddr)
+		for _, argument := range planned.Arguments {
+			_ = tx.AddArgument(argument)
+		}
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+			},
+			false,
+		)
+	}
+
+	t.Run("Should deploy and distribute the token", func(t *testing.T) {
+		submitPlanned(plan[0])
+
+		// Recipients set up their own accounts once the contract is deployed
+		script := templates.GenerateCreateTokenScript(fungibleAddr, tokenAddr, "UtilityCoin")
+		tx := createTxWithTemplateAndAuthorizer(b, script, joshAddress)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				joshAddress,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				joshSigner,
+			},
+			false,
+		)
+
+		for _, planned := range plan[1:] {
+			submitPlanned(planned)
+		}
+
+		script = templates.GenerateInspectVaultScript(fungibleAddr, tokenAddr, "UtilityCoin")
+
+		balance := executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(joshAddress))})
+		assert.Equal(t, CadenceUFix64("150.0"), balance)
+
+		balance = executeScriptAndCheck(t, b, script, [][]byte{jsoncdc.MustEncode(cadence.Address(tokenAddr))})
+		assert.Equal(t, CadenceUFix64("1050.0"), balance)
+
+		supply := executeScriptAndCheck(t, b, templates.GenerateInspectSupplyScript(fungibleAddr, tokenAddr, "UtilityCoin"), nil)
+		assert.Equal(t, CadenceUFix64("1200.0"), supply)
+	})
+
+	t.Run("Should only deploy and set up without a distribution", func(t *testing.T) {
+		plan, err := templates.GenerateCustomTokenDeploymentPlan(templates.ContractConfig{
+			FungibleTokenAddr: fungibleAddr,
+			TokenAddr:         tokenAddr,
+			TokenName:         "UtilityCoin",
+			Code:              code,
+		})
+		require.NoError(t, err)
+		assert.Len(t, plan, 2)
+	})
+
+	t.Run("Should not distribute a fixed supply token", func(t *testing.T) {
+		fixedSupplyCode, err := contracts.CustomTokenWithMetadataViews(fungibleAddr.String(), metadataViewsAddr.String(), "UtilityCoin", "utilityCoin", "1000.0", contracts.FixedSupply())
+		require.NoError(t, err)
+
+		_, err = templates.GenerateCustomTokenDeploymentPlan(templates.ContractConfig{
+			FungibleTokenAddr: fungibleAddr,
+			TokenAddr:         tokenAddr,
+			TokenName:         "UtilityCoin",
+			Code:              fixedSupplyCode,
+			Distribution:      []templates.Recipient{{Address: joshAddress, Amount: CadenceUFix64("150.0").(cadence.UFix64)}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Should not distribute to a recipient twice", func(t *testing.T) {
+		_, err := templates.GenerateCustomTokenDeploymentPlan(templates.ContractConfig{
+			FungibleTokenAddr: fungibleAddr,
+			TokenAddr:         tokenAddr,
+			TokenName:         "UtilityCoin",
+			Code:              code,
+			Distribution: []templates.Recipient{
+				{Address: joshAddress, Amount: CadenceUFix64("150.0").(cadence.UFix64)},
+				{Address: joshAddress, Amount: CadenceUFix64("50.0").(cadence.UFix64)},
+			},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDustVaults(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	primaryPath := cadence.Path{Domain: "storage", Identifier: "exampleTokenVault"}
+	secondaryPath := cadence.Path{Domain: "storage", Identifier: "exampleTokenSecondaryVault"}
+
+	createSecondaryVault(t, b, fungibleAddr, exampleTokenAddr, exampleTokenAddr, exampleTokenSigner, secondaryPath)
+	fundVaultAtPath(t, b, exampleTokenAddr, exampleTokenAddr, exampleTokenSigner, secondaryPath, "300.0")
+
+	dustScript := templates.GenerateGetDustVaultsScript(fungibleAddr)
+
+	dustVaults := func(paths []cadence.Value, threshold string) []cadence.Value {
+		result := executeScriptAndCheck(t, b,
+			dustScript,
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(exampleTokenAddr)),
+				jsoncdc.MustEncode(cadence.NewArray(paths)),
+				jsoncdc.MustEncode(CadenceUFix64(threshold)),
+			},
+		)
+
+		return result.(cadence.Array).Values
+	}
+
+	t.Run("Should only return the Vault below the threshold", func(t *testing.T) {
+		paths := dustVaults([]cadence.Value{primaryPath, secondaryPath}, "500.0")
+		assert.Equal(t, []cadence.Value{secondaryPath}, paths)
+	})
+
+	t.Run("Should skip paths without a Vault and return each path once", func(t *testing.T) {
+		paths := dustVaults([]cadence.Value{
+			secondaryPath,
+			secondaryPath,
+			primaryPath,
+			cadence.Path{Domain: "storage", Identifier: "exampleTokenAdmin"},
+			cadence.Path{Domain: "storage", Identifier: "empty"},
+		}, "500.0")
+		assert.Equal(t, []cadence.Value{secondaryPath}, paths)
+	})
+
+	t.Run("Should return Vaults of any token type", func(t *testing.T) {
+		mockTokenAddr, err := b.CreateAccount(
+			nil,
+			[]sdktemplates.Contract{
+				{
+					Name:   "MockToken",
+					Source: string(contracts.MockToken(fungibleAddr.String())),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		_, err = b.CommitBlock()
+		require.NoError(t, err)
+
+		script := templates.GenerateCreateTokenScript(fungibleAddr, mockTokenAddr, "MockToken")
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		mockTokenPath := cadence.Path{Domain: "storage", Identifier: "mockTokenVault"}
+
+		paths := dustVaults([]cadence.Value{primaryPath, secondaryPath, mockTokenPath}, "1000.0")
+		assert.Equal(t, []cadence.Value{primaryPath, secondaryPath, mockTokenPath}, paths)
+	})
+}
+
+func TestContractExists(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, _ := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	joshAccountKey, _ := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+
+	contractExists := func(contractName string, address flow.Address) cadence.Value {
+		return executeScriptAndCheck(t, b,
+			templates.GenerateContractExistsScript(),
+			[][]byte{
+				jsoncdc.MustEncode(cadence.Address(address)),
+				jsoncdc.MustEncode(cadence.String(contractName)),
+			},
+		)
+	}
+
+	t.Run("Should find a deployed contract", func(t *testing.T) {
+		assert.Equal(t, cadence.NewBool(true), contractExists("ExampleToken", exampleTokenAddr))
+		assert.Equal(t, cadence.NewBool(true), contractExists("FungibleToken", fungibleAddr))
+	})
+
+	t.Run("Should not find a contract the account doesn't host", func(t *testing.T) {
+		assert.Equal(t, cadence.NewBool(false), contractExists("ExampleToken", joshAddress))
+		assert.Equal(t, cadence.NewBool(false), contractExists("ExampleToken", fungibleAddr))
+	})
+
+	t.Run("Should pass the contract name as data", func(t *testing.T) {
+		assert.Equal(t, cadence.NewBool(false), contractExists(`ExampleToken") != nil || true || nil == ("`, exampleTokenAddr))
+	})
+}
+
+func TestSetDisplay(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	tokenAccountKey, tokenSigner := accountKeys.NewWithSigner()
+
+	fungibleAddr := deploy(t, b, "FungibleToken", contracts.FungibleToken())
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
+	customTokenCode, err := contracts.CustomTokenWithMetadataViews(
+		fungibleAddr.String(),
+		metadataViewsAddr.String(),
+		"UtilityCoin",
+		"utilityCoin",
+		"1000.0",
+		contracts.WithMutableDisplay(),
+	)
+	require.NoError(t, err)
+
+	tokenAddr := deploy(t, b, "UtilityCoin", customTokenCode, tokenAccountKey)
+
+	metadataScript := templates.GenerateGetDisplayMetadataScript(fungibleAddr, tokenAddr, metadataViewsAddr, "UtilityCoin")
+
+	displayMetadata := func() []cadence.Value {
+		result := executeScriptAndCheck(t, b,
+			metadataScript,
+			[][]byte{jsoncdc.MustEncode(cadence.Address(tokenAddr))},
+		)
+
+		return result.(cadence.Struct).Fields
+	}
+
+	t.Run("Should resolve the default display before it is set", func(t *testing.T) {
+		fields := displayMetadata()
+		require.Len(t, fields, 4)
+
+		assert.Equal(t, cadence.String("UtilityCoin"), fields[0])
+		assert.Equal(t, cadence.NewOptional(nil), fields[1])
+		assert.Equal(t, cadence.String("This is an UtilityCoin"), fields[2])
+		assert.Equal(t, cadence.String("https://s2.coinmarketcap.com/static/img/coins/200x200/4558.png"), fields[3])
+	})
+
+	t.Run("Should resolve the display the admin set", func(t *testing.T) {
+		script := templates.GenerateSetDisplayTransaction(fungibleAddr, tokenAddr, "UtilityCoin")
+		tx := createTxWithTemplateAndAuthorizer(b, script, tokenAddr)
+
+		// Quotes and backslashes reach the contract unchanged
+		description := `The "utility" coin \ now with metadata`
+
+		_ = tx.AddArgument(cadence.String("Utility Coin"))
+		_ = tx.AddArgument(cadence.String("UTIL"))
+		_ = tx.AddArgument(cadence.String(description))
+		_ = tx.AddArgument(cadence.String("https://example.com/util.png"))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				tokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				tokenSigner,
+			},
+			false,
+		)
+
+		fields := displayMetadata()
+		require.Len(t, fields, 4)
+
+		assert.Equal(t, cadence.String("Utility Coin"), fields[0])
+		assert.Equal(t, cadence.NewOptional(cadence.String("UTIL")), fields[1])
+		assert.Equal(t, cadence.String(description), fields[2])
+		assert.Equal(t, cadence.String("https://example.com/util.png"), fields[3])
+	})
+}
+
+func TestSelfTransfer(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, exampleTokenSigner := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	script := templates.GenerateSelfTransferTransaction(fungibleAddr, exampleTokenAddr, "ExampleToken")
+
+	balance := func() cadence.Value {
+		return executeScriptAndCheck(t, b,
+			templates.GenerateInspectVaultScript(fungibleAddr, exampleTokenAddr, "ExampleToken"),
+			[][]byte{jsoncdc.MustEncode(cadence.Address(exampleTokenAddr))},
+		)
+	}
+
+	t.Run("Should withdraw and deposit back to the same Vault", func(t *testing.T) {
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64("250.0"))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			false,
+		)
+
+		events := FilterFTEvents(result, exampleTokenAddr, "ExampleToken")
+		require.Len(t, events, 2)
+
+		assert.Equal(t, "TokensWithdrawn", events[0].Name)
+		assert.Equal(t, CadenceUFix64("250.0"), events[0].Amount)
+		require.NotNil(t, events[0].Address)
+		assert.Equal(t, exampleTokenAddr, *events[0].Address)
+
+		assert.Equal(t, "TokensDeposited", events[1].Name)
+		assert.Equal(t, CadenceUFix64("250.0"), events[1].Amount)
+		require.NotNil(t, events[1].Address)
+		assert.Equal(t, exampleTokenAddr, *events[1].Address)
+
+		assert.Equal(t, CadenceUFix64("1000.0"), balance())
+	})
+
+	t.Run("Should not withdraw more than the balance", func(t *testing.T) {
+		tx := createTxWithTemplateAndAuthorizer(b, script, exampleTokenAddr)
+
+		_ = tx.AddArgument(CadenceUFix64("1000.00000001"))
+
+		result := signAndSubmit(
+			t, b, tx,
+			[]flow.Address{
+				b.ServiceKey().Address,
+				exampleTokenAddr,
+			},
+			[]crypto.Signer{
+				b.ServiceKey().Signer(),
+				exampleTokenSigner,
+			},
+			true,
+		)
+
+		assert.Contains(t, result.Error.Error(), "The amount exceeds the balance of the Vault")
+		assert.Equal(t, CadenceUFix64("1000.0"), balance())
+	})
+}
+
+func TestFungibleTokenFeatures(t *testing.T) {
+	b, accountKeys := newTestSetup(t)
+
+	exampleTokenAccountKey, _ := accountKeys.NewWithSigner()
+	fungibleAddr, exampleTokenAddr, _ := DeployTokenContracts(b, t, []*flow.AccountKey{exampleTokenAccountKey})
+
+	fungibleTokenCode := func(address flow.Address) cadence.Value {
+		return executeScriptAndCheck(t, b,
+			templates.GenerateGetFungibleTokenCodeScript(),
+			[][]byte{jsoncdc.MustEncode(cadence.Address(address))},
+		)
+	}
+
+	t.Run("Should report the features of the deployed FungibleToken", func(t *testing.T) {
+		features, err := templates.ParseFungibleTokenFeatures(fungibleTokenCode(fungibleAddr))
+		require.NoError(t, err)
+
+		assert.Equal(t, templates.FungibleTokenFeatures{
+			Provider: true,
+			Receiver: true,
+			Balance:  true,
+		}, features)
+	})
+
+	t.Run("Should fail for an account that doesn't host FungibleToken", func(t *testing.T) {
+		_, err := templates.ParseFungibleTokenFeatures(fungibleTokenCode(exampleTokenAddr))
+		assert.Error(t, err)
+	})
+}
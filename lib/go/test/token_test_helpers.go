@@ -1,19 +1,30 @@
 package test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-emulator/types"
 	sdktemplates "github.com/onflow/flow-go-sdk/templates"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/client"
+	"github.com/onflow/flow-go-sdk/crypto"
 
 	"github.com/onflow/flow-ft/lib/go/contracts"
+	"github.com/onflow/flow-ft/lib/go/templates"
 )
 
+// The access client of the Flow Go SDK must be usable as a contracts.RegistryClient
+var _ contracts.RegistryClient = (*client.Client)(nil)
+
 // Deploys the FungibleToken, ExampleToken, and TokenForwarding contracts
-// to different accounts and returns their addresses
+// to different accounts and returns their addresses.
+// The MetadataViews contracts ExampleToken depends on are deployed as well
 func DeployTokenContracts(
 	b *emulator.Blockchain,
 	t *testing.T,
@@ -41,8 +52,10 @@ func DeployTokenContracts(
 	_, err = b.CommitBlock()
 	assert.NoError(t, err)
 
+	metadataViewsAddr := DeployMetadataViewsContracts(b, t, fungibleAddr)
+
 	// Deploy the ExampleToken contract
-	exampleTokenCode := contracts.ExampleToken(fungibleAddr.String())
+	exampleTokenCode := contracts.ExampleTokenWithMetadataViews(fungibleAddr.String(), metadataViewsAddr.String())
 	tokenAddr, err = b.CreateAccount(
 		key,
 		[]sdktemplates.Contract{
@@ -75,3 +88,211 @@ func DeployTokenContracts(
 
 	return fungibleAddr, tokenAddr, forwardingAddr
 }
+
+// Deploys the NonFungibleToken and MetadataViews contracts
+// to different accounts and returns the address of MetadataViews
+func DeployMetadataViewsContracts(
+	b *emulator.Blockchain,
+	t *testing.T,
+	fungibleAddr flow.Address,
+) (
+	metadataViewsAddr flow.Address,
+) {
+	var err error
+
+	// Deploy the NonFungibleToken contract
+	nonFungibleTokenCode := contracts.NonFungibleToken()
+	nonFungibleAddr, err := b.CreateAccount(
+		nil,
+		[]sdktemplates.Contract{
+			{
+				Name:   "NonFungibleToken",
+				Source: string(nonFungibleTokenCode),
+			},
+		},
+	)
+	assert.NoError(t, err)
+
+	_, err = b.CommitBlock()
+	assert.NoError(t, err)
+
+	// Deploy the MetadataViews contract
+	metadataViewsCode := contracts.MetadataViews(fungibleAddr.String(), nonFungibleAddr.String())
+	metadataViewsAddr, err = b.CreateAccount(
+		nil,
+		[]sdktemplates.Contract{
+			{
+				Name:   "MetadataViews",
+				Source: string(metadataViewsCode),
+			},
+		},
+	)
+	assert.NoError(t, err)
+
+	_, err = b.CommitBlock()
+	assert.NoError(t, err)
+
+	return metadataViewsAddr
+}
+
+// Stores an additional empty ExampleToken Vault at the specified path of an account
+func createSecondaryVault(
+	t *testing.T,
+	b *emulator.Blockchain,
+	fungibleAddr flow.Address,
+	tokenAddr flow.Address,
+	accountAddr flow.Address,
+	accountSigner crypto.Signer,
+	path cadence.Path,
+) {
+	script := templates.GenerateCreateSecondaryVaultTransaction(fungibleAddr, tokenAddr, "ExampleToken")
+	tx := createTxWithTemplateAndAuthorizer(b, script, accountAddr)
+
+	_ = tx.AddArgument(path)
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			accountAddr,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			accountSigner,
+		},
+		false,
+	)
+}
+
+// Moves tokens from the standard ExampleToken Vault of an account
+// to the Vault it stores at the specified path
+func fundVaultAtPath(
+	t *testing.T,
+	b *emulator.Blockchain,
+	tokenAddr flow.Address,
+	accountAddr flow.Address,
+	accountSigner crypto.Signer,
+	path cadence.Path,
+	amount string,
+) {
+	script := []byte(fmt.Sprintf(`
+		import ExampleToken from 0x%s
+
+		transaction(amount: UFix64, path: StoragePath) {
+			prepare(signer: AuthAccount) {
+				let vaultRef = signer.borrow<&ExampleToken.Vault>(from: ExampleToken.VaultStoragePath)
+					?? panic("Could not borrow reference to the owner's Vault!")
+
+				let targetRef = signer.borrow<&ExampleToken.Vault>(from: path)
+					?? panic("Could not borrow reference to the Vault at the path!")
+
+				targetRef.deposit(from: <-vaultRef.withdraw(amount: amount))
+			}
+		}
+	`, tokenAddr))
+	tx := createTxWithTemplateAndAuthorizer(b, script, accountAddr)
+
+	_ = tx.AddArgument(CadenceUFix64(amount))
+	_ = tx.AddArgument(path)
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{
+			b.ServiceKey().Address,
+			accountAddr,
+		},
+		[]crypto.Signer{
+			b.ServiceKey().Signer(),
+			accountSigner,
+		},
+		false,
+	)
+}
+
+// Returns the balance of the ExampleToken Vault an account stores at the specified path
+func vaultBalanceAtPath(
+	t *testing.T,
+	b *emulator.Blockchain,
+	tokenAddr flow.Address,
+	accountAddr flow.Address,
+	path cadence.Path,
+) cadence.Value {
+	script := []byte(fmt.Sprintf(`
+		import ExampleToken from 0x%s
+
+		pub fun main(account: Address, path: StoragePath): UFix64 {
+			let vaultRef = getAuthAccount(account).borrow<&ExampleToken.Vault>(from: path)
+				?? panic("Could not borrow reference to the Vault at the path!")
+
+			return vaultRef.balance
+		}
+	`, tokenAddr))
+
+	return executeScriptAndCheck(t, b,
+		script,
+		[][]byte{
+			jsoncdc.MustEncode(cadence.Address(accountAddr)),
+			jsoncdc.MustEncode(path),
+		},
+	)
+}
+
+// FTEvent is a fungible token event decoded from a transaction result
+type FTEvent struct {
+	// Name is the name of the event, e.g. "TokensDeposited"
+	Name   string
+	Amount cadence.UFix64
+	// Address is the "from" field of a TokensWithdrawn event
+	// or the "to" field of a TokensDeposited event, if it was set
+	Address *flow.Address
+}
+
+// ftEventNames are the events FilterFTEvents extracts
+var ftEventNames = map[string]bool{
+	"TokensWithdrawn": true,
+	"TokensDeposited": true,
+	"TokensMinted":    true,
+	"TokensBurned":    true,
+}
+
+// FilterFTEvents returns the withdraw, deposit, mint and burn events
+// the token contract deployed at the given address emitted in the given transaction result,
+// in the order they were emitted
+func FilterFTEvents(result *types.TransactionResult, tokenAddr flow.Address, tokenName string) []FTEvent {
+	// The only flow.Address rejected is the empty address, which no token is deployed to
+	identifiers, _ := templates.EventTypeIdentifiers(tokenAddr.String(), tokenName)
+
+	eventNames := make(map[string]string, len(ftEventNames))
+	for name := range ftEventNames {
+		eventNames[identifiers[name]] = name
+	}
+
+	var events []FTEvent
+
+	for _, event := range result.Events {
+		name, ok := eventNames[event.Type]
+		if !ok {
+			continue
+		}
+
+		ftEvent := FTEvent{Name: name}
+
+		for i, field := range event.Value.EventType.Fields {
+			switch field.Identifier {
+			case "amount":
+				ftEvent.Amount = event.Value.Fields[i].(cadence.UFix64)
+			case "from", "to":
+				optional, ok := event.Value.Fields[i].(cadence.Optional)
+				if !ok || optional.Value == nil {
+					continue
+				}
+				address := flow.Address(optional.Value.(cadence.Address))
+				ftEvent.Address = &address
+			}
+		}
+
+		events = append(events, ftEvent)
+	}
+
+	return events
+}